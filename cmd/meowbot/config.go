@@ -4,26 +4,27 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/nikmy/meowbot/internal/hr"
 	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/scheduling"
 	"github.com/nikmy/meowbot/internal/telegram"
 	"github.com/nikmy/meowbot/pkg/environment"
 	"github.com/nikmy/meowbot/pkg/errors"
 )
 
 type Config struct {
-	Environment environment.Env `yaml:"Environment"`
-	Telegram    telegram.Config `yaml:"Telegram"`
-
-	Database struct {
-		Mongo   repo.MongoConfig `yaml:"mongo"`
-		Sources struct {
-			Interviews string `yaml:"interviews"`
-			Users      string `yaml:"users"`
-		} `yaml:"sources"`
-	} `yaml:"Database"`
+	Environment environment.Env   `yaml:"Environment"`
+	Telegram    telegram.Config   `yaml:"Telegram"`
+	HR          hr.Config         `yaml:"HR"`
+	Scheduling  scheduling.Config `yaml:"Scheduling"`
+
+	ShutdownTimeout time.Duration `yaml:"ShutdownTimeout"`
+
+	Database repo.Config `yaml:"Database"`
 }
 
 func loadConfig() (*Config, error) {
@@ -49,6 +50,14 @@ func loadConfig() (*Config, error) {
 		cfg.Environment = *envFromFlags
 	}
 
+	// durations used to be configured for the bot only
+	if cfg.Scheduling.Interviews.DefaultDuration == 0 && cfg.Scheduling.Interviews.Durations == nil {
+		cfg.Scheduling.Interviews = cfg.Telegram.InterviewsConfig
+	}
+
+	// times used to be stored shifted by the fixed zone of the bot
+	cfg.Database.LegacyUTCDiff = cfg.Telegram.TimeZoneConfig.UTCDiff
+
 	return &cfg, nil
 }
 
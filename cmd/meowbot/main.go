@@ -2,17 +2,25 @@ package main
 
 import (
 	"context"
+	"flag"
 	stdlog "log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+	_ "time/tzdata"
 
+	"github.com/nikmy/meowbot/internal/hr"
 	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
 	"github.com/nikmy/meowbot/internal/telegram"
 	"github.com/nikmy/meowbot/pkg/errors"
 	"github.com/nikmy/meowbot/pkg/logger"
 )
 
+const defaultShutdownTimeout = 10 * time.Second
+
 func main() {
 	cfg, err := loadConfig()
 	if err != nil {
@@ -24,25 +32,88 @@ func main() {
 		log.Panic(errors.WrapFail(err, "init logger"))
 	}
 
+	// users without a zone are in the default one everywhere: in the bot, scheduling and HR API
+	defaultZone, err := cfg.Telegram.TimeZoneConfig.Location()
+	if err != nil {
+		log.Panic(errors.WrapFail(err, "load default time zone"))
+	}
+	models.SetDefaultLocation(defaultZone)
+
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGABRT)
 	defer cancel()
 
-	db := cfg.Database
+	switch command := flag.Arg(0); command {
+	case "":
+	case "migrate":
+		err = repo.Migrate(ctx, cfg.Database)
+		if err != nil {
+			log.Panic(errors.WrapFail(err, "migrate database"))
+		}
+		stdlog.Println("Database schema is up to date")
+		return
+	default:
+		log.Panicf("unknown command %q, only \"migrate\" is supported", command)
+	}
 
-	repoClient, err := repo.NewMongoClient(ctx, db.Mongo, db.Sources.Interviews, db.Sources.Users)
+	repoClient, err := repo.New(ctx, cfg.Database)
 	if err != nil {
 		log.Panic(errors.WrapFail(err, "init repo client"))
 	}
 
-	bot, err := telegram.New(log, cfg.Telegram, repoClient)
+	sched, err := scheduling.NewWithConfig(repoClient, cfg.Scheduling)
+	if err != nil {
+		log.Panic(errors.WrapFail(err, "init scheduler"))
+	}
+
+	bot, err := telegram.New(log, cfg.Telegram, repoClient, sched)
 	if err != nil {
 		log.Panic(errors.WrapFail(err, "initialize bot service"))
 	}
 
+	var hrServer hr.Server
+	if cfg.HR.HTTP.Addr != "" {
+		auth, err := hr.NewAuthorizer(cfg.HR.Auth)
+		if err != nil {
+			log.Panic(errors.WrapFail(err, "init hr authorizer"))
+		}
+
+		hrServer = hr.NewServer(cfg.HR, log, repoClient, sched, bot, hr.NewRequestIDGetter(cfg.HR.RequestID.Header), auth)
+	}
+
+	if path, handler := bot.Webhook(); handler != nil {
+		if hrServer == nil {
+			log.Panic(errors.Error("webhook without own listener requires HR http addr"))
+		}
+
+		hrServer.Mount(path, handler)
+	}
+
+	shutdownTimeout := cfg.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = defaultShutdownTimeout
+	}
+
 	stopped := make(chan struct{})
 	context.AfterFunc(ctx, func() {
 		stdlog.Println("Graceful shutdown...")
+
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelShutdown()
+
 		bot.Stop()
+
+		if hrServer != nil {
+			err := hrServer.Shutdown(shutdownCtx)
+			if err != nil {
+				log.Error(errors.WrapFail(err, "shutdown hr server"))
+			}
+		}
+
+		err := repoClient.Close(shutdownCtx)
+		if err != nil {
+			log.Error(errors.WrapFail(err, "close repo client"))
+		}
+
 		stopped <- struct{}{}
 	})
 
@@ -52,6 +123,17 @@ func main() {
 	}
 	stdlog.Println("Bot has been started")
 
+	if hrServer != nil {
+		go func() {
+			err := hrServer.Serve(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error(errors.WrapFail(err, "serve hr http"))
+				cancel()
+			}
+		}()
+		stdlog.Printf("HR server is listening on %s", cfg.HR.HTTP.Addr)
+	}
+
 	<-stopped
 	stdlog.Println("Shutdown complete")
 }
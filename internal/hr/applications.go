@@ -0,0 +1,122 @@
+package hr
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+func (s *server) handleListApplications(c *fiber.Ctx) error {
+	var filter models.ApplicationsFilter
+
+	if name := c.Query("status"); name != "" {
+		status, ok := models.ParseApplicationStatus(name)
+		if !ok {
+			return jsonError(c, http.StatusBadRequest, "status must be one of active, passed, rejected, withdrawn")
+		}
+		filter.Status = &status
+	}
+
+	if vacancy := c.Query("vacancy"); vacancy != "" {
+		filter.Vacancy = &vacancy
+	}
+
+	if candidate := strings.TrimPrefix(c.Query("candidate"), "@"); candidate != "" {
+		filter.Candidate = &candidate
+	}
+
+	applications, err := s.repo.Applications().List(c.Context(), filter)
+	if err != nil {
+		return errors.WrapFail(err, "do Applications.List request")
+	}
+
+	if applications == nil {
+		applications = []models.Application{}
+	}
+
+	return c.Status(http.StatusOK).JSON(applications)
+}
+
+func (s *server) handleCreateApplication(c *fiber.Ctx) error {
+	var req struct {
+		Vacancy   string `json:"vacancy"`
+		Candidate string `json:"candidate"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	candidate := strings.TrimPrefix(req.Candidate, "@")
+	if req.Vacancy == "" || candidate == "" {
+		return jsonError(c, http.StatusBadRequest, "vacancy and candidate must be provided")
+	}
+
+	var application *models.Application
+	err = s.withTxn(c.Context(), func(ctx context.Context) error {
+		_, err := s.repo.Users().Upsert(ctx, candidate, nil, nil, nil)
+		if err != nil {
+			return errors.WrapFail(err, "do Users.Upsert request")
+		}
+
+		application, err = s.sched.Apply(ctx, req.Vacancy, candidate)
+		return err
+	})
+
+	switch {
+	case errors.Is(err, scheduling.ErrVacancyNotFound):
+		return jsonError(c, http.StatusNotFound, "vacancy not found")
+	case errors.Is(err, scheduling.ErrAlreadyApplied):
+		return jsonError(c, http.StatusConflict, err.Error())
+	case err != nil:
+		return errors.WrapFail(err, "apply")
+	}
+
+	return c.Status(http.StatusCreated).JSON(application)
+}
+
+func (s *server) handleGetApplication(c *fiber.Ctx) error {
+	application, err := s.repo.Applications().Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Applications.Get request")
+	}
+
+	if application == nil {
+		return jsonError(c, http.StatusNotFound, "application not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(application)
+}
+
+func (s *server) handleWithdrawApplication(c *fiber.Ctx) error {
+	var (
+		application *models.Application
+		withdrawn   bool
+	)
+
+	err := s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		application, withdrawn, err = s.sched.Withdraw(ctx, c.Params("id"))
+		return errors.WrapFail(err, "withdraw application")
+	})
+	if err != nil {
+		return err
+	}
+
+	if application == nil {
+		return jsonError(c, http.StatusNotFound, "application not found")
+	}
+
+	if !withdrawn {
+		return jsonError(c, http.StatusConflict, "application is not active")
+	}
+
+	return c.Status(http.StatusOK).JSON(application)
+}
@@ -0,0 +1,181 @@
+package hr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const (
+	AuthNone   = "none"
+	AuthAPIKey = "apiKey"
+	AuthHMAC   = "hmac"
+
+	defaultAPIKeyHeader    = "X-Api-Key"
+	defaultSignatureHeader = "X-Signature"
+	defaultTimestampHeader = "X-Timestamp"
+	defaultMaxSkew         = 5 * time.Minute
+)
+
+// NewAuthorizer builds authorizer by config, returns nil for AuthNone.
+// Empty type is an error, so that the API is never exposed without auth by mistake.
+func NewAuthorizer(cfg AuthConfig) (authorizer, error) {
+	switch cfg.Type {
+	case "":
+		return nil, errors.Error("auth type must be set, use \"%s\" to disable auth explicitly", AuthNone)
+	case AuthNone:
+		return nil, nil
+	case AuthAPIKey:
+		if len(cfg.Keys) == 0 {
+			return nil, errors.Error("no api keys provided")
+		}
+
+		header := cfg.Header
+		if header == "" {
+			header = defaultAPIKeyHeader
+		}
+
+		keys := make([][]byte, 0, len(cfg.Keys))
+		for _, k := range cfg.Keys {
+			keys = append(keys, []byte(k))
+		}
+
+		return apiKeyAuthorizer{header: header, keys: keys}, nil
+	case AuthHMAC:
+		if cfg.Secret == "" {
+			return nil, errors.Error("no hmac secret provided")
+		}
+
+		header := cfg.Header
+		if header == "" {
+			header = defaultSignatureHeader
+		}
+
+		maxSkew := cfg.MaxSkew
+		if maxSkew <= 0 {
+			maxSkew = defaultMaxSkew
+		}
+
+		return hmacAuthorizer{
+			secret:          []byte(cfg.Secret),
+			header:          header,
+			timestampHeader: defaultTimestampHeader,
+			maxSkew:         maxSkew,
+			now:             time.Now,
+			seen:            &signatureCache{expires: make(map[string]time.Time)},
+		}, nil
+	default:
+		return nil, errors.Error("unknown auth type \"%s\"", cfg.Type)
+	}
+}
+
+// apiKeyAuthorizer accepts requests with one of static keys in header
+type apiKeyAuthorizer struct {
+	header string
+	keys   [][]byte
+}
+
+func (a apiKeyAuthorizer) Authorize(r *fasthttp.Request) (bool, error) {
+	got := r.Header.Peek(a.header)
+	if len(got) == 0 {
+		return false, nil
+	}
+
+	for _, key := range a.keys {
+		if subtle.ConstantTimeCompare(got, key) == 1 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// hmacAuthorizer accepts requests signed with shared secret. Signature is
+// hex(HMAC-SHA256(secret, timestamp + "\n" + method + "\n" + request uri + "\n" + body)),
+// where timestamp is unix seconds passed in X-Timestamp header. Every signature is
+// accepted once while its timestamp is within max skew. Seen signatures are kept in
+// memory of the process, so a request can still be replayed to another replica.
+type hmacAuthorizer struct {
+	secret          []byte
+	header          string
+	timestampHeader string
+	maxSkew         time.Duration
+	now             func() time.Time
+	seen            *signatureCache
+}
+
+func (a hmacAuthorizer) Authorize(r *fasthttp.Request) (bool, error) {
+	rawTimestamp := r.Header.Peek(a.timestampHeader)
+	timestamp, err := strconv.ParseInt(string(rawTimestamp), 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	now := a.now()
+	signedAt := time.Unix(timestamp, 0)
+	skew := now.Sub(signedAt)
+	if skew > a.maxSkew || skew < -a.maxSkew {
+		return false, nil
+	}
+
+	got, err := hex.DecodeString(string(r.Header.Peek(a.header)))
+	if err != nil || len(got) == 0 {
+		return false, nil
+	}
+
+	if !hmac.Equal(got, a.sign(rawTimestamp, r)) {
+		return false, nil
+	}
+
+	// after max skew the timestamp check rejects the signature by itself
+	return a.seen.add(string(got), now, signedAt.Add(a.maxSkew)), nil
+}
+
+func (a hmacAuthorizer) sign(timestamp []byte, r *fasthttp.Request) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write(timestamp)
+	mac.Write([]byte{'\n'})
+	mac.Write(r.Header.Method())
+	mac.Write([]byte{'\n'})
+	mac.Write(r.RequestURI())
+	mac.Write([]byte{'\n'})
+	mac.Write(r.Body())
+	return mac.Sum(nil)
+}
+
+// signatureCache remembers accepted signatures until they expire
+type signatureCache struct {
+	mu        sync.Mutex
+	expires   map[string]time.Time
+	nextPrune time.Time
+}
+
+// add returns false if the signature has been added before and has not expired yet
+func (c *signatureCache) add(signature string, now time.Time, expires time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.After(c.nextPrune) {
+		for seen, at := range c.expires {
+			if now.After(at) {
+				delete(c.expires, seen)
+			}
+		}
+		c.nextPrune = expires
+	}
+
+	if at, ok := c.expires[signature]; ok && !now.After(at) {
+		return false
+	}
+
+	c.expires[signature] = expires
+	return true
+}
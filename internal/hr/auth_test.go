@@ -0,0 +1,189 @@
+package hr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestNewAuthorizer(t *testing.T) {
+	type testcase struct {
+		name    string
+		cfg     AuthConfig
+		wantNil bool
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "not set", cfg: AuthConfig{}, wantErr: true},
+		{name: "none", cfg: AuthConfig{Type: AuthNone}, wantNil: true},
+		{name: "api key", cfg: AuthConfig{Type: AuthAPIKey, Keys: []string{"k"}}},
+		{name: "api key without keys", cfg: AuthConfig{Type: AuthAPIKey}, wantErr: true},
+		{name: "hmac", cfg: AuthConfig{Type: AuthHMAC, Secret: "s"}},
+		{name: "hmac without secret", cfg: AuthConfig{Type: AuthHMAC}, wantErr: true},
+		{name: "unknown", cfg: AuthConfig{Type: "basic"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := NewAuthorizer(tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantNil, auth == nil)
+		})
+	}
+}
+
+func Test_apiKeyAuthorizer(t *testing.T) {
+	auth, err := NewAuthorizer(AuthConfig{Type: AuthAPIKey, Keys: []string{"first", "second"}})
+	require.NoError(t, err)
+
+	type testcase struct {
+		name string
+		key  string
+		want bool
+	}
+
+	tests := [...]testcase{
+		{name: "no key", key: "", want: false},
+		{name: "wrong key", key: "third", want: false},
+		{name: "prefix of key", key: "fir", want: false},
+		{name: "first key", key: "first", want: true},
+		{name: "second key", key: "second", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r fasthttp.Request
+			if tt.key != "" {
+				r.Header.Set(defaultAPIKeyHeader, tt.key)
+			}
+
+			ok, err := auth.Authorize(&r)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+func Test_hmacAuthorizer(t *testing.T) {
+	const secret = "secret"
+	now := time.Unix(1_700_000_000, 0)
+
+	sign := func(key string, timestamp int64, method, uri, body string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + method + "\n" + uri + "\n" + body))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	type testcase struct {
+		name      string
+		timestamp int64
+		signature string
+		want      bool
+	}
+
+	const (
+		method = fasthttp.MethodPost
+		uri    = "/interviewData?iid=1"
+		body   = `{"zoom":"link"}`
+	)
+
+	tests := [...]testcase{
+		{
+			name:      "valid",
+			timestamp: now.Unix(),
+			signature: sign(secret, now.Unix(), method, uri, body),
+			want:      true,
+		},
+		{
+			name:      "small skew",
+			timestamp: now.Add(-time.Minute).Unix(),
+			signature: sign(secret, now.Add(-time.Minute).Unix(), method, uri, body),
+			want:      true,
+		},
+		{
+			name:      "expired",
+			timestamp: now.Add(-time.Hour).Unix(),
+			signature: sign(secret, now.Add(-time.Hour).Unix(), method, uri, body),
+		},
+		{
+			name:      "wrong secret",
+			timestamp: now.Unix(),
+			signature: sign("other", now.Unix(), method, uri, body),
+		},
+		{
+			name:      "tampered body",
+			timestamp: now.Unix(),
+			signature: sign(secret, now.Unix(), method, uri, `{"zoom":"other"}`),
+		},
+		{
+			name:      "not hex",
+			timestamp: now.Unix(),
+			signature: "signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAuthorizer(AuthConfig{Type: AuthHMAC, Secret: secret})
+			require.NoError(t, err)
+
+			auth := a.(hmacAuthorizer)
+			auth.now = func() time.Time { return now }
+
+			var r fasthttp.Request
+			r.Header.SetMethod(method)
+			r.SetRequestURI(uri)
+			r.SetBodyString(body)
+			r.Header.Set(defaultTimestampHeader, strconv.FormatInt(tt.timestamp, 10))
+			r.Header.Set(defaultSignatureHeader, tt.signature)
+
+			ok, err := auth.Authorize(&r)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ok)
+
+			if tt.want {
+				ok, err = auth.Authorize(&r)
+				require.NoError(t, err)
+				require.False(t, ok, "replayed request is rejected")
+			}
+		})
+	}
+}
+
+func Test_signatureCache(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := &signatureCache{expires: make(map[string]time.Time)}
+
+	require.True(t, c.add("first", now, now.Add(time.Minute)))
+	require.False(t, c.add("first", now.Add(time.Second), now.Add(time.Minute)))
+	require.True(t, c.add("second", now.Add(time.Second), now.Add(time.Minute)))
+
+	later := now.Add(2 * time.Minute)
+	require.True(t, c.add("first", later, later.Add(time.Minute)), "expired signatures are forgotten")
+	require.Len(t, c.expires, 1, "expired signatures are pruned")
+}
+
+func Test_headerRequestID(t *testing.T) {
+	getter := NewRequestIDGetter("")
+
+	var r fasthttp.Request
+	r.Header.Set(defaultRequestIDHeader, "given")
+	require.Equal(t, "given", getter.GetRequestId(&r))
+
+	var empty fasthttp.Request
+	generated := getter.GetRequestId(&empty)
+	require.NotEmpty(t, generated)
+	require.Equal(t, generated, getter.GetRequestId(&empty), "generated id is kept")
+}
@@ -14,4 +14,27 @@ type Config struct {
 		WriteTimeout time.Duration `yaml:"write_timeout"`
 		IdleTimeout  time.Duration `yaml:"idle_timeout"`
 	} `yaml:"http"`
+
+	RequestID struct {
+		Header string `yaml:"header"`
+	} `yaml:"request_id"`
+
+	Auth AuthConfig `yaml:"auth"`
+}
+
+type AuthConfig struct {
+	// Type is one of AuthNone, AuthAPIKey, AuthHMAC, it must be set explicitly
+	Type string `yaml:"type"`
+
+	// Header with api key or signature
+	Header string `yaml:"header"`
+
+	// Keys are accepted api keys
+	Keys []string `yaml:"keys"`
+
+	// Secret is a shared hmac secret
+	Secret string `yaml:"secret"`
+
+	// MaxSkew is max difference between signed timestamp and server time
+	MaxSkew time.Duration `yaml:"max_skew"`
 }
@@ -0,0 +1,3 @@
+package hr
+
+//go:generate mockgen -source=interfaces_test.go -destination=interfaces_mocks_test.go -package=$GOPACKAGE
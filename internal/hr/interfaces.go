@@ -2,13 +2,20 @@ package hr
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/valyala/fasthttp"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
 )
 
 type Server interface {
 	Serve(ctx context.Context) error
 	Shutdown(ctx context.Context) error
+
+	// Mount serves POST requests to path with the handler bypassing
+	// authorization, must be called before Serve
+	Mount(path string, handler http.Handler)
 }
 
 type reqIdGetter interface {
@@ -18,3 +25,18 @@ type reqIdGetter interface {
 type authorizer interface {
 	Authorize(r *fasthttp.Request) (bool, error)
 }
+
+// notifier enqueues messages to participants of interviews changed via API,
+// they are delivered only if the txn running in ctx is committed
+type notifier interface {
+	NotifyRescheduled(
+		ctx context.Context,
+		old *models.Interview,
+		lead models.User,
+		panelists []models.Panelist,
+		meet models.Meeting,
+	) error
+	NotifyCancelled(ctx context.Context, interview *models.Interview) error
+	NotifyDeleted(ctx context.Context, interview *models.Interview) error
+	NotifyFinished(ctx context.Context, interview *models.Interview) error
+}
@@ -0,0 +1,773 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces_test.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces_test.go -destination=interfaces_mocks_test.go -package=hr
+//
+
+// Package hr is a generated GoMock package.
+package hr
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/nikmy/meowbot/internal/repo/models"
+	txn "github.com/nikmy/meowbot/pkg/txn"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockrepoClient is a mock of repoClient interface.
+type MockrepoClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepoClientMockRecorder
+}
+
+// MockrepoClientMockRecorder is the mock recorder for MockrepoClient.
+type MockrepoClientMockRecorder struct {
+	mock *MockrepoClient
+}
+
+// NewMockrepoClient creates a new mock instance.
+func NewMockrepoClient(ctrl *gomock.Controller) *MockrepoClient {
+	mock := &MockrepoClient{ctrl: ctrl}
+	mock.recorder = &MockrepoClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrepoClient) EXPECT() *MockrepoClientMockRecorder {
+	return m.recorder
+}
+
+// Applications mocks base method.
+func (m *MockrepoClient) Applications() models.ApplicationsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Applications")
+	ret0, _ := ret[0].(models.ApplicationsRepo)
+	return ret0
+}
+
+// Applications indicates an expected call of Applications.
+func (mr *MockrepoClientMockRecorder) Applications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Applications", reflect.TypeOf((*MockrepoClient)(nil).Applications))
+}
+
+// Close mocks base method.
+func (m *MockrepoClient) Close(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockrepoClientMockRecorder) Close(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockrepoClient)(nil).Close), ctx)
+}
+
+// Dialogs mocks base method.
+func (m *MockrepoClient) Dialogs() models.DialogsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dialogs")
+	ret0, _ := ret[0].(models.DialogsRepo)
+	return ret0
+}
+
+// Dialogs indicates an expected call of Dialogs.
+func (mr *MockrepoClientMockRecorder) Dialogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dialogs", reflect.TypeOf((*MockrepoClient)(nil).Dialogs))
+}
+
+// Interviews mocks base method.
+func (m *MockrepoClient) Interviews() models.InterviewsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interviews")
+	ret0, _ := ret[0].(models.InterviewsRepo)
+	return ret0
+}
+
+// Interviews indicates an expected call of Interviews.
+func (mr *MockrepoClientMockRecorder) Interviews() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interviews", reflect.TypeOf((*MockrepoClient)(nil).Interviews))
+}
+
+// NewSession mocks base method.
+func (m *MockrepoClient) NewSession() (txn.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSession")
+	ret0, _ := ret[0].(txn.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSession indicates an expected call of NewSession.
+func (mr *MockrepoClientMockRecorder) NewSession() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockrepoClient)(nil).NewSession))
+}
+
+// Outbox mocks base method.
+func (m *MockrepoClient) Outbox() models.OutboxRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(models.OutboxRepo)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockrepoClientMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockrepoClient)(nil).Outbox))
+}
+
+// Users mocks base method.
+func (m *MockrepoClient) Users() models.UsersRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(models.UsersRepo)
+	return ret0
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockrepoClientMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockrepoClient)(nil).Users))
+}
+
+// Vacancies mocks base method.
+func (m *MockrepoClient) Vacancies() models.VacanciesRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vacancies")
+	ret0, _ := ret[0].(models.VacanciesRepo)
+	return ret0
+}
+
+// Vacancies indicates an expected call of Vacancies.
+func (mr *MockrepoClientMockRecorder) Vacancies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vacancies", reflect.TypeOf((*MockrepoClient)(nil).Vacancies))
+}
+
+// MockinterviewsApi is a mock of interviewsApi interface.
+type MockinterviewsApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockinterviewsApiMockRecorder
+}
+
+// MockinterviewsApiMockRecorder is the mock recorder for MockinterviewsApi.
+type MockinterviewsApiMockRecorder struct {
+	mock *MockinterviewsApi
+}
+
+// NewMockinterviewsApi creates a new mock instance.
+func NewMockinterviewsApi(ctrl *gomock.Controller) *MockinterviewsApi {
+	mock := &MockinterviewsApi{ctrl: ctrl}
+	mock.recorder = &MockinterviewsApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinterviewsApi) EXPECT() *MockinterviewsApiMockRecorder {
+	return m.recorder
+}
+
+// Attend mocks base method.
+func (m *MockinterviewsApi) Attend(ctx context.Context, id string, attendance [2]models.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attend", ctx, id, attendance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attend indicates an expected call of Attend.
+func (mr *MockinterviewsApiMockRecorder) Attend(ctx, id, attendance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attend", reflect.TypeOf((*MockinterviewsApi)(nil).Attend), ctx, id, attendance)
+}
+
+// Cancel mocks base method.
+func (m *MockinterviewsApi) Cancel(ctx context.Context, id string, side models.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, side)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockinterviewsApiMockRecorder) Cancel(ctx, id, side any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockinterviewsApi)(nil).Cancel), ctx, id, side)
+}
+
+// Create mocks base method.
+func (m *MockinterviewsApi) Create(ctx context.Context, vacancy, candidateTg string, duration time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, vacancy, candidateTg, duration)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockinterviewsApiMockRecorder) Create(ctx, vacancy, candidateTg, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockinterviewsApi)(nil).Create), ctx, vacancy, candidateTg, duration)
+}
+
+// Delete mocks base method.
+func (m *MockinterviewsApi) Delete(ctx context.Context, id string) (*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockinterviewsApiMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockinterviewsApi)(nil).Delete), ctx, id)
+}
+
+// Done mocks base method.
+func (m *MockinterviewsApi) Done(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Done", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Done indicates an expected call of Done.
+func (mr *MockinterviewsApiMockRecorder) Done(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockinterviewsApi)(nil).Done), ctx, id)
+}
+
+// Find mocks base method.
+func (m *MockinterviewsApi) Find(ctx context.Context, id string) (*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockinterviewsApiMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockinterviewsApi)(nil).Find), ctx, id)
+}
+
+// FindByUser mocks base method.
+func (m *MockinterviewsApi) FindByUser(ctx context.Context, username string) ([]*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", ctx, username)
+	ret0, _ := ret[0].([]*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockinterviewsApiMockRecorder) FindByUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockinterviewsApi)(nil).FindByUser), ctx, username)
+}
+
+// FixTg mocks base method.
+func (m *MockinterviewsApi) FixTg(ctx context.Context, username string, tg int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FixTg", ctx, username, tg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FixTg indicates an expected call of FixTg.
+func (mr *MockinterviewsApiMockRecorder) FixTg(ctx, username, tg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FixTg", reflect.TypeOf((*MockinterviewsApi)(nil).FixTg), ctx, username, tg)
+}
+
+// GetUpcoming mocks base method.
+func (m *MockinterviewsApi) GetUpcoming(ctx context.Context, lastNotifyBefore, startsBefore int64) ([]*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcoming", ctx, lastNotifyBefore, startsBefore)
+	ret0, _ := ret[0].([]*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcoming indicates an expected call of GetUpcoming.
+func (mr *MockinterviewsApiMockRecorder) GetUpcoming(ctx, lastNotifyBefore, startsBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcoming", reflect.TypeOf((*MockinterviewsApi)(nil).GetUpcoming), ctx, lastNotifyBefore, startsBefore)
+}
+
+// List mocks base method.
+func (m *MockinterviewsApi) List(ctx context.Context, filter models.InterviewsFilter) ([]*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockinterviewsApiMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockinterviewsApi)(nil).List), ctx, filter)
+}
+
+// Notify mocks base method.
+func (m *MockinterviewsApi) Notify(ctx context.Context, id string, at int64, notified [2]bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, id, at, notified)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockinterviewsApiMockRecorder) Notify(ctx, id, at, notified any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockinterviewsApi)(nil).Notify), ctx, id, at, notified)
+}
+
+// RemovePanelist mocks base method.
+func (m *MockinterviewsApi) RemovePanelist(ctx context.Context, id, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePanelist", ctx, id, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePanelist indicates an expected call of RemovePanelist.
+func (mr *MockinterviewsApiMockRecorder) RemovePanelist(ctx, id, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePanelist", reflect.TypeOf((*MockinterviewsApi)(nil).RemovePanelist), ctx, id, username)
+}
+
+// Schedule mocks base method.
+func (m *MockinterviewsApi) Schedule(ctx context.Context, id string, candidate, interviewer models.User, panel []models.Panelist, slot models.Meeting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, id, candidate, interviewer, panel, slot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockinterviewsApiMockRecorder) Schedule(ctx, id, candidate, interviewer, panel, slot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinterviewsApi)(nil).Schedule), ctx, id, candidate, interviewer, panel, slot)
+}
+
+// Score mocks base method.
+func (m *MockinterviewsApi) Score(ctx context.Context, id string, scorecard models.Scorecard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Score", ctx, id, scorecard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Score indicates an expected call of Score.
+func (mr *MockinterviewsApiMockRecorder) Score(ctx, id, scorecard any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Score", reflect.TypeOf((*MockinterviewsApi)(nil).Score), ctx, id, scorecard)
+}
+
+// SetPanel mocks base method.
+func (m *MockinterviewsApi) SetPanel(ctx context.Context, id string, panel models.Panel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPanel", ctx, id, panel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPanel indicates an expected call of SetPanel.
+func (mr *MockinterviewsApiMockRecorder) SetPanel(ctx, id, panel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPanel", reflect.TypeOf((*MockinterviewsApi)(nil).SetPanel), ctx, id, panel)
+}
+
+// Update mocks base method.
+func (m *MockinterviewsApi) Update(ctx context.Context, id string, vacancy, candidate *string, data *[]byte, zoom *string, duration *time.Duration, minGrade *int, skills *[]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockinterviewsApiMockRecorder) Update(ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockinterviewsApi)(nil).Update), ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills)
+}
+
+// MockusersApi is a mock of usersApi interface.
+type MockusersApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockusersApiMockRecorder
+}
+
+// MockusersApiMockRecorder is the mock recorder for MockusersApi.
+type MockusersApiMockRecorder struct {
+	mock *MockusersApi
+}
+
+// NewMockusersApi creates a new mock instance.
+func NewMockusersApi(ctrl *gomock.Controller) *MockusersApi {
+	mock := &MockusersApi{ctrl: ctrl}
+	mock.recorder = &MockusersApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusersApi) EXPECT() *MockusersApiMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockusersApi) Get(ctx context.Context, username string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, username)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockusersApiMockRecorder) Get(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockusersApi)(nil).Get), ctx, username)
+}
+
+// List mocks base method.
+func (m *MockusersApi) List(ctx context.Context, filter models.UsersFilter) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockusersApiMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockusersApi)(nil).List), ctx, filter)
+}
+
+// Match mocks base method.
+func (m *MockusersApi) Match(ctx context.Context, targetInterval [2]int64, filter models.InterviewerFilter) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", ctx, targetInterval, filter)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockusersApiMockRecorder) Match(ctx, targetInterval, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockusersApi)(nil).Match), ctx, targetInterval, filter)
+}
+
+// SetAvailability mocks base method.
+func (m *MockusersApi) SetAvailability(ctx context.Context, username string, availability *models.Availability) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, username, availability)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockusersApiMockRecorder) SetAvailability(ctx, username, availability any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockusersApi)(nil).SetAvailability), ctx, username, availability)
+}
+
+// SetLanguage mocks base method.
+func (m *MockusersApi) SetLanguage(ctx context.Context, username string, language models.Language) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLanguage", ctx, username, language)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLanguage indicates an expected call of SetLanguage.
+func (mr *MockusersApiMockRecorder) SetLanguage(ctx, username, language any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLanguage", reflect.TypeOf((*MockusersApi)(nil).SetLanguage), ctx, username, language)
+}
+
+// SetLimits mocks base method.
+func (m *MockusersApi) SetLimits(ctx context.Context, username string, limits models.Limits) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimits", ctx, username, limits)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLimits indicates an expected call of SetLimits.
+func (mr *MockusersApiMockRecorder) SetLimits(ctx, username, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimits", reflect.TypeOf((*MockusersApi)(nil).SetLimits), ctx, username, limits)
+}
+
+// SetNotifications mocks base method.
+func (m *MockusersApi) SetNotifications(ctx context.Context, username string, notifications models.Notifications) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotifications", ctx, username, notifications)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotifications indicates an expected call of SetNotifications.
+func (mr *MockusersApiMockRecorder) SetNotifications(ctx, username, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotifications", reflect.TypeOf((*MockusersApi)(nil).SetNotifications), ctx, username, notifications)
+}
+
+// SetSkills mocks base method.
+func (m *MockusersApi) SetSkills(ctx context.Context, username string, skills []string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSkills", ctx, username, skills)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSkills indicates an expected call of SetSkills.
+func (mr *MockusersApiMockRecorder) SetSkills(ctx, username, skills any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSkills", reflect.TypeOf((*MockusersApi)(nil).SetSkills), ctx, username, skills)
+}
+
+// SetTimeZone mocks base method.
+func (m *MockusersApi) SetTimeZone(ctx context.Context, username, timeZone string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimeZone", ctx, username, timeZone)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTimeZone indicates an expected call of SetTimeZone.
+func (mr *MockusersApiMockRecorder) SetTimeZone(ctx, username, timeZone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimeZone", reflect.TypeOf((*MockusersApi)(nil).SetTimeZone), ctx, username, timeZone)
+}
+
+// Update mocks base method.
+func (m *MockusersApi) Update(ctx context.Context, username string, telegramID *int64, category *models.UserCategory, intGrade *int) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, username, telegramID, category, intGrade)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockusersApiMockRecorder) Update(ctx, username, telegramID, category, intGrade any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockusersApi)(nil).Update), ctx, username, telegramID, category, intGrade)
+}
+
+// UpdateMeetings mocks base method.
+func (m *MockusersApi) UpdateMeetings(ctx context.Context, username string, meets, old []models.Meeting) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMeetings", ctx, username, meets, old)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMeetings indicates an expected call of UpdateMeetings.
+func (mr *MockusersApiMockRecorder) UpdateMeetings(ctx, username, meets, old any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMeetings", reflect.TypeOf((*MockusersApi)(nil).UpdateMeetings), ctx, username, meets, old)
+}
+
+// Upsert mocks base method.
+func (m *MockusersApi) Upsert(ctx context.Context, username string, telegramID *int64, category *models.UserCategory, intGrade *int) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, username, telegramID, category, intGrade)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockusersApiMockRecorder) Upsert(ctx, username, telegramID, category, intGrade any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockusersApi)(nil).Upsert), ctx, username, telegramID, category, intGrade)
+}
+
+// MockvacanciesApi is a mock of vacanciesApi interface.
+type MockvacanciesApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockvacanciesApiMockRecorder
+}
+
+// MockvacanciesApiMockRecorder is the mock recorder for MockvacanciesApi.
+type MockvacanciesApiMockRecorder struct {
+	mock *MockvacanciesApi
+}
+
+// NewMockvacanciesApi creates a new mock instance.
+func NewMockvacanciesApi(ctrl *gomock.Controller) *MockvacanciesApi {
+	mock := &MockvacanciesApi{ctrl: ctrl}
+	mock.recorder = &MockvacanciesApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockvacanciesApi) EXPECT() *MockvacanciesApiMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockvacanciesApi) Delete(ctx context.Context, id string) (*models.Vacancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*models.Vacancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockvacanciesApiMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockvacanciesApi)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockvacanciesApi) Get(ctx context.Context, id string) (*models.Vacancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*models.Vacancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockvacanciesApiMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockvacanciesApi)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockvacanciesApi) List(ctx context.Context) ([]models.Vacancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]models.Vacancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockvacanciesApiMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockvacanciesApi)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockvacanciesApi) Upsert(ctx context.Context, vacancy models.Vacancy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, vacancy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockvacanciesApiMockRecorder) Upsert(ctx, vacancy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockvacanciesApi)(nil).Upsert), ctx, vacancy)
+}
+
+// MocknotifierApi is a mock of notifierApi interface.
+type MocknotifierApi struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotifierApiMockRecorder
+}
+
+// MocknotifierApiMockRecorder is the mock recorder for MocknotifierApi.
+type MocknotifierApiMockRecorder struct {
+	mock *MocknotifierApi
+}
+
+// NewMocknotifierApi creates a new mock instance.
+func NewMocknotifierApi(ctrl *gomock.Controller) *MocknotifierApi {
+	mock := &MocknotifierApi{ctrl: ctrl}
+	mock.recorder = &MocknotifierApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotifierApi) EXPECT() *MocknotifierApiMockRecorder {
+	return m.recorder
+}
+
+// NotifyCancelled mocks base method.
+func (m *MocknotifierApi) NotifyCancelled(ctx context.Context, interview *models.Interview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyCancelled", ctx, interview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyCancelled indicates an expected call of NotifyCancelled.
+func (mr *MocknotifierApiMockRecorder) NotifyCancelled(ctx, interview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyCancelled", reflect.TypeOf((*MocknotifierApi)(nil).NotifyCancelled), ctx, interview)
+}
+
+// NotifyDeleted mocks base method.
+func (m *MocknotifierApi) NotifyDeleted(ctx context.Context, interview *models.Interview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyDeleted", ctx, interview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyDeleted indicates an expected call of NotifyDeleted.
+func (mr *MocknotifierApiMockRecorder) NotifyDeleted(ctx, interview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyDeleted", reflect.TypeOf((*MocknotifierApi)(nil).NotifyDeleted), ctx, interview)
+}
+
+// NotifyFinished mocks base method.
+func (m *MocknotifierApi) NotifyFinished(ctx context.Context, interview *models.Interview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyFinished", ctx, interview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyFinished indicates an expected call of NotifyFinished.
+func (mr *MocknotifierApiMockRecorder) NotifyFinished(ctx, interview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFinished", reflect.TypeOf((*MocknotifierApi)(nil).NotifyFinished), ctx, interview)
+}
+
+// NotifyRescheduled mocks base method.
+func (m *MocknotifierApi) NotifyRescheduled(ctx context.Context, old *models.Interview, lead models.User, panelists []models.Panelist, meet models.Meeting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyRescheduled", ctx, old, lead, panelists, meet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyRescheduled indicates an expected call of NotifyRescheduled.
+func (mr *MocknotifierApiMockRecorder) NotifyRescheduled(ctx, old, lead, panelists, meet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyRescheduled", reflect.TypeOf((*MocknotifierApi)(nil).NotifyRescheduled), ctx, old, lead, panelists, meet)
+}
@@ -0,0 +1,26 @@
+package hr
+
+import (
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+type repoClient interface {
+	repo.Client
+}
+
+type interviewsApi interface {
+	models.InterviewsRepo
+}
+
+type usersApi interface {
+	models.UsersRepo
+}
+
+type vacanciesApi interface {
+	models.VacanciesRepo
+}
+
+type notifierApi interface {
+	notifier
+}
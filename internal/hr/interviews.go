@@ -0,0 +1,514 @@
+package hr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// interviewPatch is a body of interview update, nil fields are left untouched
+type interviewPatch struct {
+	Vacancy   *string   `json:"vacancy"`
+	Candidate *string   `json:"candidate"`
+	Data      *[]byte   `json:"data"`
+	Zoom      *string   `json:"zoom"`
+	Duration  *duration `json:"duration"`
+	MinGrade  *int      `json:"min_grade"`
+	Skills    *[]string `json:"skills"`
+}
+
+func (s *server) handleListInterviews(c *fiber.Ctx) error {
+	filter, msg := parseInterviewsFilter(c)
+	if msg != "" {
+		return jsonError(c, http.StatusBadRequest, msg)
+	}
+
+	interviews, err := s.repo.Interviews().List(c.Context(), filter)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.List request")
+	}
+
+	if interviews == nil {
+		interviews = []*models.Interview{}
+	}
+
+	return c.Status(http.StatusOK).JSON(interviews)
+}
+
+// parseInterviewsFilter reads filter from query params status, outcome, vacancy, candidate,
+// interviewer and from, to (unix millis of meeting start), returns error message
+// if some param is malformed.
+func parseInterviewsFilter(c *fiber.Ctx) (models.InterviewsFilter, string) {
+	var filter models.InterviewsFilter
+
+	if name := c.Query("status"); name != "" {
+		status, ok := models.ParseInterviewStatus(name)
+		if !ok {
+			return filter, "status must be one of new, scheduled, finished, cancelled"
+		}
+		filter.Status = &status
+	}
+
+	if name := c.Query("outcome"); name != "" {
+		outcome, ok := models.ParseOutcome(name)
+		if !ok {
+			return filter, "outcome must be one of unknown, done, candidate_no_show, interviewer_no_show, disputed"
+		}
+		filter.Outcome = &outcome
+	}
+
+	for param, field := range map[string]**string{
+		"vacancy":     &filter.Vacancy,
+		"candidate":   &filter.Candidate,
+		"interviewer": &filter.Interviewer,
+	} {
+		if value := c.Query(param); value != "" {
+			*field = &value
+		}
+	}
+
+	for param, field := range map[string]**int64{
+		"from": &filter.From,
+		"to":   &filter.To,
+	} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return filter, param + " must be unix time in milliseconds"
+		}
+		*field = &value
+	}
+
+	return filter, ""
+}
+
+func (s *server) handleCreateInterview(c *fiber.Ctx) error {
+	var req struct {
+		Vacancy   string   `json:"vacancy"`
+		Candidate string   `json:"candidate"`
+		Duration  duration `json:"duration"`
+		MinGrade  int      `json:"min_grade"`
+		Skills    []string `json:"skills"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	candidate := strings.TrimPrefix(req.Candidate, "@")
+	if req.Vacancy == "" || candidate == "" {
+		return jsonError(c, http.StatusBadRequest, "vacancy and candidate must be provided")
+	}
+
+	if msg := checkDuration(time.Duration(req.Duration)); msg != "" {
+		return jsonError(c, http.StatusBadRequest, msg)
+	}
+
+	err = models.ValidateMinGrade(req.MinGrade)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	skills := models.NormalizeSkills(req.Skills)
+	err = models.ValidateSkills(skills)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	// requirements of the interview itself are optional
+	var (
+		minGrade *int
+		required *[]string
+	)
+	if req.MinGrade != models.GradeNotInterviewer {
+		minGrade = &req.MinGrade
+	}
+	if len(skills) > 0 {
+		required = &skills
+	}
+
+	var id string
+	err = s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		id, err = s.sched.CreateInterview(ctx, req.Vacancy, candidate, time.Duration(req.Duration))
+		if err != nil {
+			return errors.WrapFail(err, "create interview")
+		}
+
+		if minGrade == nil && required == nil {
+			return nil
+		}
+
+		err = s.repo.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, minGrade, required)
+		return errors.WrapFail(err, "set interviewer requirements")
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"id": id})
+}
+
+func (s *server) handleGetInterview(c *fiber.Ctx) error {
+	interview, err := s.repo.Interviews().Find(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Find request")
+	}
+
+	if interview == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(interview)
+}
+
+func (s *server) handleGetScorecard(c *fiber.Ctx) error {
+	interview, err := s.repo.Interviews().Find(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Find request")
+	}
+
+	if interview == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	if interview.Scorecard == nil {
+		return jsonError(c, http.StatusNotFound, "scorecard not submitted")
+	}
+
+	return c.Status(http.StatusOK).JSON(interview.Scorecard)
+}
+
+func (s *server) handlePatchInterview(c *fiber.Ctx) error {
+	return s.patchInterview(c, c.Params("id"))
+}
+
+func (s *server) handleInterviewData(c *fiber.Ctx) error {
+	iid := c.Query("iid", "")
+	if iid == "" {
+		return jsonError(c, http.StatusBadRequest, "interview id param \"iid\" must be provided")
+	}
+
+	return s.patchInterview(c, iid)
+}
+
+func (s *server) patchInterview(c *fiber.Ctx, id string) error {
+	var patch interviewPatch
+	err := c.BodyParser(&patch)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	var newDuration *time.Duration
+	if patch.Duration != nil {
+		d := time.Duration(*patch.Duration)
+		if msg := checkDuration(d); msg != "" {
+			return jsonError(c, http.StatusBadRequest, msg)
+		}
+		newDuration = &d
+	}
+
+	if patch.MinGrade != nil {
+		err = models.ValidateMinGrade(*patch.MinGrade)
+		if err != nil {
+			return jsonError(c, http.StatusBadRequest, err.Error())
+		}
+	}
+
+	if patch.Skills != nil {
+		skills := models.NormalizeSkills(*patch.Skills)
+		err = models.ValidateSkills(skills)
+		if err != nil {
+			return jsonError(c, http.StatusBadRequest, err.Error())
+		}
+		patch.Skills = &skills
+	}
+
+	if patch.Candidate != nil {
+		candidate := strings.TrimPrefix(*patch.Candidate, "@")
+		patch.Candidate = &candidate
+	}
+
+	found, err := s.repo.Interviews().Find(c.Context(), id)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Find request")
+	}
+
+	if found == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	// the meeting and the participants' calendars are booked for them, use reschedule or cancel instead
+	scheduledOnly := patch.Vacancy != nil || patch.Candidate != nil || patch.Duration != nil
+	if scheduledOnly && found.Status == models.InterviewStatusScheduled {
+		return jsonError(c, http.StatusConflict, "vacancy, candidate and duration of scheduled interview cannot be changed")
+	}
+
+	err = s.repo.Interviews().Update(
+		c.Context(), id,
+		patch.Vacancy, patch.Candidate, patch.Data, patch.Zoom, newDuration, patch.MinGrade, patch.Skills,
+	)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Update request")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+func (s *server) handleDeleteInterview(c *fiber.Ctx) error {
+	var (
+		found     *models.Interview
+		cancelled bool
+	)
+
+	err := s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		found, cancelled, err = s.sched.DeleteInterview(ctx, c.Params("id"))
+		if err != nil || !cancelled {
+			return errors.WrapFail(err, "delete interview")
+		}
+
+		err = s.note.NotifyDeleted(ctx, found)
+		return errors.WrapFail(err, "notify about deletion")
+	})
+	if err != nil {
+		return err
+	}
+
+	if found == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"cancelled": cancelled})
+}
+
+func (s *server) handleCancelInterview(c *fiber.Ctx) error {
+	interview, err := s.repo.Interviews().Find(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Find request")
+	}
+
+	if interview == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	if interview.Status != models.InterviewStatusScheduled {
+		return jsonError(c, http.StatusConflict, "interview is not scheduled")
+	}
+
+	var cancelled bool
+	err = s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		cancelled, err = s.sched.CancelInterview(ctx, interview, models.RoleHR)
+		if err != nil || !cancelled {
+			return errors.WrapFail(err, "cancel interview")
+		}
+
+		err = s.note.NotifyCancelled(ctx, interview)
+		return errors.WrapFail(err, "notify about cancel")
+	})
+	if err != nil {
+		return err
+	}
+
+	if !cancelled {
+		return jsonError(c, http.StatusConflict, "interview is not scheduled")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+func (s *server) handleRescheduleInterview(c *fiber.Ctx) error {
+	var req struct {
+		Start int64 `json:"start"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	if req.Start <= time.Now().UnixMilli() {
+		return jsonError(c, http.StatusBadRequest, "start must be in the future")
+	}
+
+	var (
+		interview   *models.Interview
+		interviewer models.User
+		panelists   []models.Panelist
+		meet        models.Meeting
+	)
+
+	err = s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		interview, err = s.repo.Interviews().Find(ctx, c.Params("id"))
+		if err != nil || interview == nil {
+			return errors.WrapFail(err, "do Interviews.Find request")
+		}
+
+		meet = models.Meeting{req.Start, req.Start + interview.MeetDuration().Milliseconds()}
+		interviewer, panelists, err = s.sched.Reschedule(ctx, interview, meet)
+		if err != nil {
+			return err
+		}
+
+		err = s.note.NotifyRescheduled(ctx, interview, interviewer, panelists, meet)
+		return errors.WrapFail(err, "notify about reschedule")
+	})
+
+	switch {
+	case err == nil && interview == nil:
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	case errors.Is(err, scheduling.ErrNotScheduled),
+		errors.Is(err, scheduling.ErrCandidateBusy),
+		errors.Is(err, scheduling.ErrNoInterviewer),
+		errors.Is(err, scheduling.ErrTooSoon):
+		return jsonError(c, http.StatusConflict, err.Error())
+	case err != nil:
+		return errors.WrapFail(err, "reschedule interview")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"interviewer": interviewer.Username,
+		"panelists":   panelists,
+		"meet":        meet,
+	})
+}
+
+func (s *server) handleSetPanel(c *fiber.Ctx) error {
+	var panel models.Panel
+	err := c.BodyParser(&panel)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	err = panel.Validate()
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	interview, err := s.repo.Interviews().Find(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Find request")
+	}
+
+	if interview == nil {
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	}
+
+	err = s.repo.Interviews().SetPanel(c.Context(), interview.ID, panel)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.SetPanel request")
+	}
+
+	return c.Status(http.StatusOK).JSON(panel)
+}
+
+// handleDoneInterview finishes the interview the same way the bot does when the meeting ends:
+// participants are asked about the outcome, so it is recorded from their answers
+func (s *server) handleDoneInterview(c *fiber.Ctx) error {
+	var (
+		interview *models.Interview
+		conflict  string
+	)
+
+	err := s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		interview, err = s.repo.Interviews().Find(ctx, c.Params("id"))
+		if err != nil || interview == nil {
+			return errors.WrapFail(err, "do Interviews.Find request")
+		}
+
+		switch {
+		case interview.Status != models.InterviewStatusScheduled:
+			conflict = "interview is not scheduled"
+			return nil
+		case interview.Meet == nil || interview.Meet[1] > time.Now().UnixMilli():
+			conflict = "interview has not ended yet"
+			return nil
+		}
+
+		err = s.repo.Interviews().Done(ctx, interview.ID)
+		if err != nil {
+			return errors.WrapFail(err, "do Interviews.Done request")
+		}
+
+		err = s.note.NotifyFinished(ctx, interview)
+		return errors.WrapFail(err, "notify about finish")
+	})
+
+	switch {
+	case err != nil:
+		return err
+	case interview == nil:
+		return jsonError(c, http.StatusNotFound, "interview not found")
+	case conflict != "":
+		return jsonError(c, http.StatusConflict, conflict)
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+// duration is a request field taking either Go duration like "1h30m"
+// or nanoseconds, the form durations are returned in
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		var ns int64
+		err := json.Unmarshal(data, &ns)
+		if err != nil {
+			return errors.WrapFail(err, "unmarshal duration in nanoseconds")
+		}
+
+		*d = duration(ns)
+		return nil
+	}
+
+	var raw string
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return errors.WrapFail(err, "unmarshal duration string")
+	}
+
+	if raw == "" {
+		*d = 0
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return errors.WrapFail(err, "parse duration")
+	}
+
+	*d = duration(parsed)
+	return nil
+}
+
+// checkDuration returns error message if duration is out of the range
+// the bot accepts too, zero means the default one
+func checkDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	err := models.ValidateDuration(d)
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
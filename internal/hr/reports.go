@@ -0,0 +1,66 @@
+package hr
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// outcomesRow counts outcomes of finished interviews held by the interviewer
+type outcomesRow struct {
+	Interviewer string         `json:"interviewer"`
+	Total       int            `json:"total"`
+	Outcomes    map[string]int `json:"outcomes"`
+}
+
+// handleOutcomesReport counts outcomes of finished interviews per interviewer,
+// interviews are selected by the same filters as in the list except status
+func (s *server) handleOutcomesReport(c *fiber.Ctx) error {
+	filter, msg := parseInterviewsFilter(c)
+	if msg != "" {
+		return jsonError(c, http.StatusBadRequest, msg)
+	}
+
+	finished := models.InterviewStatusFinished
+	filter.Status = &finished
+
+	interviews, err := s.repo.Interviews().List(c.Context(), filter)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.List request")
+	}
+
+	return c.Status(http.StatusOK).JSON(outcomesReport(interviews))
+}
+
+func outcomesReport(interviews []*models.Interview) []outcomesRow {
+	rows := make([]outcomesRow, 0)
+	byInterviewer := make(map[string]int)
+
+	for _, i := range interviews {
+		idx, ok := byInterviewer[i.InterviewerUN]
+		if !ok {
+			outcomes := make(map[string]int, len(models.Outcomes()))
+			for _, o := range models.Outcomes() {
+				outcomes[o.String()] = 0
+			}
+
+			idx = len(rows)
+			byInterviewer[i.InterviewerUN] = idx
+			rows = append(rows, outcomesRow{Interviewer: i.InterviewerUN, Outcomes: outcomes})
+		}
+
+		rows[idx].Total++
+		rows[idx].Outcomes[i.Outcome.String()]++
+	}
+
+	slices.SortFunc(rows, func(a, b outcomesRow) int {
+		return strings.Compare(a.Interviewer, b.Interviewer)
+	})
+
+	return rows
+}
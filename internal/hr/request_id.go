@@ -0,0 +1,39 @@
+package hr
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/valyala/fasthttp"
+)
+
+const defaultRequestIDHeader = "X-Request-Id"
+
+// NewRequestIDGetter returns getter, which takes request id from header
+// or generates a new one and stores it to the request.
+func NewRequestIDGetter(header string) reqIdGetter {
+	if header == "" {
+		header = defaultRequestIDHeader
+	}
+
+	return headerRequestID{header: header}
+}
+
+type headerRequestID struct {
+	header string
+}
+
+func (h headerRequestID) GetRequestId(r *fasthttp.Request) string {
+	id := r.Header.Peek(h.header)
+	if len(id) > 0 {
+		return string(id)
+	}
+
+	var raw [16]byte
+	_, _ = rand.Read(raw[:])
+
+	generated := hex.EncodeToString(raw[:])
+	r.Header.Set(h.header, generated)
+
+	return generated
+}
@@ -3,19 +3,26 @@ package hr
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"go.uber.org/zap"
 
 	"github.com/nikmy/meowbot/internal/repo"
-	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
 	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
 )
 
+const txnTimeout = 5 * time.Second
+
 func NewServer(
 	cfg Config,
 	log *zap.SugaredLogger,
 	repoClient repo.Client,
+	sched scheduling.Scheduler,
+	notifier notifier,
 	reqIdGetter reqIdGetter,
 	auth authorizer,
 ) Server {
@@ -30,24 +37,39 @@ func NewServer(
 		EnableTrustedProxyCheck: true,
 		ProxyHeader:             cfg.Proxy.Header,
 		TrustedProxies:          cfg.Proxy.Trusted,
-		RequestMethods:          []string{fiber.MethodGet, fiber.MethodPost},
+		RequestMethods: []string{
+			fiber.MethodHead,
+			fiber.MethodGet,
+			fiber.MethodPost,
+			fiber.MethodPut,
+			fiber.MethodPatch,
+			fiber.MethodDelete,
+		},
 	}
 
 	fiberCfg.ErrorHandler = func(c *fiber.Ctx, err error) error {
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			return jsonError(c, fiberErr.Code, fiberErr.Message)
+		}
+
 		reqID := reqIdGetter.GetRequestId(c.Request())
 		serveLog.With(
 			zap.String("request_id", reqID),
 			zap.Any("body", string(c.Body())),
 		).Error(err)
-		return c.Status(http.StatusInternalServerError).Send(nil)
+		return jsonError(c, http.StatusInternalServerError, "internal error")
 	}
 
 	s := &server{
-		repo: repoClient,
-		http: fiber.New(fiberCfg),
-		addr: cfg.HTTP.Addr,
-		auth: auth,
-		log:  serveLog,
+		repo:  repoClient,
+		txm:   txn.NewManager(repoClient),
+		sched: sched,
+		note:  notifier,
+		http:  fiber.New(fiberCfg),
+		addr:  cfg.HTTP.Addr,
+		auth:  auth,
+		log:   serveLog,
 	}
 
 	s.setupRoutes()
@@ -56,11 +78,14 @@ func NewServer(
 }
 
 type server struct {
-	repo repo.Client
-	http *fiber.App
-	addr string
-	auth authorizer
-	log  *zap.SugaredLogger
+	repo  repo.Client
+	txm   txn.Manager
+	sched scheduling.Scheduler
+	note  notifier
+	http  *fiber.App
+	addr  string
+	auth  authorizer
+	log   *zap.SugaredLogger
 }
 
 func (s *server) Serve(ctx context.Context) error {
@@ -75,24 +100,56 @@ func (s *server) Serve(ctx context.Context) error {
 	}
 }
 
-func (s *server) Shutdown(ctx context.Context) error {
-	var errs []error
-	err := s.repo.Close(ctx)
-	if err != nil {
-		errs = append(errs, errors.WrapFail(err, "close repo"))
-	}
-
-	err = s.http.ShutdownWithContext(ctx)
-	if err != nil {
-		errs = append(errs, errors.WrapFail(err, "shutdown http server"))
-	}
+func (s *server) Mount(path string, handler http.Handler) {
+	s.http.Post(path, adaptor.HTTPHandler(handler))
+}
 
-	return errors.Join(errs...)
+// Shutdown stops http server, repo client is closed by its owner
+func (s *server) Shutdown(ctx context.Context) error {
+	return errors.WrapFail(s.http.ShutdownWithContext(ctx), "shutdown http server")
 }
 
 func (s *server) setupRoutes() {
+	s.http.Get("/openapi.yaml", s.handleSpec)
+
+	s.http.Get("/interviews", s.authWrapper(s.handleListInterviews))
+	s.http.Post("/interviews", s.authWrapper(s.handleCreateInterview))
+	s.http.Get("/interviews/:id", s.authWrapper(s.handleGetInterview))
+	s.http.Patch("/interviews/:id", s.authWrapper(s.handlePatchInterview))
+	s.http.Delete("/interviews/:id", s.authWrapper(s.handleDeleteInterview))
+	s.http.Post("/interviews/:id/cancel", s.authWrapper(s.handleCancelInterview))
+	s.http.Post("/interviews/:id/done", s.authWrapper(s.handleDoneInterview))
+	s.http.Get("/interviews/:id/scorecard", s.authWrapper(s.handleGetScorecard))
+	s.http.Post("/interviews/:id/reschedule", s.authWrapper(s.handleRescheduleInterview))
+	s.http.Put("/interviews/:id/panel", s.authWrapper(s.handleSetPanel))
+
+	s.http.Get("/vacancies", s.authWrapper(s.handleListVacancies))
+	s.http.Get("/vacancies/:id", s.authWrapper(s.handleGetVacancy))
+	s.http.Put("/vacancies/:id", s.authWrapper(s.handlePutVacancy))
+	s.http.Delete("/vacancies/:id", s.authWrapper(s.handleDeleteVacancy))
+
+	s.http.Get("/applications", s.authWrapper(s.handleListApplications))
+	s.http.Post("/applications", s.authWrapper(s.handleCreateApplication))
+	s.http.Get("/applications/:id", s.authWrapper(s.handleGetApplication))
+	s.http.Post("/applications/:id/withdraw", s.authWrapper(s.handleWithdrawApplication))
+
+	s.http.Get("/reports/outcomes", s.authWrapper(s.handleOutcomesReport))
+
+	s.http.Get("/users", s.authWrapper(s.handleListUsers))
+	s.http.Get("/users/:username", s.authWrapper(s.handleGetUser))
+	s.http.Get("/users/:username/interviews", s.authWrapper(s.handleUserInterviews))
+	s.http.Put("/users/:username/interviewer", s.authWrapper(s.handleGrantInterviewer))
+	s.http.Delete("/users/:username/interviewer", s.authWrapper(s.handleRevokeInterviewer))
+	s.http.Put("/users/:username/notifications", s.authWrapper(s.handleSetNotifications))
+	s.http.Put("/users/:username/timezone", s.authWrapper(s.handleSetTimeZone))
+	s.http.Put("/users/:username/limits", s.authWrapper(s.handleSetLimits))
+	s.http.Put("/users/:username/skills", s.authWrapper(s.handleSetSkills))
+
+	// legacy routes, kept for existing integrations
 	s.http.Post("/upsertEmployee", s.authWrapper(s.handleUpsertEmployee))
 	s.http.Post("/interviewData", s.authWrapper(s.handleInterviewData))
+	s.http.Get("/availability", s.authWrapper(s.handleGetAvailability))
+	s.http.Post("/availability", s.authWrapper(s.handleSetAvailability))
 }
 
 func (s *server) authWrapper(h fiber.Handler) fiber.Handler {
@@ -107,60 +164,41 @@ func (s *server) authWrapper(h fiber.Handler) fiber.Handler {
 		}
 
 		if !ok {
-			return c.Status(http.StatusUnauthorized).Send(nil)
+			return jsonError(c, http.StatusUnauthorized, "unauthorized")
 		}
 
 		return h(c)
 	}
 }
 
-func (s *server) handleInterviewData(c *fiber.Ctx) error {
-	iid := c.Query("iid", "")
-	if iid == "" {
-		return c.Status(http.StatusBadRequest).
-			Send([]byte("{\"error\": \"interview id param \\\"iid\\\"\" must be provided}"))
-	}
-
-	var patch struct {
-		Vacancy   *string `json:"vacancy"`
-		Candidate *string `json:"candidate"`
-		Data      *[]byte `json:"data"`
-		Zoom      *string `json:"zoom"`
-	}
-	err := c.JSON(&patch)
-	if err != nil {
-		return errors.WrapFail(err, "unmarshal patch data")
-	}
-
-	err = s.repo.Interviews().Update(c.Context(), iid, patch.Vacancy, patch.Candidate, patch.Data, patch.Zoom)
-	if err != nil {
-		return errors.WrapFail(err, "do Interviews.Find request")
-	}
-
-	return c.Status(http.StatusOK).Send(nil)
+// jsonError sends error response, all errors have the form {"error": "message"}
+func jsonError(c *fiber.Ctx, status int, msg string) error {
+	return c.Status(status).JSON(fiber.Map{"error": msg})
 }
 
-func (s *server) handleUpsertEmployee(c *fiber.Ctx) error {
-	var req struct {
-		TG string `json:"tg"`
-		HR bool   `json:"hr"`
-	}
-
-	err := c.JSON(&req)
+// withTxn runs do inside a transaction, committing it if do succeeds
+func (s *server) withTxn(parent context.Context, do func(ctx context.Context) error) error {
+	ctx, cancel, err := s.txm.NewSessionContext(parent, txnTimeout)
 	if err != nil {
-		return errors.WrapFail(err, "unmarshal body as json")
+		return errors.WrapFail(err, "init session context")
 	}
+	defer cancel()
 
-	cat := models.EmployeeUser
-	if req.HR {
-		cat = models.HRUser
+	tx, err := txn.Start(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "start txn")
 	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			s.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
 
-	_, err = s.repo.Users().Upsert(c.Context(), req.TG, nil, &cat, nil)
+	err = do(ctx)
 	if err != nil {
-		return errors.WrapFail(err, "do Users.Upsert request")
+		return err
 	}
 
-	return c.Status(http.StatusOK).Send(nil)
-
+	return errors.WrapFail(tx.Commit(ctx), "commit txn")
 }
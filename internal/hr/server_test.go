@@ -0,0 +1,928 @@
+package hr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+)
+
+type testcase struct {
+	name    string
+	method  string
+	target  string
+	body    string
+	prepare func(i *MockinterviewsApi, u *MockusersApi)
+
+	// vacancies are served from a mock which knows nothing unless prepared
+	prepareVacancies func(v *MockvacanciesApi)
+
+	prepareNotifier func(n *MocknotifierApi)
+
+	wantStatus int
+	wantBody   string
+}
+
+func runServerTests(t *testing.T, auth authorizer, tests []testcase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rMock := NewMockrepoClient(ctrl)
+			iMock := NewMockinterviewsApi(ctrl)
+			uMock := NewMockusersApi(ctrl)
+			rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+			rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+			// transactions are run over an empty memory store, data is served by mocks
+			rMock.EXPECT().NewSession().DoAndReturn(repo.NewMemoryClient(repo.MemoryConfig{}).NewSession).AnyTimes()
+
+			vMock := NewMockvacanciesApi(ctrl)
+			rMock.EXPECT().Vacancies().Return(vMock).AnyTimes()
+
+			if tt.prepare != nil {
+				tt.prepare(iMock, uMock)
+			}
+
+			if tt.prepareVacancies != nil {
+				tt.prepareVacancies(vMock)
+			} else {
+				vMock.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			}
+
+			// nothing is expected to be notified unless prepared
+			nMock := NewMocknotifierApi(ctrl)
+			if tt.prepareNotifier != nil {
+				tt.prepareNotifier(nMock)
+			}
+
+			s := NewServer(Config{}, zap.NewNop().Sugar(), rMock, scheduling.New(rMock), nMock, NewRequestIDGetter(""), auth).(*server)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := s.http.Test(req)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.wantBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func TestServer_interviews(t *testing.T) {
+	scheduled := models.InterviewStatusScheduled
+	ended := &models.Interview{ID: "42", Status: scheduled, Meet: &[2]int64{100, 200}}
+
+	runServerTests(t, nil, []testcase{
+		{
+			name:   "list with filter",
+			method: http.MethodGet,
+			target: "/interviews?status=scheduled&vacancy=go&from=100&to=200",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				from, to, vacancy := int64(100), int64(200), "go"
+				i.EXPECT().List(gomock.Any(), models.InterviewsFilter{
+					Status:  &scheduled,
+					Vacancy: &vacancy,
+					From:    &from,
+					To:      &to,
+				}).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[]`,
+		},
+		{
+			name:       "list with unknown status",
+			method:     http.MethodGet,
+			target:     "/interviews?status=lost",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "status must be one of new, scheduled, finished, cancelled"}`,
+		},
+		{
+			name:   "list by outcome",
+			method: http.MethodGet,
+			target: "/interviews?outcome=candidate_no_show",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				outcome := models.OutcomeCandidateNoShow
+				i.EXPECT().List(gomock.Any(), models.InterviewsFilter{Outcome: &outcome}).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[]`,
+		},
+		{
+			name:       "list with unknown outcome",
+			method:     http.MethodGet,
+			target:     "/interviews?outcome=lost",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "outcome must be one of unknown, done, candidate_no_show, interviewer_no_show, disputed"}`,
+		},
+		{
+			name:       "list with malformed date",
+			method:     http.MethodGet,
+			target:     "/interviews?from=today",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "from must be unix time in milliseconds"}`,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			target: "/interviews",
+			body:   `{"vacancy": "go", "candidate": "@cand", "duration": "1h30m"}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Create(gomock.Any(), "go", "cand", 90*time.Minute).Return("42", nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id": "42"}`,
+		},
+		{
+			name:   "create with duration in nanoseconds",
+			method: http.MethodPost,
+			target: "/interviews",
+			body:   `{"vacancy": "go", "candidate": "cand", "duration": 5400000000000}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Create(gomock.Any(), "go", "cand", 90*time.Minute).Return("42", nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id": "42"}`,
+		},
+		{
+			name:       "create with negative duration",
+			method:     http.MethodPost,
+			target:     "/interviews",
+			body:       `{"vacancy": "go", "candidate": "cand", "duration": -1}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "duration must be between 1m0s and 24h0m0s"}`,
+		},
+		{
+			name:       "create with sub-minute duration",
+			method:     http.MethodPost,
+			target:     "/interviews",
+			body:       `{"vacancy": "go", "candidate": "cand", "duration": 999999}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "duration must be between 1m0s and 24h0m0s"}`,
+		},
+		{
+			name:       "create with malformed duration",
+			method:     http.MethodPost,
+			target:     "/interviews",
+			body:       `{"vacancy": "go", "candidate": "cand", "duration": "long"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "malformed body"}`,
+		},
+		{
+			name:   "create with min grade",
+			method: http.MethodPost,
+			target: "/interviews",
+			body:   `{"vacancy": "go", "candidate": "cand", "min_grade": 3}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				grade := models.GradeSenior
+				i.EXPECT().Create(gomock.Any(), "go", "cand", models.DefaultInterviewDuration).Return("42", nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, nil, nil, &grade, nil).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id": "42"}`,
+		},
+		{
+			name:       "create with unknown grade",
+			method:     http.MethodPost,
+			target:     "/interviews",
+			body:       `{"vacancy": "go", "candidate": "cand", "min_grade": 5}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "min grade must be between 0 and 4"}`,
+		},
+		{
+			name:   "patch min grade",
+			method: http.MethodPatch,
+			target: "/interviews/42",
+			body:   `{"min_grade": 2}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				grade := models.GradeMiddle
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42"}, nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, nil, nil, &grade, nil).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "patch skills",
+			method: http.MethodPatch,
+			target: "/interviews/42",
+			body:   `{"skills": ["Frontend"]}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				skills := []string{"frontend"}
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42"}, nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, nil, nil, nil, &skills).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "patch duration",
+			method: http.MethodPatch,
+			target: "/interviews/42",
+			body:   `{"duration": "45m"}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				duration := 45 * time.Minute
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42"}, nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, nil, &duration, nil, nil).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "patch candidate of scheduled",
+			method: http.MethodPatch,
+			target: "/interviews/42",
+			body:   `{"candidate": "@other"}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42", Status: scheduled}, nil)
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error": "vacancy, candidate and duration of scheduled interview cannot be changed"}`,
+		},
+		{
+			name:   "patch zoom of scheduled",
+			method: http.MethodPatch,
+			target: "/interviews/42",
+			body:   `{"zoom": "https://zoom.us/j/1"}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				zoom := "https://zoom.us/j/1"
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42", Status: scheduled}, nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, &zoom, nil, nil, nil).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "patch negative min grade",
+			method:     http.MethodPatch,
+			target:     "/interviews/42",
+			body:       `{"min_grade": -1}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "min grade must be between 0 and 4"}`,
+		},
+		{
+			name:       "create without candidate",
+			method:     http.MethodPost,
+			target:     "/interviews",
+			body:       `{"vacancy": "go"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "vacancy and candidate must be provided"}`,
+		},
+		{
+			name:   "get missing",
+			method: http.MethodGet,
+			target: "/interviews/42",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "interview not found"}`,
+		},
+		{
+			name:   "cancel not scheduled",
+			method: http.MethodPost,
+			target: "/interviews/42/cancel",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42"}, nil)
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error": "interview is not scheduled"}`,
+		},
+		{
+			name:       "reschedule to the past",
+			method:     http.MethodPost,
+			target:     "/interviews/42/reschedule",
+			body:       `{"start": 100}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "start must be in the future"}`,
+		},
+		{
+			name:   "set panel",
+			method: http.MethodPut,
+			target: "/interviews/42/panel",
+			body:   `{"interviewers": 2, "shadows": 1}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{ID: "42"}, nil)
+				i.EXPECT().SetPanel(gomock.Any(), "42", models.Panel{Interviewers: 2, Shadows: 1}).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"interviewers": 2, "shadows": 1}`,
+		},
+		{
+			name:       "set too large panel",
+			method:     http.MethodPut,
+			target:     "/interviews/42/panel",
+			body:       `{"interviewers": 4, "shadows": 2}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "panel must not have more than 5 members"}`,
+		},
+		{
+			name:   "set panel of missing interview",
+			method: http.MethodPut,
+			target: "/interviews/42/panel",
+			body:   `{"interviewers": 2}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "interview not found"}`,
+		},
+		{
+			name:   "done",
+			method: http.MethodPost,
+			target: "/interviews/42/done",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(ended, nil)
+				i.EXPECT().Done(gomock.Any(), "42").Return(nil)
+			},
+			prepareNotifier: func(n *MocknotifierApi) {
+				n.EXPECT().NotifyFinished(gomock.Any(), ended).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "done before the meeting ends",
+			method: http.MethodPost,
+			target: "/interviews/42/done",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{
+					ID:     "42",
+					Status: scheduled,
+					Meet:   &[2]int64{0, time.Now().Add(time.Hour).UnixMilli()},
+				}, nil)
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error": "interview has not ended yet"}`,
+		},
+		{
+			name:   "done missing",
+			method: http.MethodPost,
+			target: "/interviews/42/done",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "interview not found"}`,
+		},
+		{
+			name:   "scorecard",
+			method: http.MethodGet,
+			target: "/interviews/42/scorecard",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(&models.Interview{
+					ID:     "42",
+					Status: models.InterviewStatusFinished,
+					Scorecard: &models.Scorecard{
+						Ratings:     []models.Rating{{Criterion: "code", Score: 4}},
+						Hire:        true,
+						Comment:     "good",
+						SubmittedAt: 100,
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"ratings": [{"criterion": "code", "score": 4}], "hire": true, "comment": "good", "submitted_at": 100}`,
+		},
+		{
+			name:   "scorecard not submitted",
+			method: http.MethodGet,
+			target: "/interviews/42/scorecard",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").
+					Return(&models.Interview{ID: "42", Status: models.InterviewStatusFinished}, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "scorecard not submitted"}`,
+		},
+		{
+			name:   "repo failure",
+			method: http.MethodGet,
+			target: "/interviews/42",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().Find(gomock.Any(), "42").Return(nil, context.DeadlineExceeded)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error": "internal error"}`,
+		},
+	})
+}
+
+func TestServer_vacancies(t *testing.T) {
+	runServerTests(t, nil, []testcase{
+		{
+			name:   "list empty",
+			method: http.MethodGet,
+			target: "/vacancies",
+			prepareVacancies: func(v *MockvacanciesApi) {
+				v.EXPECT().List(gomock.Any()).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[]`,
+		},
+		{
+			name:   "put",
+			method: http.MethodPut,
+			target: "/vacancies/go",
+			body: `{"title": "Go developer", "interviewers": ["@alice"], "min_grade": 2, "skills": ["Go", "#postgres"],
+				"duration": "1h", "stages": [{"name": "screening", "duration": 1800000000000}, {"name": "tech"}]}`,
+			prepareVacancies: func(v *MockvacanciesApi) {
+				v.EXPECT().Upsert(gomock.Any(), models.Vacancy{
+					ID:           "go",
+					Title:        "Go developer",
+					Interviewers: []string{"alice"},
+					MinGrade:     2,
+					Skills:       []string{"go", "postgres"},
+					Duration:     time.Hour,
+					Stages:       []models.Stage{{Name: "screening", Duration: 30 * time.Minute}, {Name: "tech"}},
+				}).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: `{"id": "go", "title": "Go developer", "interviewers": ["alice"], "min_grade": 2,
+				"skills": ["go", "postgres"],
+				"duration": 3600000000000, "zoom": "", "stages": [{"name": "screening", "duration": 1800000000000},
+				{"name": "tech", "duration": 0}]}`,
+		},
+		{
+			name:       "put with negative duration",
+			method:     http.MethodPut,
+			target:     "/vacancies/go",
+			body:       `{"duration": -1}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "duration must be between 1m0s and 24h0m0s"}`,
+		},
+		{
+			name:       "put with sub-minute stage duration",
+			method:     http.MethodPut,
+			target:     "/vacancies/go",
+			body:       `{"stages": [{"name": "tech", "duration": "30s"}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "stage \"tech\" duration must be between 1m0s and 24h0m0s"}`,
+		},
+		{
+			name:       "put stage without name",
+			method:     http.MethodPut,
+			target:     "/vacancies/go",
+			body:       `{"stages": [{"duration": "1h"}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "stage 1 has no name"}`,
+		},
+		{
+			name:   "delete missing",
+			method: http.MethodDelete,
+			target: "/vacancies/go",
+			prepareVacancies: func(v *MockvacanciesApi) {
+				v.EXPECT().Delete(gomock.Any(), "go").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "vacancy not found"}`,
+		},
+		{
+			name:   "create interview with vacancy defaults",
+			method: http.MethodPost,
+			target: "/interviews",
+			body:   `{"vacancy": "go", "candidate": "cand"}`,
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				zoom := "https://zoom.us/j/1"
+				i.EXPECT().Create(gomock.Any(), "go", "cand", 45*time.Minute).Return("42", nil)
+				i.EXPECT().Update(gomock.Any(), "42", nil, nil, nil, &zoom, nil, nil, nil).Return(nil)
+			},
+			prepareVacancies: func(v *MockvacanciesApi) {
+				v.EXPECT().Get(gomock.Any(), "go").Return(&models.Vacancy{
+					ID:       "go",
+					Duration: 45 * time.Minute,
+					Zoom:     "https://zoom.us/j/1",
+				}, nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id": "42"}`,
+		},
+	})
+}
+
+func TestServer_applications(t *testing.T) {
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	s := NewServer(Config{}, zap.NewNop().Sugar(), client, scheduling.New(client), nil, NewRequestIDGetter(""), nil).(*server)
+
+	do := func(method, target, body string) (int, map[string]any) {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := s.http.Test(req)
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+		return resp.StatusCode, got
+	}
+
+	status, got := do(http.MethodPost, "/applications", `{"vacancy": "go", "candidate": "@cand"}`)
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, "vacancy not found", got["error"])
+
+	require.NoError(t, client.Vacancies().Upsert(context.Background(), models.Vacancy{ID: "go"}))
+
+	status, got = do(http.MethodPost, "/applications", `{"vacancy": "go", "candidate": "@cand"}`)
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, "cand", got["candidate"])
+	require.Len(t, got["interviews"], 1)
+	id := got["id"].(string)
+
+	status, _ = do(http.MethodPost, "/applications", `{"vacancy": "go", "candidate": "cand"}`)
+	require.Equal(t, http.StatusConflict, status)
+
+	status, got = do(http.MethodPost, "/applications/"+id+"/withdraw", "")
+	require.Equal(t, http.StatusOK, status)
+	require.EqualValues(t, models.ApplicationStatusWithdrawn, got["status"])
+
+	status, got = do(http.MethodPost, "/applications/"+id+"/withdraw", "")
+	require.Equal(t, http.StatusConflict, status)
+	require.Equal(t, "application is not active", got["error"])
+
+	status, got = do(http.MethodGet, "/applications?status=lost", "")
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "status must be one of active, passed, rejected, withdrawn", got["error"])
+}
+
+func TestServer_notifications(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := scheduling.New(client)
+
+	ctrl := gomock.NewController(t)
+	nMock := NewMocknotifierApi(ctrl)
+	s := NewServer(Config{}, zap.NewNop().Sugar(), client, sched, nMock, NewRequestIDGetter(""), nil).(*server)
+
+	do := func(method, target, body string) int {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := s.http.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	grade := models.GradeJunior
+	_, err := client.Users().Upsert(ctx, "int", nil, nil, &grade)
+	require.NoError(t, err)
+	_, err = client.Users().Upsert(ctx, "cand", nil, nil, nil)
+	require.NoError(t, err)
+
+	// book creates interview scheduled in the given number of hours, candidate is the same for all
+	book := func(hours time.Duration) *models.Interview {
+		id, err := sched.CreateInterview(ctx, "go", "cand", time.Hour)
+		require.NoError(t, err)
+
+		interview, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+
+		start := time.Now().Add(hours * time.Hour).UnixMilli()
+		_, _, err = sched.Book(ctx, interview, models.Meeting{start, start + time.Hour.Milliseconds()})
+		require.NoError(t, err)
+
+		interview, err = client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+		return interview
+	}
+
+	t.Run("reschedule", func(t *testing.T) {
+		interview := book(24)
+		start := interview.Meet[0] + 2*time.Hour.Milliseconds()
+		meet := models.Meeting{start, start + time.Hour.Milliseconds()}
+
+		lead := gomock.Cond(func(x any) bool { return x.(models.User).Username == "int" })
+		nMock.EXPECT().NotifyRescheduled(gomock.Any(), interview, lead, gomock.Len(0), meet).Return(nil)
+
+		status := do(http.MethodPost, "/interviews/"+interview.ID+"/reschedule", fmt.Sprintf(`{"start": %d}`, start))
+		require.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("reschedule is rolled back if not notified", func(t *testing.T) {
+		interview := book(28)
+		start := interview.Meet[0] + 4*time.Hour.Milliseconds()
+
+		nMock.EXPECT().NotifyRescheduled(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("mock"))
+
+		status := do(http.MethodPost, "/interviews/"+interview.ID+"/reschedule", fmt.Sprintf(`{"start": %d}`, start))
+		require.Equal(t, http.StatusInternalServerError, status)
+
+		found, err := client.Interviews().Find(ctx, interview.ID)
+		require.NoError(t, err)
+		require.Equal(t, interview.Meet, found.Meet)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		interview := book(36)
+		nMock.EXPECT().NotifyCancelled(gomock.Any(), interview).Return(nil)
+
+		status := do(http.MethodPost, "/interviews/"+interview.ID+"/cancel", "")
+		require.Equal(t, http.StatusOK, status)
+
+		// nobody is notified twice
+		status = do(http.MethodPost, "/interviews/"+interview.ID+"/cancel", "")
+		require.Equal(t, http.StatusConflict, status)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		interview := book(40)
+		nMock.EXPECT().NotifyDeleted(gomock.Any(), interview).Return(nil)
+
+		status := do(http.MethodDelete, "/interviews/"+interview.ID, "")
+		require.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("delete not scheduled", func(t *testing.T) {
+		id, err := sched.CreateInterview(ctx, "go", "cand", time.Hour)
+		require.NoError(t, err)
+
+		status := do(http.MethodDelete, "/interviews/"+id, "")
+		require.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("revoke interviewer", func(t *testing.T) {
+		// interviews moved by the first cases are still scheduled
+		scheduled := gomock.Cond(func(x any) bool { return x.(*models.Interview).InterviewerUN == "int" })
+		nMock.EXPECT().NotifyCancelled(gomock.Any(), scheduled).Return(nil).Times(2)
+
+		status := do(http.MethodDelete, "/users/int/interviewer", "")
+		require.Equal(t, http.StatusOK, status)
+	})
+}
+
+func TestServer_reports(t *testing.T) {
+	finished := models.InterviewStatusFinished
+	vacancy := "go"
+
+	runServerTests(t, nil, []testcase{
+		{
+			name:   "outcomes",
+			method: http.MethodGet,
+			target: "/reports/outcomes?vacancy=go",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().List(gomock.Any(), models.InterviewsFilter{Status: &finished, Vacancy: &vacancy}).
+					Return([]*models.Interview{
+						{InterviewerUN: "dog", Outcome: models.OutcomeDone},
+						{InterviewerUN: "cat", Outcome: models.OutcomeCandidateNoShow},
+						{InterviewerUN: "dog", Outcome: models.OutcomeDone},
+						{InterviewerUN: "cat"},
+					}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: `[
+				{"interviewer": "cat", "total": 2, "outcomes": {
+					"unknown": 1, "done": 0, "candidate_no_show": 1, "interviewer_no_show": 0, "disputed": 0
+				}},
+				{"interviewer": "dog", "total": 2, "outcomes": {
+					"unknown": 0, "done": 2, "candidate_no_show": 0, "interviewer_no_show": 0, "disputed": 0
+				}}
+			]`,
+		},
+		{
+			name:   "no finished interviews",
+			method: http.MethodGet,
+			target: "/reports/outcomes",
+			prepare: func(i *MockinterviewsApi, _ *MockusersApi) {
+				i.EXPECT().List(gomock.Any(), models.InterviewsFilter{Status: &finished}).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[]`,
+		},
+	})
+}
+
+func TestServer_users(t *testing.T) {
+	user := models.User{
+		Username: "int",
+		IntGrade: 1,
+		Assigned: []models.Meeting{{100, 200}},
+	}
+	userJSON, _ := json.Marshal(user)
+
+	runServerTests(t, nil, []testcase{
+		{
+			name:   "list interviewers",
+			method: http.MethodGet,
+			target: "/users?interviewer=true&category=employee",
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				employee := models.EmployeeUser
+				u.EXPECT().List(gomock.Any(), models.UsersFilter{
+					Category:         &employee,
+					InterviewersOnly: true,
+				}).Return([]models.User{user}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "[" + string(userJSON) + "]",
+		},
+		{
+			name:   "get with assigned meetings",
+			method: http.MethodGet,
+			target: "/users/@int",
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().Get(gomock.Any(), "int").Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   string(userJSON),
+		},
+		{
+			name:   "get missing",
+			method: http.MethodGet,
+			target: "/users/nobody",
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().Get(gomock.Any(), "nobody").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "user not found"}`,
+		},
+		{
+			name:   "grant interviewer",
+			method: http.MethodPut,
+			target: "/users/int/interviewer",
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				grade := models.GradeJunior
+				u.EXPECT().Upsert(gomock.Any(), "int", nil, nil, &grade).Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "grant interviewer grade",
+			method: http.MethodPut,
+			target: "/users/int/interviewer",
+			body:   `{"grade": 4}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				grade := models.GradeStaff
+				u.EXPECT().Upsert(gomock.Any(), "int", nil, nil, &grade).Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "grant unknown grade",
+			method:     http.MethodPut,
+			target:     "/users/int/interviewer",
+			body:       `{"grade": 0}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "grade must be between 1 and 4"}`,
+		},
+		{
+			name:   "set notifications",
+			method: http.MethodPut,
+			target: "/users/int/notifications",
+			body:   `{"email": "int@example.com", "channels": ["email", "telegram"]}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().SetNotifications(gomock.Any(), "int", models.Notifications{
+					Email:    "int@example.com",
+					Channels: []models.NotificationChannel{models.ChannelEmail, models.ChannelTelegram},
+				}).Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   string(userJSON),
+		},
+		{
+			name:       "set email channel without email",
+			method:     http.MethodPut,
+			target:     "/users/int/notifications",
+			body:       `{"channels": ["email"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "email must be provided for email channel"}`,
+		},
+		{
+			name:   "set notifications of missing user",
+			method: http.MethodPut,
+			target: "/users/nobody/notifications",
+			body:   `{"channels": ["webhook"]}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().SetNotifications(gomock.Any(), "nobody", gomock.Any()).Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "user not found"}`,
+		},
+		{
+			name:   "set time zone",
+			method: http.MethodPut,
+			target: "/users/int/timezone",
+			body:   `{"timeZone": "Asia/Yerevan"}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().SetTimeZone(gomock.Any(), "int", "Asia/Yerevan").Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   string(userJSON),
+		},
+		{
+			name:       "set unknown time zone",
+			method:     http.MethodPut,
+			target:     "/users/int/timezone",
+			body:       `{"timeZone": "Mars/Olympus"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "unknown time zone \"Mars/Olympus\""}`,
+		},
+		{
+			name:   "set limits",
+			method: http.MethodPut,
+			target: "/users/int/limits",
+			body:   `{"perDay": 2, "perWeek": 8, "bufferAfter": 900000000000}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				limits := models.Limits{PerDay: 2, PerWeek: 8, BufferAfter: 15 * time.Minute}
+				u.EXPECT().SetLimits(gomock.Any(), "int", limits).Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   string(userJSON),
+		},
+		{
+			name:       "set negative limits",
+			method:     http.MethodPut,
+			target:     "/users/int/limits",
+			body:       `{"perDay": -1}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "limits must not be negative"}`,
+		},
+		{
+			name:       "set too long buffer",
+			method:     http.MethodPut,
+			target:     "/users/int/limits",
+			body:       `{"bufferBefore": 36000000000000}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "buffers must be between 0 and 2h0m0s"}`,
+		},
+		{
+			name:   "set skills",
+			method: http.MethodPut,
+			target: "/users/int/skills",
+			body:   `{"skills": ["#Go", "ml", "go"]}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().SetSkills(gomock.Any(), "int", []string{"go", "ml"}).Return(&user, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   string(userJSON),
+		},
+		{
+			name:       "set skill of two words",
+			method:     http.MethodPut,
+			target:     "/users/int/skills",
+			body:       `{"skills": ["machine learning"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error": "skill \"machine learning\" must be a single word"}`,
+		},
+		{
+			name:   "set time zone of missing user",
+			method: http.MethodPut,
+			target: "/users/nobody/timezone",
+			body:   `{"timeZone": ""}`,
+			prepare: func(_ *MockinterviewsApi, u *MockusersApi) {
+				u.EXPECT().SetTimeZone(gomock.Any(), "nobody", "").Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "user not found"}`,
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			target:     "/unknown",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error": "Cannot GET /unknown"}`,
+		},
+	})
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	auth, err := NewAuthorizer(AuthConfig{Type: AuthAPIKey, Keys: []string{"key"}})
+	require.NoError(t, err)
+
+	runServerTests(t, auth, []testcase{
+		{
+			name:       "without key",
+			method:     http.MethodGet,
+			target:     "/interviews",
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"error": "unauthorized"}`,
+		},
+	})
+}
+
+func TestServer_Mount(t *testing.T) {
+	auth, err := NewAuthorizer(AuthConfig{Type: AuthAPIKey, Keys: []string{"key"}})
+	require.NoError(t, err)
+
+	s := NewServer(Config{}, zap.NewNop().Sugar(), nil, scheduling.New(nil), nil, NewRequestIDGetter(""), auth).(*server)
+	s.Mount("/hook", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, "update", string(body))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	resp, err := s.http.Test(httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader("update")))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode, "mounted handler bypasses authorization")
+}
@@ -0,0 +1,18 @@
+package hr
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// openAPISpec describes all routes of the server, pkg/hrclient is generated from it
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+func (s *server) handleSpec(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "application/yaml")
+	return c.Status(http.StatusOK).Send(openAPISpec)
+}
@@ -0,0 +1,126 @@
+package hr
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/hrclient"
+)
+
+func TestSpec_matchesRoutes(t *testing.T) {
+	var spec struct {
+		Paths map[string]map[string]any `yaml:"paths"`
+	}
+	require.NoError(t, yaml.Unmarshal(openAPISpec, &spec))
+
+	var documented []string
+	for path, item := range spec.Paths {
+		for method := range item {
+			switch method {
+			case "get", "post", "put", "patch", "delete":
+				documented = append(documented, strings.ToUpper(method)+" "+path)
+			}
+		}
+	}
+
+	s := NewServer(Config{}, zap.NewNop().Sugar(), nil, scheduling.New(nil), nil, NewRequestIDGetter(""), nil).(*server)
+
+	var registered []string
+	for _, route := range s.http.GetRoutes(true) {
+		if route.Method == http.MethodHead {
+			continue
+		}
+
+		segments := strings.Split(route.Path, "/")
+		for i, segment := range segments {
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "{" + segment[1:] + "}"
+			}
+		}
+
+		registered = append(registered, route.Method+" "+strings.Join(segments, "/"))
+	}
+
+	slices.Sort(documented)
+	slices.Sort(registered)
+	require.Equal(t, documented, registered)
+}
+
+func TestSpec_client(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rMock := NewMockrepoClient(ctrl)
+	iMock := NewMockinterviewsApi(ctrl)
+	uMock := NewMockusersApi(ctrl)
+	rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+	rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+	s := NewServer(Config{}, zap.NewNop().Sugar(), rMock, scheduling.New(rMock), nil, NewRequestIDGetter(""), nil).(*server)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = s.http.Listener(ln) }()
+	t.Cleanup(func() { _ = s.http.Shutdown() })
+
+	client, err := hrclient.NewClientWithResponses("http://" + ln.Addr().String())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("list interviews", func(t *testing.T) {
+		scheduled := models.InterviewStatusScheduled
+		vacancy := "go"
+		meet := [2]int64{100, 200}
+
+		iMock.EXPECT().
+			List(gomock.Any(), models.InterviewsFilter{Status: &scheduled, Vacancy: &vacancy}).
+			Return([]*models.Interview{{
+				ID:          "42",
+				Vacancy:     vacancy,
+				CandidateUN: "cand",
+				Status:      scheduled,
+				Meet:        &meet,
+			}}, nil)
+
+		status := hrclient.Scheduled
+		resp, err := client.ListInterviewsWithResponse(ctx, &hrclient.ListInterviewsParams{
+			Status:  &status,
+			Vacancy: &vacancy,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, *resp.JSON200, 1)
+
+		got := (*resp.JSON200)[0]
+		require.Equal(t, "42", got.Id)
+		require.Equal(t, "cand", got.Candidate)
+		require.Equal(t, int(scheduled), got.Status)
+		require.Equal(t, hrclient.Meeting{100, 200}, *got.Meet)
+	})
+
+	t.Run("get missing user", func(t *testing.T) {
+		uMock.EXPECT().Get(gomock.Any(), "nobody").Return(nil, nil)
+
+		resp, err := client.GetUserWithResponse(ctx, "nobody")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+		require.Equal(t, "user not found", resp.JSON404.Error)
+	})
+
+	t.Run("serves spec", func(t *testing.T) {
+		resp, err := http.Get("http://" + ln.Addr().String() + "/openapi.yaml")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
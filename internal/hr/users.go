@@ -0,0 +1,320 @@
+package hr
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+var userCategories = map[string]models.UserCategory{
+	"external": models.ExternalUser,
+	"employee": models.EmployeeUser,
+	"hr":       models.HRUser,
+}
+
+func (s *server) handleListUsers(c *fiber.Ctx) error {
+	var filter models.UsersFilter
+
+	if name := c.Query("category"); name != "" {
+		category, ok := userCategories[name]
+		if !ok {
+			return jsonError(c, http.StatusBadRequest, "category must be one of external, employee, hr")
+		}
+		filter.Category = &category
+	}
+
+	filter.InterviewersOnly = c.QueryBool("interviewer", false)
+
+	users, err := s.repo.Users().List(c.Context(), filter)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.List request")
+	}
+
+	if users == nil {
+		users = []models.User{}
+	}
+
+	return c.Status(http.StatusOK).JSON(users)
+}
+
+func (s *server) handleGetUser(c *fiber.Ctx) error {
+	user, err := s.repo.Users().Get(c.Context(), usernameParam(c))
+	if err != nil {
+		return errors.WrapFail(err, "do Users.Get request")
+	}
+
+	if user == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(user)
+}
+
+func (s *server) handleUserInterviews(c *fiber.Ctx) error {
+	interviews, err := s.repo.Interviews().FindByUser(c.Context(), usernameParam(c))
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.FindByUser request")
+	}
+
+	if interviews == nil {
+		interviews = []*models.Interview{}
+	}
+
+	return c.Status(http.StatusOK).JSON(interviews)
+}
+
+func (s *server) handleGrantInterviewer(c *fiber.Ctx) error {
+	// body is optional, junior grade is granted by default
+	req := struct {
+		Grade int `json:"grade"`
+	}{Grade: models.GradeJunior}
+
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&req)
+		if err != nil {
+			return jsonError(c, http.StatusBadRequest, "malformed body")
+		}
+	}
+
+	err := models.ValidateGrade(req.Grade)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	_, err = s.repo.Users().Upsert(c.Context(), usernameParam(c), nil, nil, &req.Grade)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.Upsert request")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+func (s *server) handleRevokeInterviewer(c *fiber.Ctx) error {
+	var (
+		old       *models.User
+		cancelled []*models.Interview
+	)
+
+	err := s.withTxn(c.Context(), func(ctx context.Context) error {
+		var err error
+		old, cancelled, err = s.sched.RevokeInterviewer(ctx, usernameParam(c))
+		if err != nil {
+			return errors.WrapFail(err, "revoke interviewer")
+		}
+
+		for _, interview := range cancelled {
+			err = s.note.NotifyCancelled(ctx, interview)
+			if err != nil {
+				return errors.WrapFail(err, "notify about cancel of %s", interview.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if old == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	ids := make([]string, 0, len(cancelled))
+	for _, interview := range cancelled {
+		ids = append(ids, interview.ID)
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"cancelled": ids})
+}
+
+func (s *server) handleSetNotifications(c *fiber.Ctx) error {
+	var notifications models.Notifications
+	err := c.BodyParser(&notifications)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	err = notifications.Validate()
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := s.repo.Users().SetNotifications(c.Context(), usernameParam(c), notifications)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.SetNotifications request")
+	}
+
+	if updated == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(updated)
+}
+
+func (s *server) handleSetLimits(c *fiber.Ctx) error {
+	var limits models.Limits
+	err := c.BodyParser(&limits)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	err = limits.Validate()
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := s.repo.Users().SetLimits(c.Context(), usernameParam(c), limits)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.SetLimits request")
+	}
+
+	if updated == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(updated)
+}
+
+func (s *server) handleSetSkills(c *fiber.Ctx) error {
+	var req struct {
+		Skills []string `json:"skills"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	skills := models.NormalizeSkills(req.Skills)
+	err = models.ValidateSkills(skills)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := s.repo.Users().SetSkills(c.Context(), usernameParam(c), skills)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.SetSkills request")
+	}
+
+	if updated == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(updated)
+}
+
+func (s *server) handleSetTimeZone(c *fiber.Ctx) error {
+	var req struct {
+		TimeZone string `json:"timeZone"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	_, err = models.LoadLocation(req.TimeZone)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := s.repo.Users().SetTimeZone(c.Context(), usernameParam(c), req.TimeZone)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.SetTimeZone request")
+	}
+
+	if updated == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(updated)
+}
+
+func (s *server) handleUpsertEmployee(c *fiber.Ctx) error {
+	var req struct {
+		TG string `json:"tg"`
+		HR bool   `json:"hr"`
+	}
+
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	tg := strings.TrimPrefix(req.TG, "@")
+	if tg == "" {
+		return jsonError(c, http.StatusBadRequest, "tg must be provided")
+	}
+
+	cat := models.EmployeeUser
+	if req.HR {
+		cat = models.HRUser
+	}
+
+	_, err = s.repo.Users().Upsert(c.Context(), tg, nil, &cat, nil)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.Upsert request")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+func (s *server) handleGetAvailability(c *fiber.Ctx) error {
+	username := c.Query("username", "")
+	if username == "" {
+		return jsonError(c, http.StatusBadRequest, "username param \"username\" must be provided")
+	}
+
+	user, err := s.repo.Users().Get(c.Context(), username)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.Get request")
+	}
+
+	if user == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	availability := user.Availability
+	if availability == nil {
+		availability = &models.Availability{}
+	}
+
+	return c.Status(http.StatusOK).JSON(availability)
+}
+
+func (s *server) handleSetAvailability(c *fiber.Ctx) error {
+	username := c.Query("username", "")
+	if username == "" {
+		return jsonError(c, http.StatusBadRequest, "username param \"username\" must be provided")
+	}
+
+	var availability models.Availability
+	err := c.BodyParser(&availability)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	err = availability.Validate()
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := s.repo.Users().SetAvailability(c.Context(), username, &availability)
+	if err != nil {
+		return errors.WrapFail(err, "do Users.SetAvailability request")
+	}
+
+	if updated == nil {
+		return jsonError(c, http.StatusNotFound, "user not found")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
+
+func usernameParam(c *fiber.Ctx) string {
+	return strings.TrimPrefix(c.Params("username"), "@")
+}
@@ -0,0 +1,102 @@
+package hr
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// vacancyRequest is a body of vacancy upsert, durations are Go ones like "1h30m" or nanoseconds
+type vacancyRequest struct {
+	Title        string   `json:"title"`
+	Interviewers []string `json:"interviewers"`
+	MinGrade     int      `json:"min_grade"`
+	Skills       []string `json:"skills"`
+	Duration     duration `json:"duration"`
+	Zoom         string   `json:"zoom"`
+	Stages       []struct {
+		Name     string   `json:"name"`
+		Duration duration `json:"duration"`
+	} `json:"stages"`
+}
+
+func (s *server) handleListVacancies(c *fiber.Ctx) error {
+	vacancies, err := s.repo.Vacancies().List(c.Context())
+	if err != nil {
+		return errors.WrapFail(err, "do Vacancies.List request")
+	}
+
+	if vacancies == nil {
+		vacancies = []models.Vacancy{}
+	}
+
+	return c.Status(http.StatusOK).JSON(vacancies)
+}
+
+func (s *server) handleGetVacancy(c *fiber.Ctx) error {
+	vacancy, err := s.repo.Vacancies().Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Vacancies.Get request")
+	}
+
+	if vacancy == nil {
+		return jsonError(c, http.StatusNotFound, "vacancy not found")
+	}
+
+	return c.Status(http.StatusOK).JSON(vacancy)
+}
+
+func (s *server) handlePutVacancy(c *fiber.Ctx) error {
+	var req vacancyRequest
+	err := c.BodyParser(&req)
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, "malformed body")
+	}
+
+	vacancy := models.Vacancy{
+		ID:       c.Params("id"),
+		Title:    req.Title,
+		MinGrade: req.MinGrade,
+		Skills:   models.NormalizeSkills(req.Skills),
+		Duration: time.Duration(req.Duration),
+		Zoom:     req.Zoom,
+	}
+
+	for _, stage := range req.Stages {
+		vacancy.Stages = append(vacancy.Stages, models.Stage{Name: stage.Name, Duration: time.Duration(stage.Duration)})
+	}
+
+	for _, username := range req.Interviewers {
+		vacancy.Interviewers = append(vacancy.Interviewers, strings.TrimPrefix(username, "@"))
+	}
+
+	err = vacancy.Validate()
+	if err != nil {
+		return jsonError(c, http.StatusBadRequest, err.Error())
+	}
+
+	err = s.repo.Vacancies().Upsert(c.Context(), vacancy)
+	if err != nil {
+		return errors.WrapFail(err, "do Vacancies.Upsert request")
+	}
+
+	return c.Status(http.StatusOK).JSON(vacancy)
+}
+
+func (s *server) handleDeleteVacancy(c *fiber.Ctx) error {
+	deleted, err := s.repo.Vacancies().Delete(c.Context(), c.Params("id"))
+	if err != nil {
+		return errors.WrapFail(err, "do Vacancies.Delete request")
+	}
+
+	if deleted == nil {
+		return jsonError(c, http.StatusNotFound, "vacancy not found")
+	}
+
+	return c.Status(http.StatusOK).Send(nil)
+}
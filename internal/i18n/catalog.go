@@ -0,0 +1,211 @@
+package i18n
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultLocale = "ru"
+
+//go:embed locales/*.yaml
+var builtin embed.FS
+
+// Config selects the default locale and overrides built-in templates,
+// a locale which is not built in can be added this way too.
+type Config struct {
+	// Default is used when user's locale is unknown or not supported, "ru" by default
+	Default string `yaml:"default"`
+
+	// Templates maps locale to message key to text/template source
+	Templates map[string]map[string]string `yaml:"templates"`
+}
+
+// Catalog renders messages in the locale of the user. Every message is a named
+// template, so messages can include each other with {{template "key" .}}.
+type Catalog struct {
+	fallback string
+	locales  map[string]*template.Template
+}
+
+// New parses built-in messages and the overrides. Messages missing in a locale
+// are taken from the default one.
+func New(cfg Config) (*Catalog, error) {
+	sources, err := loadBuiltin()
+	if err != nil {
+		return nil, err
+	}
+
+	for locale, messages := range cfg.Templates {
+		locale = normalize(locale)
+		if sources[locale] == nil {
+			sources[locale] = make(map[string]string, len(messages))
+		}
+		for key, text := range messages {
+			sources[locale][key] = text
+		}
+	}
+
+	fallback := normalize(cfg.Default)
+	if fallback == "" {
+		fallback = defaultLocale
+	}
+	if sources[fallback] == nil {
+		return nil, errors.Error("no messages for default locale %q", fallback)
+	}
+
+	base, err := parse(template.New(fallback).Option("missingkey=error").Funcs(funcs), sources[fallback])
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse %s messages", fallback)
+	}
+
+	c := &Catalog{
+		fallback: fallback,
+		locales:  map[string]*template.Template{fallback: base},
+	}
+
+	for locale, messages := range sources {
+		if locale == fallback {
+			continue
+		}
+
+		clone, err := base.Clone()
+		if err != nil {
+			return nil, errors.WrapFail(err, "clone %s messages", fallback)
+		}
+
+		c.locales[locale], err = parse(clone, messages)
+		if err != nil {
+			return nil, errors.WrapFail(err, "parse %s messages", locale)
+		}
+	}
+
+	return c, nil
+}
+
+// Default returns the locale used for users whose one is not supported
+func (c *Catalog) Default() string {
+	return c.fallback
+}
+
+// Locales returns supported locales in alphabetical order
+func (c *Catalog) Locales() []string {
+	locales := make([]string, 0, len(c.locales))
+	for locale := range c.locales {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	return locales
+}
+
+// Match returns supported locale for IETF language tag like "en-US",
+// the tag is tried as is and then without region.
+func (c *Catalog) Match(tag string) (string, bool) {
+	tag = normalize(tag)
+	if _, ok := c.locales[tag]; ok {
+		return tag, true
+	}
+
+	lang, _, _ := strings.Cut(tag, "-")
+	if _, ok := c.locales[lang]; ok {
+		return lang, true
+	}
+
+	return "", false
+}
+
+// Render executes message template in the locale, falling back to the default
+// locale if the locale is not supported or the template fails. If the message
+// does not exist at all, its key is returned, so it is noticed without breaking dialogs.
+func (c *Catalog) Render(locale string, key string, data any) string {
+	matched, ok := c.Match(locale)
+	if !ok {
+		matched = c.fallback
+	}
+
+	text, err := execute(c.locales[matched], key, data)
+	if err != nil && matched != c.fallback {
+		text, err = execute(c.locales[c.fallback], key, data)
+	}
+	if err != nil {
+		return key
+	}
+
+	return text
+}
+
+// Words renders message and splits it by spaces, it is for lists like weekday names
+func (c *Catalog) Words(locale string, key string) []string {
+	return strings.Fields(c.Render(locale, key, nil))
+}
+
+func execute(t *template.Template, key string, data any) (string, error) {
+	msg := t.Lookup(key)
+	if msg == nil {
+		return "", errors.Error("no message %q", key)
+	}
+
+	var sb strings.Builder
+	err := msg.Execute(&sb, data)
+	if err != nil {
+		return "", errors.WrapFail(err, "execute %q", key)
+	}
+
+	return sb.String(), nil
+}
+
+func parse(t *template.Template, messages map[string]string) (*template.Template, error) {
+	for key, text := range messages {
+		_, err := t.New(key).Parse(text)
+		if err != nil {
+			return nil, errors.WrapFail(err, "parse %q", key)
+		}
+	}
+	return t, nil
+}
+
+func loadBuiltin() (map[string]map[string]string, error) {
+	files, err := fs.Glob(builtin, "locales/*.yaml")
+	if err != nil {
+		return nil, errors.WrapFail(err, "list built-in locales")
+	}
+
+	sources := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		raw, err := builtin.ReadFile(file)
+		if err != nil {
+			return nil, errors.WrapFail(err, "read %s", file)
+		}
+
+		var messages map[string]string
+		err = yaml.Unmarshal(raw, &messages)
+		if err != nil {
+			return nil, errors.WrapFail(err, "decode %s", file)
+		}
+
+		sources[strings.TrimSuffix(path.Base(file), path.Ext(file))] = messages
+	}
+
+	return sources, nil
+}
+
+// normalize turns "en_US" into "en-us"
+func normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+var funcs = template.FuncMap{
+	"weeks":   func(d time.Duration) int { return int(d.Hours() / 168) },
+	"days":    func(d time.Duration) int { return int(d.Hours() / 24) },
+	"hours":   func(d time.Duration) int { return int(d.Hours()) },
+	"minutes": func(d time.Duration) int { return int(d.Minutes()) },
+	"mod":     func(a, b int) int { return a % b },
+}
@@ -0,0 +1,171 @@
+package i18n
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_builtin(t *testing.T) {
+	sources, err := loadBuiltin()
+	require.NoError(t, err)
+
+	keys := sortedKeys(sources[defaultLocale])
+	require.NotEmpty(t, keys)
+
+	c, err := New(Config{})
+	require.NoError(t, err)
+
+	for locale, messages := range sources {
+		require.Equal(t, keys, sortedKeys(messages), "keys of %s", locale)
+
+		require.Len(t, c.Words(locale, "weekdays"), 7, locale)
+		require.Len(t, c.Words(locale, "calendar.weekdays"), 7, locale)
+		require.Len(t, c.Words(locale, "calendar.months"), 12, locale)
+	}
+}
+
+func TestCatalog_Render(t *testing.T) {
+	type testcase struct {
+		name   string
+		cfg    Config
+		locale string
+		key    string
+		data   any
+		want   string
+	}
+
+	interview := map[string]any{"ID": "42", "Time": "01.06.24 10:00 MSK", "Duration": 90 * time.Minute}
+
+	tests := [...]testcase{
+		{
+			name:   "default locale",
+			key:    "interview.assigned",
+			data:   interview,
+			want:   "Назначили собеседование `42` на 01.06.24 10:00 MSK, продолжительность — 1 ч. 30 мин.",
+			locale: "ru",
+		},
+		{
+			name:   "language tag with region",
+			locale: "en-US",
+			key:    "interview.assigned",
+			data:   interview,
+			want:   "Interview `42` is scheduled at 01.06.24 10:00 MSK, duration — 1 h 30 min",
+		},
+		{
+			name:   "unsupported locale",
+			locale: "de",
+			key:    "duration",
+			data:   time.Hour,
+			want:   "1 ч.",
+		},
+		{
+			name:   "configured default locale",
+			cfg:    Config{Default: "en"},
+			locale: "de",
+			key:    "duration",
+			data:   45 * time.Minute,
+			want:   "45 min",
+		},
+		{
+			name: "overridden message is used by others",
+			cfg: Config{Templates: map[string]map[string]string{
+				"en": {"duration": "{{minutes .}}m"},
+			}},
+			locale: "en",
+			key:    "create.ask_duration",
+			data:   map[string]any{"Default": time.Hour},
+			want:   "Enter interview duration in minutes or «-» to use the default one for the position (60m)",
+		},
+		{
+			name: "added locale falls back to default messages",
+			cfg: Config{Templates: map[string]map[string]string{
+				"DE": {"fail": "Etwas ist schiefgelaufen"},
+			}},
+			locale: "de-AT",
+			key:    "match.busy",
+			want:   "В это время вы заняты",
+		},
+		{
+			name:   "remaining weeks",
+			locale: "en",
+			key:    "remaining",
+			data:   21 * 24 * time.Hour,
+			want:   "3 weeks",
+		},
+		{
+			name:   "remaining minutes",
+			locale: "ru",
+			key:    "remaining",
+			data:   time.Hour,
+			want:   "60 мин.",
+		},
+		{
+			name: "failed override falls back",
+			cfg: Config{Templates: map[string]map[string]string{
+				"en": {"create.done": "{{.Missing}}"},
+			}},
+			locale: "en",
+			key:    "create.done",
+			data:   map[string]any{"ID": "42"},
+			want:   "Создано собеседование с id `42`",
+		},
+		{
+			name:   "unknown message",
+			locale: "en",
+			key:    "no.such.key",
+			want:   "no.such.key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.cfg)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Render(tt.locale, tt.key, tt.data))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{Default: "de"})
+	require.Error(t, err, "default locale without messages")
+
+	_, err = New(Config{Templates: map[string]map[string]string{"en": {"fail": "{{"}}})
+	require.Error(t, err, "bad template")
+
+	c, err := New(Config{Templates: map[string]map[string]string{"de": {"language.name": "Deutsch"}}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en", "ru"}, c.Locales())
+
+	locale, ok := c.Match("EN_gb")
+	require.True(t, ok)
+	require.Equal(t, "en", locale)
+
+	_, ok = c.Match("fr")
+	require.False(t, ok)
+}
+
+func TestCatalog_Render_usage(t *testing.T) {
+	c, err := New(Config{})
+	require.NoError(t, err)
+
+	candidate := c.Render("en", "usage", map[string]any{"HR": false, "Interviewer": false})
+	require.NotContains(t, candidate, "/availability")
+	require.NotContains(t, candidate, "/create")
+
+	hr := c.Render("en", "usage", map[string]any{"HR": true, "Interviewer": true})
+	require.Contains(t, hr, "/timezone — choose time zone\n/availability")
+	require.Contains(t, hr, "/scorecard — rate a candidate after an interview\n/create")
+}
+
+func sortedKeys(messages map[string]string) []string {
+	keys := make([]string, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
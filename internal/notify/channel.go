@@ -0,0 +1,41 @@
+// Package notify delivers messages to users through different channels.
+package notify
+
+import (
+	"context"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// Channel sends text to the address, whose meaning depends on the channel
+type Channel interface {
+	Send(ctx context.Context, address string, text string) error
+}
+
+// ErrPermanent matches errors which cannot be fixed by retries,
+// e.g. unknown address or a user who blocked the bot
+var ErrPermanent = errors.Error("permanent delivery failure")
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+func (e permanentError) Is(target error) bool {
+	return target == ErrPermanent
+}
+
+// Permanent marks err as not worth retrying
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
@@ -0,0 +1,143 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultEmailSubject = "Meowbot"
+
+type SMTPConfig struct {
+	// Addr is host:port of the server, empty disables email channel
+	Addr string `yaml:"addr"`
+
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+
+	From    string `yaml:"from"`
+	Subject string `yaml:"subject"`
+
+	// DisableStartTLS keeps connection plain even if the server
+	// supports STARTTLS, it is meant for local relays only
+	DisableStartTLS bool `yaml:"disableStartTLS"`
+
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// SMTP sends messages as plain text emails
+type SMTP struct {
+	cfg SMTPConfig
+}
+
+func NewSMTP(cfg SMTPConfig) (*SMTP, error) {
+	if cfg.From == "" {
+		return nil, errors.Error("smtp sender address must be provided")
+	}
+	if cfg.Subject == "" {
+		cfg.Subject = defaultEmailSubject
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &SMTP{cfg: cfg}, nil
+}
+
+func (s *SMTP) Send(ctx context.Context, address string, text string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.cfg.Addr)
+	if err != nil {
+		return errors.WrapFail(err, "connect to smtp server")
+	}
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	host, _, _ := net.SplitHostPort(s.cfg.Addr)
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return errors.WrapFail(err, "greet smtp server")
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok && !s.cfg.DisableStartTLS {
+		err = c.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return errors.WrapFail(err, "start tls")
+		}
+	}
+
+	if s.cfg.Username != "" {
+		err = c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, host))
+		if err != nil {
+			return errors.WrapFail(err, "authenticate")
+		}
+	}
+
+	err = c.Mail(s.cfg.From)
+	if err != nil {
+		return errors.WrapFail(err, "set sender")
+	}
+
+	err = c.Rcpt(address)
+	if err != nil {
+		return errors.WrapFail(classify(err), "set recipient %s", address)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return errors.WrapFail(err, "start data")
+	}
+
+	_, err = w.Write(s.message(address, text))
+	if err != nil {
+		return errors.WrapFail(err, "write message")
+	}
+
+	err = w.Close()
+	if err != nil {
+		return errors.WrapFail(classify(err), "send message")
+	}
+
+	return errors.WrapFail(c.Quit(), "quit")
+}
+
+func (s *SMTP) message(to string, text string) []byte {
+	// messages are written in telegram markdown, inline code marks are useless in emails
+	text = strings.ReplaceAll(text, "`", "")
+	text = strings.ReplaceAll(text, "\n", "\r\n")
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&sb, "To: %s\r\n", to)
+	fmt.Fprintf(&sb, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", s.cfg.Subject))
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(text)
+	sb.WriteString("\r\n")
+	return []byte(sb.String())
+}
+
+// classify makes 5xx replies permanent, 4xx ones are temporary by SMTP
+func classify(err error) error {
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return Permanent(err)
+	}
+	return err
+}
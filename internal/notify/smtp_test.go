@@ -0,0 +1,152 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// fakeSMTP is a local stand-in for SMTP server, it accepts all
+// recipients except rejected and records received messages
+type fakeSMTP struct {
+	net.Listener
+
+	rejected string
+
+	mu       sync.Mutex
+	received []string
+}
+
+func newFakeSMTP(t *testing.T, rejected string) *fakeSMTP {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	s := &fakeSMTP{Listener: l, rejected: rejected}
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250-localhost")
+			_ = tp.PrintfLine("250 8BITMIME")
+		case "RCPT":
+			if s.rejected != "" && strings.Contains(line, s.rejected) {
+				_ = tp.PrintfLine("550 no such user")
+				continue
+			}
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.received = append(s.received, string(data))
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 ok")
+		}
+	}
+}
+
+func (s *fakeSMTP) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.received...)
+}
+
+func TestSMTP_Send(t *testing.T) {
+	type testcase struct {
+		name string
+		to   string
+
+		wantErr       bool
+		wantPermanent bool
+	}
+
+	tests := [...]testcase{
+		{
+			name: "delivered",
+			to:   "cat@example.com",
+		},
+		{
+			name:          "rejected recipient",
+			to:            "ghost@example.com",
+			wantErr:       true,
+			wantPermanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTP(t, "ghost@")
+
+			s, err := NewSMTP(SMTPConfig{
+				Addr:    server.Addr().String(),
+				From:    "bot@example.com",
+				Subject: "Собеседование",
+			})
+			require.NoError(t, err)
+
+			err = s.Send(context.Background(), tt.to, "Собеседование `42` отменено\nУдачи!")
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tt.wantPermanent, errors.Is(err, ErrPermanent))
+				require.Empty(t, server.messages())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, server.messages(), 1)
+
+			msg, err := textproto.NewReader(bufio.NewReader(strings.NewReader(server.messages()[0]))).ReadMIMEHeader()
+			require.NoError(t, err)
+			require.Equal(t, tt.to, msg.Get("To"))
+			require.Equal(t, "bot@example.com", msg.Get("From"))
+			require.Contains(t, server.messages()[0], "Собеседование 42 отменено\nУдачи!")
+		})
+	}
+}
+
+func TestNewSMTP(t *testing.T) {
+	_, err := NewSMTP(SMTPConfig{Addr: "localhost:25"})
+	require.Error(t, err)
+}
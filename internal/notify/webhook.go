@@ -0,0 +1,90 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// SignatureHeader keeps hex HMAC-SHA256 of the body, signed with the webhook secret
+const SignatureHeader = "X-Meowbot-Signature"
+
+type WebhookConfig struct {
+	// URL receives POST requests, empty disables webhook channel
+	URL string `yaml:"url"`
+
+	// Secret signs requests, so the receiver can check they come from the bot
+	Secret string `yaml:"secret"`
+
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// WebhookPayload is a body of webhook request
+type WebhookPayload struct {
+	Username string `json:"username"`
+	Text     string `json:"text"`
+}
+
+// Webhook posts messages to a single URL, which is responsible
+// for delivering them to the user by username
+type Webhook struct {
+	cfg    WebhookConfig
+	client *http.Client
+}
+
+func NewWebhook(cfg WebhookConfig) *Webhook {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &Webhook{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (w *Webhook) Send(ctx context.Context, address string, text string) error {
+	body, err := json.Marshal(WebhookPayload{Username: address, Text: text})
+	if err != nil {
+		return errors.WrapFail(err, "encode payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WrapFail(err, "create request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if w.cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.cfg.Secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.WrapFail(err, "post webhook")
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return errors.Error("webhook responded %s", resp.Status)
+	default:
+		return Permanent(errors.Error("webhook responded %s", resp.Status))
+	}
+}
+
+// Sign returns signature of body for SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+func TestWebhook_Send(t *testing.T) {
+	type testcase struct {
+		name   string
+		status int
+
+		wantErr       bool
+		wantPermanent bool
+	}
+
+	tests := [...]testcase{
+		{name: "delivered", status: http.StatusNoContent},
+		{name: "server error", status: http.StatusBadGateway, wantErr: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, wantErr: true},
+		{name: "unknown user", status: http.StatusNotFound, wantErr: true, wantPermanent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got WebhookPayload
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, Sign("secret", body), r.Header.Get(SignatureHeader))
+				require.NoError(t, json.Unmarshal(body, &got))
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(server.Close)
+
+			hook := NewWebhook(WebhookConfig{URL: server.URL, Secret: "secret"})
+
+			err := hook.Send(context.Background(), "cat", "hello")
+			require.Equal(t, WebhookPayload{Username: "cat", Text: "hello"}, got)
+
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Equal(t, tt.wantPermanent, errors.Is(err, ErrPermanent))
+		})
+	}
+}
@@ -2,27 +2,102 @@ package repo
 
 import (
 	"context"
+	"time"
 
+	memrepo "github.com/nikmy/meowbot/internal/repo/internal/memory"
 	mongorepo "github.com/nikmy/meowbot/internal/repo/internal/mongo"
+	sqliterepo "github.com/nikmy/meowbot/internal/repo/internal/sqlite"
 	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
 	"github.com/nikmy/meowbot/pkg/txn"
 )
 
 type Client interface {
 	Interviews() models.InterviewsRepo
 	Users() models.UsersRepo
+	Dialogs() models.DialogsRepo
+	Outbox() models.OutboxRepo
+	Vacancies() models.VacanciesRepo
+	Applications() models.ApplicationsRepo
 	Close(ctx context.Context) error
 
 	NewSession() (txn.Session, error)
 }
 
+type Backend string
+
+const (
+	BackendMongo  Backend = "mongo"
+	BackendMemory Backend = "memory"
+	BackendSQLite Backend = "sqlite"
+)
+
+// Config selects storage backend, mongo is used by default
+type Config struct {
+	Backend Backend      `yaml:"backend"`
+	Mongo   MongoConfig  `yaml:"mongo"`
+	Memory  MemoryConfig `yaml:"memory"`
+	SQLite  SQLiteConfig `yaml:"sqlite"`
+	Sources Sources      `yaml:"sources"`
+
+	// LegacyUTCDiff is utcDiff of the bot config, meeting times stored
+	// before they were kept in UTC are shifted back by it once
+	LegacyUTCDiff time.Duration `yaml:"-"`
+}
+
 type MongoConfig = mongorepo.Config
 
+type MemoryConfig = memrepo.Config
+
+type SQLiteConfig = sqliterepo.Config
+
+type Sources = mongorepo.Sources
+
+// New creates client of the configured backend
+func New(ctx context.Context, cfg Config) (Client, error) {
+	cfg.Mongo.LegacyUTCDiff = cfg.LegacyUTCDiff
+	cfg.SQLite.LegacyUTCDiff = cfg.LegacyUTCDiff
+
+	switch cfg.Backend {
+	case "", BackendMongo:
+		return NewMongoClient(ctx, cfg.Mongo, cfg.Sources)
+	case BackendMemory:
+		return NewMemoryClient(cfg.Memory), nil
+	case BackendSQLite:
+		return NewSQLiteClient(ctx, cfg.SQLite)
+	default:
+		return nil, errors.Error("unknown repo backend %q", cfg.Backend)
+	}
+}
+
+// Migrate brings storage schema up to date. Backends migrate on open
+// by default, it is for mongo deployments with skipMigrations.
+func Migrate(ctx context.Context, cfg Config) error {
+	cfg.Mongo.SkipMigrations = false
+
+	c, err := New(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	return c.Close(ctx)
+}
+
 func NewMongoClient(
 	ctx context.Context,
 	cfg mongorepo.Config,
-	interviewsSrc string,
-	usersSrc string,
+	sources Sources,
 ) (Client, error) {
-	return mongorepo.NewMongoClient(ctx, cfg, interviewsSrc, usersSrc)
+	return mongorepo.NewMongoClient(ctx, cfg, sources)
+}
+
+// NewMemoryClient creates client keeping data in process memory,
+// it is meant for tests and local development.
+func NewMemoryClient(cfg MemoryConfig) Client {
+	return memrepo.NewMemoryClient(cfg)
+}
+
+// NewSQLiteClient opens SQLite database file, creating and migrating it if needed
+func NewSQLiteClient(ctx context.Context, cfg SQLiteConfig) (Client, error) {
+	return sqliterepo.NewSQLiteClient(ctx, cfg)
 }
@@ -0,0 +1,81 @@
+package repo_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/repotest"
+)
+
+func TestMemoryClient(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repo.Client {
+		return repo.NewMemoryClient(repo.MemoryConfig{})
+	})
+}
+
+func TestSQLiteClient(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repo.Client {
+		c, err := repo.NewSQLiteClient(context.Background(), repo.SQLiteConfig{
+			Path:        filepath.Join(t.TempDir(), "meowbot.db"),
+			BusyTimeout: 100 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		return c
+	})
+}
+
+// TestMongoClient needs mongo replica set for transactions, e.g.
+// MEOWBOT_TEST_MONGO_URL=mongodb://localhost:27017/?replicaSet=rs0
+func TestMongoClient(t *testing.T) {
+	url := os.Getenv("MEOWBOT_TEST_MONGO_URL")
+	if url == "" {
+		t.Skip("MEOWBOT_TEST_MONGO_URL is not set")
+	}
+
+	cfg := repo.MongoConfig{URL: url, Timeout: 5 * time.Second}
+	cfg.Auth.Username = os.Getenv("MEOWBOT_TEST_MONGO_USERNAME")
+	cfg.Auth.Password = os.Getenv("MEOWBOT_TEST_MONGO_PASSWORD")
+
+	sources := repo.Sources{Interviews: "interviews", Users: "users", Dialogs: "dialogs"}
+
+	repotest.Run(t, func(t *testing.T) repo.Client {
+		ctx := context.Background()
+
+		cfg := cfg
+		cfg.Database = fmt.Sprintf("repotest_%d", time.Now().UnixNano())
+
+		c, err := repo.NewMongoClient(ctx, cfg, sources)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			admin, err := mongo.Connect(ctx, options.Client().ApplyURI(url).SetAuth(options.Credential{
+				Username: cfg.Auth.Username,
+				Password: cfg.Auth.Password,
+			}))
+			require.NoError(t, err)
+			defer func() { _ = admin.Disconnect(ctx) }()
+
+			require.NoError(t, admin.Database(cfg.Database).Drop(ctx))
+		})
+
+		return c
+	})
+}
+
+func TestNew(t *testing.T) {
+	c, err := repo.New(context.Background(), repo.Config{Backend: repo.BackendMemory})
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	_, err = repo.New(context.Background(), repo.Config{Backend: "postgres"})
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+type memoryApplications struct {
+	s *store
+}
+
+func (m memoryApplications) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	interview string,
+) (string, error) {
+	id := fmt.Sprintf("%016x", m.s.seq.Add(1))
+
+	err := m.s.do(ctx, func(st state) error {
+		st.applications.put(id, models.Application{
+			ID:         id,
+			Vacancy:    vacancy,
+			Candidate:  candidate,
+			Interviews: []string{interview},
+			Status:     models.ApplicationStatusActive,
+			CreatedAt:  time.Now().UnixMilli(),
+		})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (m memoryApplications) Get(ctx context.Context, id string) (*models.Application, error) {
+	var found *models.Application
+	err := m.s.do(ctx, func(st state) error {
+		if application, ok := st.applications.get(id); ok {
+			found = cloneApplication(application)
+		}
+		return nil
+	})
+	return found, err
+}
+
+func (m memoryApplications) FindByInterview(ctx context.Context, interview string) (*models.Application, error) {
+	found, err := m.filter(ctx, func(a models.Application) bool {
+		return slices.Contains(a.Interviews, interview)
+	})
+	if err != nil || len(found) == 0 {
+		return nil, err
+	}
+
+	return &found[0], nil
+}
+
+func (m memoryApplications) List(ctx context.Context, filter models.ApplicationsFilter) ([]models.Application, error) {
+	return m.filter(ctx, func(a models.Application) bool {
+		switch {
+		case filter.Vacancy != nil && a.Vacancy != *filter.Vacancy:
+			return false
+		case filter.Candidate != nil && a.Candidate != *filter.Candidate:
+			return false
+		case filter.Status != nil && a.Status != *filter.Status:
+			return false
+		}
+		return true
+	})
+}
+
+func (m memoryApplications) Advance(ctx context.Context, id string, interview string) error {
+	return m.updateActive(ctx, id, func(a *models.Application) {
+		a.Stage = len(a.Interviews)
+		a.Interviews = append(a.Interviews, interview)
+	})
+}
+
+func (m memoryApplications) Close(ctx context.Context, id string, status models.ApplicationStatus) error {
+	return m.updateActive(ctx, id, func(a *models.Application) {
+		a.Status = status
+	})
+}
+
+func (m memoryApplications) updateActive(ctx context.Context, id string, patch func(a *models.Application)) error {
+	return m.s.do(ctx, func(st state) error {
+		application, ok := st.applications.get(id)
+		if !ok || application.Status != models.ApplicationStatusActive {
+			return errors.Error("no applications updated")
+		}
+
+		patched := cloneApplication(application)
+		patch(patched)
+		st.applications.put(id, *patched)
+		return nil
+	})
+}
+
+// filter returns copies of matched applications ordered by id
+func (m memoryApplications) filter(
+	ctx context.Context,
+	match func(a models.Application) bool,
+) ([]models.Application, error) {
+	var found []models.Application
+	err := m.s.do(ctx, func(st state) error {
+		for _, application := range st.applications.all() {
+			if match(application) {
+				found = append(found, *cloneApplication(application))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b models.Application) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return found, nil
+}
+
+func cloneApplication(a models.Application) *models.Application {
+	a.Interviews = slices.Clone(a.Interviews)
+	return &a
+}
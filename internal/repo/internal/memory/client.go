@@ -0,0 +1,68 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+type Config struct {
+	// DialogTTL is time after which unfinished bot dialog is forgotten
+	DialogTTL time.Duration `yaml:"dialogTTL"`
+}
+
+// NewMemoryClient creates repo keeping everything in process memory.
+// Data is lost on restart, so it is meant for tests and local development.
+func NewMemoryClient(cfg Config) *memoryClient {
+	s := newStore()
+	return &memoryClient{
+		store:      s,
+		interviews: memoryInterviews{s: s},
+		users:      memoryUsers{s: s},
+		dialogs:    memoryDialogs{s: s, ttl: cfg.DialogTTL},
+		outbox:     memoryOutbox{s: s},
+
+		vacancies:    memoryVacancies{s: s},
+		applications: memoryApplications{s: s},
+	}
+}
+
+type memoryClient struct {
+	store      *store
+	interviews memoryInterviews
+	users      memoryUsers
+	dialogs    memoryDialogs
+	outbox     memoryOutbox
+
+	vacancies    memoryVacancies
+	applications memoryApplications
+}
+
+func (m *memoryClient) Interviews() models.InterviewsRepo {
+	return m.interviews
+}
+
+func (m *memoryClient) Users() models.UsersRepo {
+	return m.users
+}
+
+func (m *memoryClient) Dialogs() models.DialogsRepo {
+	return m.dialogs
+}
+
+func (m *memoryClient) Outbox() models.OutboxRepo {
+	return m.outbox
+}
+
+func (m *memoryClient) Vacancies() models.VacanciesRepo {
+	return m.vacancies
+}
+
+func (m *memoryClient) Applications() models.ApplicationsRepo {
+	return m.applications
+}
+
+func (m *memoryClient) Close(context.Context) error {
+	return nil
+}
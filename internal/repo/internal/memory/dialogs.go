@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+type dialogKey struct {
+	chat int64
+	user int64
+}
+
+// dialog keeps data encoded with bson, so values are decoded
+// exactly the same way as with mongo storage
+type dialog struct {
+	state     string
+	data      map[string]bson.RawValue
+	updatedAt time.Time
+}
+
+type memoryDialogs struct {
+	s   *store
+	ttl time.Duration
+}
+
+func (d memoryDialogs) GetState(ctx context.Context, chatID, userID int64) (string, error) {
+	var found string
+	err := d.s.do(ctx, func(st state) error {
+		found = d.get(st, chatID, userID).state
+		return nil
+	})
+	return found, err
+}
+
+func (d memoryDialogs) SetState(ctx context.Context, chatID, userID int64, state string) error {
+	return d.upsert(ctx, chatID, userID, func(dlg *dialog) {
+		dlg.state = state
+	})
+}
+
+func (d memoryDialogs) ResetState(ctx context.Context, chatID, userID int64, withData bool) error {
+	if withData {
+		return d.s.do(ctx, func(st state) error {
+			st.dialogs.delete(dialogKey{chat: chatID, user: userID})
+			return nil
+		})
+	}
+
+	return d.upsert(ctx, chatID, userID, func(dlg *dialog) {
+		dlg.state = ""
+	})
+}
+
+func (d memoryDialogs) UpdateData(ctx context.Context, chatID, userID int64, key string, data any) error {
+	if data == nil {
+		return d.upsert(ctx, chatID, userID, func(dlg *dialog) {
+			delete(dlg.data, key)
+		})
+	}
+
+	kind, raw, err := bson.MarshalValue(data)
+	if err != nil {
+		return errors.WrapFail(err, "encode dialog data")
+	}
+
+	return d.upsert(ctx, chatID, userID, func(dlg *dialog) {
+		dlg.data[key] = bson.RawValue{Type: kind, Value: raw}
+	})
+}
+
+func (d memoryDialogs) GetData(ctx context.Context, chatID, userID int64, key string, to any) (bool, error) {
+	var (
+		value bson.RawValue
+		found bool
+	)
+
+	err := d.s.do(ctx, func(st state) error {
+		value, found = d.get(st, chatID, userID).data[key]
+		return nil
+	})
+	if err != nil || !found {
+		return false, err
+	}
+
+	err = value.Unmarshal(to)
+	if err != nil {
+		return false, errors.WrapFail(err, "decode dialog data")
+	}
+
+	return true, nil
+}
+
+// get returns empty dialog if it does not exist or has expired
+func (d memoryDialogs) get(st state, chatID, userID int64) dialog {
+	dlg, ok := st.dialogs.get(dialogKey{chat: chatID, user: userID})
+	if !ok || d.ttl > 0 && time.Since(dlg.updatedAt) > d.ttl {
+		return dialog{}
+	}
+	return dlg
+}
+
+func (d memoryDialogs) upsert(ctx context.Context, chatID, userID int64, patch func(dlg *dialog)) error {
+	return d.s.do(ctx, func(st state) error {
+		dlg := d.get(st, chatID, userID)
+		dlg.data = maps.Clone(dlg.data)
+		if dlg.data == nil {
+			dlg.data = make(map[string]bson.RawValue)
+		}
+
+		patch(&dlg)
+		dlg.updatedAt = time.Now()
+
+		st.dialogs.put(dialogKey{chat: chatID, user: userID}, dlg)
+		return nil
+	})
+}
@@ -0,0 +1,376 @@
+package repo
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const upcomingLimit = 1024
+
+type memoryInterviews struct {
+	s *store
+}
+
+func (m memoryInterviews) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	duration time.Duration,
+) (string, error) {
+	randomSuffix := strconv.Itoa(rand.Intn(90) + 10)
+	timestamp := strconv.FormatInt(time.Now().UnixMicro(), 16)
+	id := timestamp + randomSuffix
+
+	err := m.s.do(ctx, func(st state) error {
+		if _, exists := st.interviews.get(id); exists {
+			return errors.Error("duplicate interview id %s", id)
+		}
+
+		st.interviews.put(id, models.Interview{
+			ID:          id,
+			Vacancy:     vacancy,
+			CandidateUN: candidate,
+			Duration:    duration,
+		})
+		return nil
+	})
+	if err != nil {
+		return "", errors.WrapFail(err, "insert interview")
+	}
+
+	return id, nil
+}
+
+func (m memoryInterviews) Delete(ctx context.Context, id string) (*models.Interview, error) {
+	var found *models.Interview
+	err := m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return nil
+		}
+
+		st.interviews.delete(id)
+		found = cloneInterview(interview)
+		return nil
+	})
+	return found, err
+}
+
+func (m memoryInterviews) Update(
+	ctx context.Context,
+	id string,
+	vacancy *string,
+	candidate *string,
+	data *[]byte,
+	zoom *string,
+	duration *time.Duration,
+	minGrade *int,
+	skills *[]string,
+) error {
+	_, err := m.update(ctx, id, func(i *models.Interview) {
+		if vacancy != nil {
+			i.Vacancy = *vacancy
+		}
+		if candidate != nil {
+			i.CandidateUN = *candidate
+			i.CandidateTg = 0
+		}
+		if data != nil {
+			i.Data = slices.Clone(*data)
+		}
+		if zoom != nil {
+			i.Zoom = *zoom
+		}
+		if duration != nil {
+			i.Duration = *duration
+		}
+		if minGrade != nil {
+			i.MinGrade = *minGrade
+		}
+		if skills != nil {
+			i.Skills = slices.Clone(*skills)
+		}
+	})
+	return err
+}
+
+func (m memoryInterviews) Schedule(
+	ctx context.Context,
+	id string,
+	candidate models.User,
+	interviewer models.User,
+	panel []models.Panelist,
+	meet models.Meeting,
+) error {
+	_, err := m.update(ctx, id, func(i *models.Interview) {
+		i.Status = models.InterviewStatusScheduled
+		i.InterviewerTg = interviewer.Telegram
+		i.InterviewerUN = interviewer.Username
+		i.Panelists = slices.Clone(panel)
+		i.CandidateTg = candidate.Telegram
+		i.Meet = (*[2]int64)(&meet)
+		i.LastNotification = nil
+	})
+	return err
+}
+
+func (m memoryInterviews) SetPanel(ctx context.Context, id string, panel models.Panel) error {
+	return m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return errors.Error("no interviews updated")
+		}
+
+		interview.Panel = panel
+		st.interviews.put(id, *cloneInterview(interview))
+		return nil
+	})
+}
+
+func (m memoryInterviews) RemovePanelist(ctx context.Context, id string, username string) error {
+	return m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return errors.Error("no interviews updated")
+		}
+
+		removed := cloneInterview(interview)
+		removed.Panelists = slices.DeleteFunc(removed.Panelists, func(p models.Panelist) bool {
+			return p.Username == username
+		})
+		st.interviews.put(id, *removed)
+		return nil
+	})
+}
+
+func (m memoryInterviews) Notify(ctx context.Context, id string, at int64, notified [2]bool) error {
+	_, err := m.update(ctx, id, func(i *models.Interview) {
+		i.LastNotification = &models.NotificationLog{UnixTime: at, Notified: notified}
+	})
+	return err
+}
+
+func (m memoryInterviews) Find(ctx context.Context, id string) (*models.Interview, error) {
+	var found *models.Interview
+	err := m.s.do(ctx, func(st state) error {
+		if interview, ok := st.interviews.get(id); ok {
+			found = cloneInterview(interview)
+		}
+		return nil
+	})
+	return found, err
+}
+
+func (m memoryInterviews) FindByUser(ctx context.Context, username string) ([]*models.Interview, error) {
+	return m.filter(ctx, 0, func(i models.Interview) bool {
+		return i.CandidateUN == username || i.IsInterviewer(username)
+	})
+}
+
+func (m memoryInterviews) List(ctx context.Context, filter models.InterviewsFilter) ([]*models.Interview, error) {
+	return m.filter(ctx, 0, func(i models.Interview) bool {
+		switch {
+		case filter.Status != nil && i.Status != *filter.Status:
+			return false
+		case filter.Outcome != nil && i.Outcome != *filter.Outcome:
+			return false
+		case filter.Vacancy != nil && i.Vacancy != *filter.Vacancy:
+			return false
+		case filter.Candidate != nil && i.CandidateUN != *filter.Candidate:
+			return false
+		case filter.Interviewer != nil && i.InterviewerUN != *filter.Interviewer:
+			return false
+		case (filter.From != nil || filter.To != nil) && i.Meet == nil:
+			return false
+		case filter.From != nil && i.Meet[0] < *filter.From:
+			return false
+		case filter.To != nil && i.Meet[0] >= *filter.To:
+			return false
+		}
+		return true
+	})
+}
+
+func (m memoryInterviews) GetUpcoming(ctx context.Context, lastNotifyBefore, startsBefore int64) ([]*models.Interview, error) {
+	return m.filter(ctx, upcomingLimit, func(i models.Interview) bool {
+		if i.Status != models.InterviewStatusScheduled || i.Meet == nil || i.Meet[0] >= startsBefore {
+			return false
+		}
+
+		last := i.LastNotification
+		return last == nil ||
+			last.UnixTime < lastNotifyBefore ||
+			!last.Notified[models.RoleInterviewer] ||
+			!last.Notified[models.RoleCandidate]
+	})
+}
+
+func (m memoryInterviews) Cancel(ctx context.Context, id string, side models.Role) error {
+	modified, err := m.update(ctx, id, func(i *models.Interview) {
+		i.Meet = nil
+		i.LastNotification = nil
+		i.InterviewerTg = 0
+		i.InterviewerUN = ""
+		i.Panelists = nil
+		i.Zoom = ""
+		i.Status = models.InterviewStatusCancelled
+		i.CancelledBy = side
+	})
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
+func (m memoryInterviews) Done(ctx context.Context, id string) error {
+	modified, err := m.update(ctx, id, func(i *models.Interview) {
+		i.Status = models.InterviewStatusFinished
+	})
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
+func (m memoryInterviews) Attend(ctx context.Context, id string, attendance [2]models.Attendance) error {
+	return m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return errors.Error("no interviews updated")
+		}
+
+		attended := cloneInterview(interview)
+		attended.Attendance = attendance
+		attended.Outcome = models.ResolveOutcome(attendance)
+		st.interviews.put(id, *attended)
+		return nil
+	})
+}
+
+func (m memoryInterviews) Score(ctx context.Context, id string, scorecard models.Scorecard) error {
+	return m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return errors.Error("no interviews updated")
+		}
+
+		scored := cloneInterview(interview)
+		scored.Scorecard = cloneScorecard(scorecard)
+		st.interviews.put(id, *scored)
+		return nil
+	})
+}
+
+func (m memoryInterviews) FixTg(ctx context.Context, username string, tg int64) error {
+	return m.s.do(ctx, func(st state) error {
+		for _, interview := range st.interviews.all() {
+			fixed := *cloneInterview(interview)
+			if fixed.CandidateUN == username {
+				fixed.CandidateTg = tg
+			}
+			if fixed.InterviewerUN == username {
+				fixed.InterviewerTg = tg
+			}
+
+			if !reflect.DeepEqual(fixed, interview) {
+				st.interviews.put(fixed.ID, fixed)
+			}
+		}
+		return nil
+	})
+}
+
+// update applies patch to a copy of the interview and saves it if anything changed.
+// Returns false if the interview does not exist or the patch is a no-op.
+func (m memoryInterviews) update(ctx context.Context, id string, patch func(i *models.Interview)) (bool, error) {
+	var modified bool
+	err := m.s.do(ctx, func(st state) error {
+		interview, ok := st.interviews.get(id)
+		if !ok {
+			return nil
+		}
+
+		patched := cloneInterview(interview)
+		patch(patched)
+
+		if reflect.DeepEqual(*patched, interview) {
+			return nil
+		}
+
+		st.interviews.put(id, *patched)
+		modified = true
+		return nil
+	})
+	return modified, err
+}
+
+// filter returns copies of matched interviews ordered by id, limit <= 0 means no limit
+func (m memoryInterviews) filter(
+	ctx context.Context,
+	limit int,
+	match func(i models.Interview) bool,
+) ([]*models.Interview, error) {
+	var found []*models.Interview
+	err := m.s.do(ctx, func(st state) error {
+		for _, interview := range st.interviews.all() {
+			if match(interview) {
+				found = append(found, cloneInterview(interview))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b *models.Interview) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	return found, nil
+}
+
+func cloneInterview(i models.Interview) *models.Interview {
+	i.Data = slices.Clone(i.Data)
+	i.Panelists = slices.Clone(i.Panelists)
+	i.Skills = slices.Clone(i.Skills)
+	if i.Meet != nil {
+		meet := *i.Meet
+		i.Meet = &meet
+	}
+	if i.LastNotification != nil {
+		last := *i.LastNotification
+		i.LastNotification = &last
+	}
+	if i.Scorecard != nil {
+		i.Scorecard = cloneScorecard(*i.Scorecard)
+	}
+	return &i
+}
+
+func cloneScorecard(s models.Scorecard) *models.Scorecard {
+	s.Ratings = slices.Clone(s.Ratings)
+	return &s
+}
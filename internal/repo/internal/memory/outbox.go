@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+type memoryOutbox struct {
+	s *store
+}
+
+func (o memoryOutbox) Add(ctx context.Context, to models.Contact, text string) (string, error) {
+	now := time.Now().UnixMilli()
+	id := fmt.Sprintf("%016x", o.s.seq.Add(1))
+
+	err := o.s.do(ctx, func(st state) error {
+		st.outbox.put(id, models.OutboxMessage{
+			ID:          id,
+			Contact:     to,
+			Text:        text,
+			CreatedAt:   now,
+			NextAttempt: now,
+		})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (o memoryOutbox) Pending(ctx context.Context, now int64, limit int) ([]models.OutboxMessage, error) {
+	var found []models.OutboxMessage
+	err := o.s.do(ctx, func(st state) error {
+		for _, msg := range st.outbox.all() {
+			if msg.Status == models.OutboxStatusPending && msg.NextAttempt <= now {
+				found = append(found, msg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b models.OutboxMessage) int {
+		return cmp.Or(cmp.Compare(a.CreatedAt, b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	if len(found) > limit {
+		found = found[:limit]
+	}
+
+	return found, nil
+}
+
+func (o memoryOutbox) Claim(ctx context.Context, id string, at int64, until int64) (bool, error) {
+	var claimed bool
+	err := o.s.do(ctx, func(st state) error {
+		msg, ok := st.outbox.get(id)
+		if !ok || msg.Status != models.OutboxStatusPending || msg.NextAttempt != at {
+			return nil
+		}
+
+		msg.NextAttempt = until
+		msg.Attempts++
+		st.outbox.put(id, msg)
+		claimed = true
+		return nil
+	})
+	return claimed, err
+}
+
+func (o memoryOutbox) Sent(ctx context.Context, id string, at int64) error {
+	return o.update(ctx, id, func(msg *models.OutboxMessage) {
+		msg.Status = models.OutboxStatusSent
+		msg.SentAt = at
+	})
+}
+
+func (o memoryOutbox) Retry(ctx context.Context, id string, reason string, next *int64) error {
+	return o.update(ctx, id, func(msg *models.OutboxMessage) {
+		msg.LastError = reason
+		if next == nil {
+			msg.Status = models.OutboxStatusFailed
+		} else {
+			msg.NextAttempt = *next
+		}
+	})
+}
+
+func (o memoryOutbox) update(ctx context.Context, id string, patch func(msg *models.OutboxMessage)) error {
+	return o.s.do(ctx, func(st state) error {
+		msg, ok := st.outbox.get(id)
+		if !ok {
+			return nil
+		}
+
+		patch(&msg)
+		st.outbox.put(id, msg)
+		return nil
+	})
+}
@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// ErrWriteConflict is returned on commit of txn which has written
+// a document changed by someone else after the txn had started.
+var ErrWriteConflict = errors.Error("write conflict")
+
+type doc[V any] struct {
+	value   V
+	version uint64
+}
+
+// collection keeps documents by key. Stored values are never modified
+// in place, writes always put a new copy, so snapshots are cheap.
+type collection[K comparable, V any] struct {
+	docs map[K]doc[V]
+
+	// clock is a version counter of committed data, nil inside txn
+	clock *uint64
+
+	// written tracks keys changed by txn with their versions
+	// at the moment of the snapshot, nil outside txn
+	written map[K]uint64
+}
+
+func newCollection[K comparable, V any](clock *uint64) *collection[K, V] {
+	return &collection[K, V]{docs: make(map[K]doc[V]), clock: clock}
+}
+
+func (c *collection[K, V]) get(key K) (V, bool) {
+	d, ok := c.docs[key]
+	return d.value, ok
+}
+
+func (c *collection[K, V]) put(key K, value V) {
+	c.docs[key] = doc[V]{value: value, version: c.tick(key)}
+}
+
+func (c *collection[K, V]) delete(key K) {
+	c.tick(key)
+	delete(c.docs, key)
+}
+
+func (c *collection[K, V]) tick(key K) uint64 {
+	if c.written != nil {
+		if _, seen := c.written[key]; !seen {
+			c.written[key] = c.docs[key].version
+		}
+		return 0
+	}
+
+	*c.clock++
+	return *c.clock
+}
+
+func (c *collection[K, V]) all() []V {
+	values := make([]V, 0, len(c.docs))
+	for _, d := range c.docs {
+		values = append(values, d.value)
+	}
+	return values
+}
+
+func (c *collection[K, V]) snapshot() *collection[K, V] {
+	return &collection[K, V]{docs: maps.Clone(c.docs), written: make(map[K]uint64)}
+}
+
+// conflicts reports whether any key written by txn snapshot s
+// has been changed in c after the snapshot was taken.
+func (c *collection[K, V]) conflicts(s *collection[K, V]) bool {
+	for key, version := range s.written {
+		if c.docs[key].version != version {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *collection[K, V]) apply(s *collection[K, V]) {
+	for key := range s.written {
+		d, ok := s.docs[key]
+		if !ok {
+			c.delete(key)
+			continue
+		}
+		c.put(key, d.value)
+	}
+}
+
+type state struct {
+	interviews *collection[string, models.Interview]
+	users      *collection[string, models.User]
+	dialogs    *collection[dialogKey, dialog]
+	outbox     *collection[string, models.OutboxMessage]
+
+	vacancies    *collection[string, models.Vacancy]
+	applications *collection[string, models.Application]
+}
+
+func (s state) snapshot() state {
+	return state{
+		interviews: s.interviews.snapshot(),
+		users:      s.users.snapshot(),
+		dialogs:    s.dialogs.snapshot(),
+		outbox:     s.outbox.snapshot(),
+
+		vacancies:    s.vacancies.snapshot(),
+		applications: s.applications.snapshot(),
+	}
+}
+
+// store is a committed state guarded by mutex. Operations outside txn
+// are applied to it directly, so each of them is atomic.
+type store struct {
+	mu    sync.Mutex
+	clock uint64
+	state state
+
+	// seq numbers outbox messages, it is not rolled back with txn
+	seq atomic.Uint64
+}
+
+func newStore() *store {
+	s := &store{}
+	s.state = state{
+		interviews: newCollection[string, models.Interview](&s.clock),
+		users:      newCollection[string, models.User](&s.clock),
+		dialogs:    newCollection[dialogKey, dialog](&s.clock),
+		outbox:     newCollection[string, models.OutboxMessage](&s.clock),
+
+		vacancies:    newCollection[string, models.Vacancy](&s.clock),
+		applications: newCollection[string, models.Application](&s.clock),
+	}
+	return s
+}
+
+// do runs f over data visible in ctx: snapshot of running txn or committed state
+func (s *store) do(ctx context.Context, f func(st state) error) error {
+	if tx := txnFromContext(ctx); tx != nil {
+		return tx.do(f)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return f(s.state)
+}
+
+func (s *store) snapshot() state {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.snapshot()
+}
+
+// commit applies txn writes unless some of written documents have been
+// changed since txn start (first committer wins)
+func (s *store) commit(snapshot state) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	live := s.state
+	if live.interviews.conflicts(snapshot.interviews) ||
+		live.users.conflicts(snapshot.users) ||
+		live.dialogs.conflicts(snapshot.dialogs) ||
+		live.outbox.conflicts(snapshot.outbox) ||
+		live.vacancies.conflicts(snapshot.vacancies) ||
+		live.applications.conflicts(snapshot.applications) {
+		return ErrWriteConflict
+	}
+
+	live.interviews.apply(snapshot.interviews)
+	live.users.apply(snapshot.users)
+	live.dialogs.apply(snapshot.dialogs)
+	live.outbox.apply(snapshot.outbox)
+	live.vacancies.apply(snapshot.vacancies)
+	live.applications.apply(snapshot.applications)
+	return nil
+}
@@ -0,0 +1,166 @@
+package repo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+type sessionKey struct{}
+
+func (m *memoryClient) NewSession() (txn.Session, error) {
+	return &session{store: m.store}, nil
+}
+
+type session struct {
+	store *store
+
+	mu      sync.Mutex
+	running *memoryTxn
+}
+
+func (s *session) BindContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+func (s *session) Txn() txn.Txn {
+	return &memoryTxn{session: s}
+}
+
+func (s *session) Close(context.Context) {
+	s.mu.Lock()
+	running := s.running
+	s.mu.Unlock()
+
+	if running != nil {
+		running.finish()
+	}
+}
+
+// txnFromContext returns txn running in the session bound to ctx, if any
+func txnFromContext(ctx context.Context) *memoryTxn {
+	s, ok := ctx.Value(sessionKey{}).(*session)
+	if !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// memoryTxn works over a snapshot of the store taken at start,
+// so isolation is always snapshot one regardless of the settings.
+type memoryTxn struct {
+	session *session
+	err     error
+
+	mu       sync.Mutex
+	snapshot state
+	finished bool
+}
+
+func (m *memoryTxn) SetModel(model txn.ConsistencyModel) txn.Txn {
+	if m.err != nil {
+		return m
+	}
+
+	if model > txn.CausalConsistency {
+		m.err = errors.Error("unsupported consistency model")
+	}
+
+	return m
+}
+
+func (m *memoryTxn) SetIsolation(lvl txn.IsolationLevel) txn.Txn {
+	if m.err != nil {
+		return m
+	}
+
+	if lvl > txn.SnapshotIsolation {
+		m.err = errors.Error("unsupported isolation level")
+	}
+
+	return m
+}
+
+func (m *memoryTxn) Start(context.Context) (txn.ActiveTxn, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	s := m.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running != nil {
+		return nil, errors.Error("transaction already in progress")
+	}
+
+	m.snapshot = s.store.snapshot()
+	s.running = m
+	return m, nil
+}
+
+func (m *memoryTxn) Abort(context.Context) error {
+	if !m.finish() {
+		return errors.Error("transaction is already finished")
+	}
+	return nil
+}
+
+func (m *memoryTxn) Commit(context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.finished {
+		return errors.Error("transaction is already finished")
+	}
+
+	err := m.session.store.commit(m.snapshot)
+	m.finishLocked()
+	return err
+}
+
+func (m *memoryTxn) Close(context.Context) error {
+	m.finish()
+	return nil
+}
+
+func (m *memoryTxn) do(f func(st state) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.finished {
+		return errors.Error("transaction is already finished")
+	}
+
+	return f(m.snapshot)
+}
+
+// finish drops txn changes, returns false if it has been finished before
+func (m *memoryTxn) finish() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.finished {
+		return false
+	}
+
+	m.finishLocked()
+	return true
+}
+
+func (m *memoryTxn) finishLocked() {
+	m.finished = true
+	m.snapshot = state{}
+
+	s := m.session
+	s.mu.Lock()
+	if s.running == m {
+		s.running = nil
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,308 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+const matchLimit = 1024
+
+type memoryUsers struct {
+	s *store
+}
+
+func (u memoryUsers) Update(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+) (*models.User, error) {
+	return u.findOneAndUpdate(ctx, username, telegramID, category, intGrade, false)
+}
+
+func (u memoryUsers) Upsert(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+) (*models.User, error) {
+	return u.findOneAndUpdate(ctx, username, telegramID, category, intGrade, true)
+}
+
+// findOneAndUpdate returns user state before the update, like mongo does,
+// so it is nil for a just inserted user.
+func (u memoryUsers) findOneAndUpdate(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+	upsert bool,
+) (*models.User, error) {
+	var old *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok && !upsert {
+			return nil
+		}
+
+		if ok {
+			old = cloneUser(user)
+		}
+
+		updated := cloneUser(user)
+		updated.Username = username
+		if telegramID != nil {
+			updated.Telegram = *telegramID
+		}
+		if category != nil {
+			updated.Category = *category
+		}
+		if intGrade != nil {
+			updated.IntGrade = *intGrade
+		}
+
+		st.users.put(username, *updated)
+		return nil
+	})
+	return old, err
+}
+
+func (u memoryUsers) Get(ctx context.Context, username string) (*models.User, error) {
+	var found *models.User
+	err := u.s.do(ctx, func(st state) error {
+		if user, ok := st.users.get(username); ok {
+			found = cloneUser(user)
+		}
+		return nil
+	})
+	return found, err
+}
+
+func (u memoryUsers) List(ctx context.Context, filter models.UsersFilter) ([]models.User, error) {
+	found, err := u.filter(ctx, 0, func(user models.User) bool {
+		if filter.Category != nil && user.Category != *filter.Category {
+			return false
+		}
+		return !filter.InterviewersOnly || user.IntGrade > models.GradeNotInterviewer
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		found = []models.User{}
+	}
+
+	return found, nil
+}
+
+func (u memoryUsers) Match(
+	ctx context.Context,
+	slot [2]int64,
+	filter models.InterviewerFilter,
+) ([]models.User, error) {
+	matched, err := u.filter(ctx, matchLimit, func(user models.User) bool {
+		return filter.Matches(user, slot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	models.RankBySkills(matched, filter.Skills)
+	return matched, nil
+}
+
+func (u memoryUsers) SetAvailability(
+	ctx context.Context,
+	username string,
+	availability *models.Availability,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.Availability = cloneAvailability(availability)
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+func (u memoryUsers) SetNotifications(
+	ctx context.Context,
+	username string,
+	notifications models.Notifications,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.Notifications = notifications
+		updated.Notifications.Channels = slices.Clone(notifications.Channels)
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+func (u memoryUsers) SetLanguage(
+	ctx context.Context,
+	username string,
+	language models.Language,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.Language = language
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+func (u memoryUsers) SetTimeZone(
+	ctx context.Context,
+	username string,
+	timeZone string,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.TimeZone = timeZone
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+func (u memoryUsers) SetLimits(
+	ctx context.Context,
+	username string,
+	limits models.Limits,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.Limits = limits
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+func (u memoryUsers) SetSkills(
+	ctx context.Context,
+	username string,
+	skills []string,
+) (*models.User, error) {
+	var updated *models.User
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok {
+			return nil
+		}
+
+		updated = cloneUser(user)
+		updated.Skills = slices.Clone(skills)
+		st.users.put(username, *cloneUser(*updated))
+		return nil
+	})
+	return updated, err
+}
+
+// UpdateMeetings replaces assigned meetings only if they are still equal to old
+func (u memoryUsers) UpdateMeetings(
+	ctx context.Context,
+	username string,
+	meets []models.Meeting,
+	old []models.Meeting,
+) (bool, error) {
+	var modified bool
+	err := u.s.do(ctx, func(st state) error {
+		user, ok := st.users.get(username)
+		if !ok || !slices.Equal(user.Assigned, old) || slices.Equal(user.Assigned, meets) {
+			return nil
+		}
+
+		updated := cloneUser(user)
+		updated.Assigned = slices.Clone(meets)
+		st.users.put(username, *updated)
+		modified = true
+		return nil
+	})
+	return modified, err
+}
+
+// filter returns copies of matched users ordered by username, limit <= 0 means no limit
+func (u memoryUsers) filter(ctx context.Context, limit int, match func(user models.User) bool) ([]models.User, error) {
+	var found []models.User
+	err := u.s.do(ctx, func(st state) error {
+		for _, user := range st.users.all() {
+			if match(user) {
+				found = append(found, *cloneUser(user))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b models.User) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	return found, nil
+}
+
+func cloneUser(u models.User) *models.User {
+	u.Assigned = slices.Clone(u.Assigned)
+	u.Availability = cloneAvailability(u.Availability)
+	u.Notifications.Channels = slices.Clone(u.Notifications.Channels)
+	u.Skills = slices.Clone(u.Skills)
+	return &u
+}
+
+func cloneAvailability(a *models.Availability) *models.Availability {
+	if a == nil {
+		return nil
+	}
+
+	return &models.Availability{
+		Weekly:     slices.Clone(a.Weekly),
+		Exceptions: slices.Clone(a.Exceptions),
+	}
+}
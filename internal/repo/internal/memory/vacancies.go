@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+type memoryVacancies struct {
+	s *store
+}
+
+func (m memoryVacancies) Upsert(ctx context.Context, vacancy models.Vacancy) error {
+	return m.s.do(ctx, func(st state) error {
+		st.vacancies.put(vacancy.ID, *cloneVacancy(vacancy))
+		return nil
+	})
+}
+
+func (m memoryVacancies) Get(ctx context.Context, id string) (*models.Vacancy, error) {
+	var found *models.Vacancy
+	err := m.s.do(ctx, func(st state) error {
+		if vacancy, ok := st.vacancies.get(id); ok {
+			found = cloneVacancy(vacancy)
+		}
+		return nil
+	})
+	return found, err
+}
+
+func (m memoryVacancies) List(ctx context.Context) ([]models.Vacancy, error) {
+	var found []models.Vacancy
+	err := m.s.do(ctx, func(st state) error {
+		for _, vacancy := range st.vacancies.all() {
+			found = append(found, *cloneVacancy(vacancy))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b models.Vacancy) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return found, nil
+}
+
+func (m memoryVacancies) Delete(ctx context.Context, id string) (*models.Vacancy, error) {
+	var found *models.Vacancy
+	err := m.s.do(ctx, func(st state) error {
+		vacancy, ok := st.vacancies.get(id)
+		if !ok {
+			return nil
+		}
+
+		st.vacancies.delete(id)
+		found = cloneVacancy(vacancy)
+		return nil
+	})
+	return found, err
+}
+
+func cloneVacancy(v models.Vacancy) *models.Vacancy {
+	v.Interviewers = slices.Clone(v.Interviewers)
+	v.Skills = slices.Clone(v.Skills)
+	v.Stages = slices.Clone(v.Stages)
+	return &v
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chenmingyong0423/go-mongox"
+	"github.com/chenmingyong0423/go-mongox/builder/query"
+	"github.com/chenmingyong0423/go-mongox/builder/update"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultApplicationsCollection = "applications"
+
+type mongoApplications struct {
+	c *mongox.Collection[models.Application]
+}
+
+func (a mongoApplications) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	interview string,
+) (string, error) {
+	id := primitive.NewObjectID().Hex()
+
+	_, err := a.c.Creator().InsertOne(ctx, &models.Application{
+		ID:         id,
+		Vacancy:    vacancy,
+		Candidate:  candidate,
+		Interviews: []string{interview},
+		Status:     models.ApplicationStatusActive,
+		CreatedAt:  time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return "", errors.WrapFail(err, "insert application")
+	}
+
+	return id, nil
+}
+
+func (a mongoApplications) Get(ctx context.Context, id string) (*models.Application, error) {
+	return a.findOne(ctx, query.Id(id))
+}
+
+func (a mongoApplications) FindByInterview(ctx context.Context, interview string) (*models.Application, error) {
+	return a.findOne(ctx, query.Eq(models.ApplicationFieldInterviews, interview))
+}
+
+func (a mongoApplications) List(ctx context.Context, filter models.ApplicationsFilter) ([]models.Application, error) {
+	conds := make([]any, 0, 3)
+	if filter.Vacancy != nil {
+		conds = append(conds, query.Eq(models.ApplicationFieldVacancy, *filter.Vacancy))
+	}
+	if filter.Candidate != nil {
+		conds = append(conds, query.Eq(models.ApplicationFieldCandidate, *filter.Candidate))
+	}
+	if filter.Status != nil {
+		conds = append(conds, query.Eq(models.ApplicationFieldStatus, *filter.Status))
+	}
+
+	q := bson.D{}
+	if len(conds) > 0 {
+		q = query.BsonBuilder().And(conds...).Build()
+	}
+
+	found, err := a.c.Finder().
+		Filter(q).
+		Find(ctx, options.Find().SetSort(bson.D{{Key: models.ApplicationFieldID, Value: 1}}))
+	if err != nil {
+		return nil, errors.WrapFail(err, "find applications by filter")
+	}
+
+	applications := make([]models.Application, 0, len(found))
+	for _, application := range found {
+		applications = append(applications, *application)
+	}
+
+	return applications, nil
+}
+
+func (a mongoApplications) Advance(ctx context.Context, id string, interview string) error {
+	return a.updateActive(ctx, id, update.BsonBuilder().
+		Inc(models.ApplicationFieldStage, 1).
+		Push(models.ApplicationFieldInterviews, interview).
+		Build(),
+	)
+}
+
+func (a mongoApplications) Close(ctx context.Context, id string, status models.ApplicationStatus) error {
+	return a.updateActive(ctx, id, update.Set(models.ApplicationFieldStatus, status))
+}
+
+func (a mongoApplications) updateActive(ctx context.Context, id string, upd bson.D) error {
+	r, err := a.c.Updater().
+		Filter(query.And(
+			query.Id(id),
+			query.Eq(models.ApplicationFieldStatus, models.ApplicationStatusActive),
+		)).
+		Updates(upd).
+		UpdateOne(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "update application by id")
+	}
+
+	if r.MatchedCount == 0 {
+		return errors.Error("no applications updated")
+	}
+
+	return nil
+}
+
+func (a mongoApplications) findOne(ctx context.Context, filter bson.D) (*models.Application, error) {
+	found, err := a.c.Finder().
+		Filter(filter).
+		FindOne(ctx)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "find application")
+	}
+
+	return found, nil
+}
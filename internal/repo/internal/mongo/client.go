@@ -29,13 +29,42 @@ type Config struct {
 		MinSize uint64 `yaml:"minSize"`
 		MaxSize uint64 `yaml:"maxSize"`
 	}
+
+	// DialogTTL is time after which unfinished bot dialog is forgotten
+	DialogTTL time.Duration `yaml:"dialogTTL"`
+
+	// SkipMigrations disables migrations on start, then they
+	// must be applied with "migrate" command before the update
+	SkipMigrations bool `yaml:"skipMigrations"`
+
+	// LegacyUTCDiff is utcDiff of the bot config, meeting times stored
+	// before they were kept in UTC are shifted back by it once
+	LegacyUTCDiff time.Duration `yaml:"-"`
+}
+
+// Sources are names of collections
+type Sources struct {
+	Interviews string `yaml:"interviews"`
+	Users      string `yaml:"users"`
+	Dialogs    string `yaml:"dialogs"`
+
+	// Outbox keeps messages to be delivered, "outbox" by default
+	Outbox string `yaml:"outbox"`
+
+	// Vacancies keeps vacancy settings, "vacancies" by default
+	Vacancies string `yaml:"vacancies"`
+
+	// Applications keeps pipelines of candidates, "applications" by default
+	Applications string `yaml:"applications"`
+
+	// Migrations keeps applied migrations, "migrations" by default
+	Migrations string `yaml:"migrations"`
 }
 
 func NewMongoClient(
 	ctx context.Context,
 	cfg Config,
-	interviewsCollectionName string,
-	usersCollectionName string,
+	sources Sources,
 ) (*mongoClient, error) {
 	client, err := mongo.Connect(
 		ctx,
@@ -54,21 +83,70 @@ func NewMongoClient(
 	}
 
 	db := client.Database(cfg.Database, &options.DatabaseOptions{})
-	return &mongoClient{
-		c:          client,
-		users:      mongoUsers{
-			c: mongox.NewCollection[models.User](db.Collection(usersCollectionName)),
+
+	if sources.Outbox == "" {
+		sources.Outbox = defaultOutboxCollection
+	}
+	if sources.Vacancies == "" {
+		sources.Vacancies = defaultVacanciesCollection
+	}
+	if sources.Applications == "" {
+		sources.Applications = defaultApplicationsCollection
+	}
+	if sources.Migrations == "" {
+		sources.Migrations = defaultMigrationsCollection
+	}
+
+	dialogs := mongoDialogs{c: db.Collection(sources.Dialogs)}
+	_, err = dialogs.ensureTTL(ctx, cfg.DialogTTL)
+	if err != nil {
+		return nil, errors.WrapFail(err, "setup dialogs collection")
+	}
+
+	m := &mongoClient{
+		c:       client,
+		db:      db,
+		sources: sources,
+		users: mongoUsers{
+			c: mongox.NewCollection[models.User](db.Collection(sources.Users)),
 		},
 		interviews: mongoInterviews{
-			c: mongox.NewCollection[models.Interview](db.Collection(interviewsCollectionName)),
+			c: mongox.NewCollection[models.Interview](db.Collection(sources.Interviews)),
+		},
+		dialogs: dialogs,
+		outbox: mongoOutbox{
+			c: mongox.NewCollection[models.OutboxMessage](db.Collection(sources.Outbox)),
+		},
+		vacancies: mongoVacancies{
+			c: mongox.NewCollection[models.Vacancy](db.Collection(sources.Vacancies)),
 		},
-	}, nil
+		applications: mongoApplications{
+			c: mongox.NewCollection[models.Application](db.Collection(sources.Applications)),
+		},
+	}
+
+	if !cfg.SkipMigrations {
+		err = m.Migrate(ctx, cfg)
+		if err != nil {
+			_ = client.Disconnect(ctx)
+			return nil, errors.WrapFail(err, "migrate mongo db")
+		}
+	}
+
+	return m, nil
 }
 
 type mongoClient struct {
 	c          *mongo.Client
+	db         *mongo.Database
+	sources    Sources
 	users      mongoUsers
 	interviews mongoInterviews
+	dialogs    mongoDialogs
+	outbox     mongoOutbox
+
+	vacancies    mongoVacancies
+	applications mongoApplications
 }
 
 func (m *mongoClient) Interviews() models.InterviewsRepo {
@@ -79,6 +157,22 @@ func (m *mongoClient) Users() models.UsersRepo {
 	return m.users
 }
 
+func (m *mongoClient) Dialogs() models.DialogsRepo {
+	return m.dialogs
+}
+
+func (m *mongoClient) Outbox() models.OutboxRepo {
+	return m.outbox
+}
+
+func (m *mongoClient) Vacancies() models.VacanciesRepo {
+	return m.vacancies
+}
+
+func (m *mongoClient) Applications() models.ApplicationsRepo {
+	return m.applications
+}
+
 func (m *mongoClient) Close(ctx context.Context) error {
 	return errors.WrapFail(m.c.Disconnect(ctx), "disconnect from mongo db")
 }
@@ -0,0 +1,189 @@
+package repo
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+	mng "github.com/nikmy/meowbot/pkg/mongotools"
+)
+
+const (
+	dialogFieldID        = "_id"
+	dialogFieldChat      = "chat"
+	dialogFieldUser      = "user"
+	dialogFieldState     = "state"
+	dialogFieldData      = "data"
+	dialogFieldUpdatedAt = "updated_at"
+
+	// dialogsTTLIndex is the default name mongo has given the index created before migrations
+	dialogsTTLIndex = "updated_at_1"
+)
+
+type mongoDialogs struct {
+	c *mongo.Collection
+}
+
+func dialogID(chatID, userID int64) bson.D {
+	return bson.D{
+		{Key: dialogFieldID, Value: bson.D{
+			{Key: dialogFieldChat, Value: chatID},
+			{Key: dialogFieldUser, Value: userID},
+		}},
+	}
+}
+
+// expireAfterSeconds is the option of the ttl index, dialogs are kept
+// as long as mongo allows if ttl is not positive
+func expireAfterSeconds(ttl time.Duration) int32 {
+	if ttl <= 0 || ttl.Seconds() >= math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(ttl.Seconds())
+}
+
+// createTTL creates the index making mongo remove dialogs which were not updated for ttl
+func (d mongoDialogs) createTTL(ctx context.Context, ttl time.Duration) error {
+	found, err := d.ensureTTL(ctx, ttl)
+	if err != nil || found {
+		return err
+	}
+
+	_, err = d.c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: dialogFieldUpdatedAt, Value: 1}},
+		Options: options.Index().SetName(dialogsTTLIndex).SetExpireAfterSeconds(expireAfterSeconds(ttl)),
+	})
+	return errors.WrapFail(err, "create ttl index")
+}
+
+// ensureTTL updates ttl of the index if it has changed in the config,
+// it reports false if the index has not been created by migrations yet
+func (d mongoDialogs) ensureTTL(ctx context.Context, ttl time.Duration) (bool, error) {
+	specs, err := d.c.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return false, errors.WrapFail(err, "list indexes")
+	}
+
+	want := expireAfterSeconds(ttl)
+	for _, spec := range specs {
+		if spec.Name != dialogsTTLIndex {
+			continue
+		}
+		if spec.ExpireAfterSeconds != nil && *spec.ExpireAfterSeconds == want {
+			return true, nil
+		}
+
+		err = d.c.Database().RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: d.c.Name()},
+			{Key: "index", Value: bson.D{
+				{Key: "name", Value: dialogsTTLIndex},
+				{Key: "expireAfterSeconds", Value: want},
+			}},
+		}).Err()
+		return true, errors.WrapFail(err, "update ttl index")
+	}
+
+	return false, nil
+}
+
+func (d mongoDialogs) GetState(ctx context.Context, chatID, userID int64) (string, error) {
+	var found struct {
+		State string `bson:"state"`
+	}
+
+	err := d.c.FindOne(
+		ctx,
+		dialogID(chatID, userID),
+		options.FindOne().SetProjection(bson.D{{Key: dialogFieldState, Value: 1}}),
+	).Decode(&found)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", errors.WrapFail(err, "find dialog")
+	}
+
+	return found.State, nil
+}
+
+func (d mongoDialogs) SetState(ctx context.Context, chatID, userID int64, state string) error {
+	return d.upsert(ctx, chatID, userID, bson.D{{Key: "$set", Value: bson.D{
+		{Key: dialogFieldState, Value: state},
+		{Key: dialogFieldUpdatedAt, Value: time.Now()},
+	}}})
+}
+
+func (d mongoDialogs) ResetState(ctx context.Context, chatID, userID int64, withData bool) error {
+	if withData {
+		_, err := d.c.DeleteOne(ctx, dialogID(chatID, userID))
+		return errors.WrapFail(err, "delete dialog")
+	}
+
+	return d.upsert(ctx, chatID, userID, bson.D{
+		{Key: "$unset", Value: bson.D{{Key: dialogFieldState, Value: ""}}},
+		{Key: "$set", Value: bson.D{{Key: dialogFieldUpdatedAt, Value: time.Now()}}},
+	})
+}
+
+func (d mongoDialogs) UpdateData(ctx context.Context, chatID, userID int64, key string, data any) error {
+	field := mng.Path(dialogFieldData, key)
+
+	if data == nil {
+		return d.upsert(ctx, chatID, userID, bson.D{
+			{Key: "$unset", Value: bson.D{{Key: field, Value: ""}}},
+			{Key: "$set", Value: bson.D{{Key: dialogFieldUpdatedAt, Value: time.Now()}}},
+		})
+	}
+
+	return d.upsert(ctx, chatID, userID, bson.D{{Key: "$set", Value: bson.D{
+		{Key: field, Value: data},
+		{Key: dialogFieldUpdatedAt, Value: time.Now()},
+	}}})
+}
+
+func (d mongoDialogs) GetData(ctx context.Context, chatID, userID int64, key string, to any) (bool, error) {
+	field := mng.Path(dialogFieldData, key)
+
+	raw, err := d.c.FindOne(
+		ctx,
+		dialogID(chatID, userID),
+		options.FindOne().SetProjection(bson.D{{Key: field, Value: 1}}),
+	).Raw()
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, errors.WrapFail(err, "find dialog")
+	}
+
+	value, err := raw.LookupErr(dialogFieldData, key)
+	if errors.Is(err, bsoncore.ErrElementNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, errors.WrapFail(err, "lookup dialog data")
+	}
+
+	err = value.Unmarshal(to)
+	if err != nil {
+		return false, errors.WrapFail(err, "decode dialog data")
+	}
+
+	return true, nil
+}
+
+func (d mongoDialogs) upsert(ctx context.Context, chatID, userID int64, upd bson.D) error {
+	_, err := d.c.UpdateOne(ctx, dialogID(chatID, userID), upd, options.Update().SetUpsert(true))
+	return errors.WrapFail(err, "upsert dialog")
+}
@@ -22,7 +22,12 @@ type mongoInterviews struct {
 	c *mongox.Collection[models.Interview]
 }
 
-func (m mongoInterviews) Create(ctx context.Context, vacancy string, candidate string) (string, error) {
+func (m mongoInterviews) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	duration time.Duration,
+) (string, error) {
 	randomSuffix := strconv.Itoa(rand.Intn(90) + 10)
 	timestamp := strconv.FormatInt(time.Now().UnixMicro(), 16)
 	id := timestamp + randomSuffix
@@ -31,6 +36,7 @@ func (m mongoInterviews) Create(ctx context.Context, vacancy string, candidate s
 		ID:          id,
 		Vacancy:     vacancy,
 		CandidateUN: candidate,
+		Duration:    duration,
 	})
 	if err != nil {
 		return "", errors.WrapFail(err, "insert interview")
@@ -65,6 +71,7 @@ func (m mongoInterviews) Schedule(
 	id string,
 	candidate models.User,
 	interviewer models.User,
+	panel []models.Panelist,
 	meet models.Meeting,
 ) error {
 	_, err := m.c.Updater().
@@ -73,13 +80,50 @@ func (m mongoInterviews) Schedule(
 			Set(models.InterviewFieldStatus, models.InterviewStatusScheduled).
 			Set(models.InterviewFieldInterviewerTg, interviewer.Telegram).
 			Set(models.InterviewFieldInterviewerUN, interviewer.Username).
+			Set(models.InterviewFieldPanelists, panel).
 			Set(models.InterviewFieldCandidateTg, candidate.Telegram).
 			Set(models.InterviewFieldMeet, meet).
+			Unset(models.InterviewFieldLastNotification).
 			Build()).
 		UpdateOne(ctx)
 	return errors.WrapFail(err, "update interview")
 }
 
+func (m mongoInterviews) SetPanel(ctx context.Context, id string, panel models.Panel) error {
+	r, err := m.c.Updater().
+		Filter(query.Id(id)).
+		Updates(update.Set(models.InterviewFieldPanel, panel)).
+		UpdateOne(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "update interview by id")
+	}
+
+	if r.MatchedCount == 0 {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
+func (m mongoInterviews) RemovePanelist(ctx context.Context, id string, username string) error {
+	r, err := m.c.Updater().
+		Filter(query.Id(id)).
+		Updates(update.Pull(
+			models.InterviewFieldPanelists,
+			bson.D{{Key: models.PanelistFieldUsername, Value: username}},
+		)).
+		UpdateOne(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "update interview by id")
+	}
+
+	if r.MatchedCount == 0 {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
 func (m mongoInterviews) Notify(ctx context.Context, id string, at int64, notified [2]bool) error {
 	notifiedField := mng.Path(models.InterviewFieldLastNotification, models.NotificationFieldNotified)
 	unixTimeField := mng.Path(models.InterviewFieldLastNotification, models.NotificationFieldUnixTime)
@@ -117,6 +161,7 @@ func (m mongoInterviews) FindByUser(ctx context.Context, username string) ([]*mo
 		Filter(query.Or(
 			query.Eq(models.InterviewFieldCandidateUN, username),
 			query.Eq(models.InterviewFieldInterviewerUN, username),
+			query.Eq(mng.Path(models.InterviewFieldPanelists, models.PanelistFieldUsername), username),
 		)).
 		Find(ctx)
 
@@ -127,6 +172,45 @@ func (m mongoInterviews) FindByUser(ctx context.Context, username string) ([]*mo
 	return parsed, nil
 }
 
+func (m mongoInterviews) List(ctx context.Context, filter models.InterviewsFilter) ([]*models.Interview, error) {
+	conds := make([]any, 0, 7)
+	if filter.Status != nil {
+		conds = append(conds, query.Eq(models.InterviewFieldStatus, *filter.Status))
+	}
+	if filter.Outcome != nil {
+		conds = append(conds, query.Eq(models.InterviewFieldOutcome, *filter.Outcome))
+	}
+	if filter.Vacancy != nil {
+		conds = append(conds, query.Eq(models.InterviewFieldVacancy, *filter.Vacancy))
+	}
+	if filter.Candidate != nil {
+		conds = append(conds, query.Eq(models.InterviewFieldCandidateUN, *filter.Candidate))
+	}
+	if filter.Interviewer != nil {
+		conds = append(conds, query.Eq(models.InterviewFieldInterviewerUN, *filter.Interviewer))
+	}
+	if filter.From != nil {
+		conds = append(conds, query.Gte(mng.Index(models.InterviewFieldMeet, 0), *filter.From))
+	}
+	if filter.To != nil {
+		conds = append(conds, query.Lt(mng.Index(models.InterviewFieldMeet, 0), *filter.To))
+	}
+
+	q := bson.D{}
+	if len(conds) > 0 {
+		q = query.BsonBuilder().And(conds...).Build()
+	}
+
+	found, err := m.c.Finder().
+		Filter(q).
+		Find(ctx, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, errors.WrapFail(err, "find interviews by filter")
+	}
+
+	return found, nil
+}
+
 func (m mongoInterviews) Update(
 	ctx context.Context,
 	id string,
@@ -134,12 +218,32 @@ func (m mongoInterviews) Update(
 	candidate *string,
 	data *[]byte,
 	zoom *string,
+	duration *time.Duration,
+	minGrade *int,
+	skills *[]string,
 ) error {
-	upd := update.BsonBuilder().
-		Set(models.InterviewFieldVacancy, vacancy).
-		Set(models.InterviewFieldCandidateUN, candidate).
-		Set(models.InterviewFieldData, data).
-		Set(models.InterviewFieldZoom, zoom)
+	upd := update.BsonBuilder()
+	if vacancy != nil {
+		upd.Set(models.InterviewFieldVacancy, *vacancy)
+	}
+	if candidate != nil {
+		upd.Set(models.InterviewFieldCandidateUN, *candidate)
+	}
+	if data != nil {
+		upd.Set(models.InterviewFieldData, *data)
+	}
+	if zoom != nil {
+		upd.Set(models.InterviewFieldZoom, *zoom)
+	}
+	if duration != nil {
+		upd.Set(models.InterviewFieldDuration, *duration)
+	}
+	if minGrade != nil {
+		upd.Set(models.InterviewFieldMinGrade, *minGrade)
+	}
+	if skills != nil {
+		upd.Set(models.InterviewFieldSkills, *skills)
+	}
 	if candidate != nil {
 		upd.Unset(models.InterviewFieldCandidateTg)
 	}
@@ -158,7 +262,7 @@ func (m mongoInterviews) GetUpcoming(ctx context.Context, lastNotifyBefore, star
 	q := query.BsonBuilder().
 		And(
 			query.Eq(models.InterviewFieldStatus, models.InterviewStatusScheduled),
-			bson.D{{mng.Index(models.InterviewFieldMeet, 0), bson.M{"$lt": startsBefore}}},
+			bson.D{{Key: mng.Index(models.InterviewFieldMeet, 0), Value: bson.M{"$lt": startsBefore}}},
 			query.Or(
 				query.Exists(models.InterviewFieldLastNotification, false),
 				query.Lt(unixTime, lastNotifyBefore),
@@ -185,6 +289,7 @@ func (m mongoInterviews) Cancel(ctx context.Context, id string, side models.Role
 					models.InterviewFieldLastNotification,
 					models.InterviewFieldInterviewerTg,
 					models.InterviewFieldInterviewerUN,
+					models.InterviewFieldPanelists,
 					models.InterviewFieldZoom,
 				).
 				Set(models.InterviewFieldStatus, models.InterviewStatusCancelled).
@@ -220,6 +325,43 @@ func (m mongoInterviews) Done(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m mongoInterviews) Attend(ctx context.Context, id string, attendance [2]models.Attendance) error {
+	r, err := m.c.Updater().
+		Filter(query.Id(id)).
+		Updates(
+			update.BsonBuilder().
+				Set(models.InterviewFieldAttendance, attendance).
+				Set(models.InterviewFieldOutcome, models.ResolveOutcome(attendance)).
+				Build(),
+		).
+		UpdateOne(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "update interview by id")
+	}
+
+	if r.MatchedCount == 0 {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
+func (m mongoInterviews) Score(ctx context.Context, id string, scorecard models.Scorecard) error {
+	r, err := m.c.Updater().
+		Filter(query.Id(id)).
+		Updates(update.Set(models.InterviewFieldScorecard, scorecard)).
+		UpdateOne(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "update interview by id")
+	}
+
+	if r.MatchedCount == 0 {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
 func (m mongoInterviews) FixTg(ctx context.Context, username string, tg int64) error {
 	_, err := m.c.Updater().
 		Filter(query.Eq(models.InterviewFieldCandidateUN, username)).
@@ -0,0 +1,297 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+	mng "github.com/nikmy/meowbot/pkg/mongotools"
+)
+
+const defaultMigrationsCollection = "migrations"
+
+// migration changes indexes or stored data. Migrations are recorded after
+// they succeed, so up must be idempotent: it may be rerun after a crash
+// or concurrently by another replica. Migrations which cannot be rerun
+// are marked once and applied in a transaction together with the record.
+type migration struct {
+	version int
+	name    string
+	once    bool
+	up      func(ctx context.Context, db *mongo.Database, sources Sources, cfg Config) error
+}
+
+// migrations are applied in order, append new ones to the end
+// and never change versions of the released ones
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create indexes",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, _ Config) error {
+			_, err := db.Collection(sources.Users).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: models.UserFieldUsername, Value: 1}},
+				Options: options.Index().SetName("username_unique").SetUnique(true),
+			})
+			if err != nil {
+				return errors.WrapFail(err, "create unique username index")
+			}
+
+			_, err = db.Collection(sources.Interviews).Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys: bson.D{
+						{Key: models.InterviewFieldStatus, Value: 1},
+						{Key: mng.Index(models.InterviewFieldMeet, 0), Value: 1},
+					},
+					Options: options.Index().SetName("status_meet_start"),
+				},
+				{
+					Keys:    bson.D{{Key: models.InterviewFieldCandidateUN, Value: 1}},
+					Options: options.Index().SetName("candidate"),
+				},
+				{
+					Keys:    bson.D{{Key: models.InterviewFieldInterviewerUN, Value: 1}},
+					Options: options.Index().SetName("interviewer"),
+				},
+			})
+			return errors.WrapFail(err, "create interviews indexes")
+		},
+	},
+	{
+		version: 2,
+		name:    "create outbox indexes",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, _ Config) error {
+			_, err := db.Collection(sources.Outbox).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{
+					{Key: models.OutboxFieldStatus, Value: 1},
+					{Key: models.OutboxFieldNextAttempt, Value: 1},
+				},
+				Options: options.Index().SetName("status_next_attempt"),
+			})
+			return errors.WrapFail(err, "create pending outbox index")
+		},
+	},
+	{
+		version: 3,
+		name:    "address outbox messages by channel",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, _ Config) error {
+			_, err := db.Collection(sources.Outbox).UpdateMany(
+				ctx,
+				bson.D{{Key: "recipient", Value: bson.D{{Key: "$exists", Value: true}}}},
+				mongo.Pipeline{
+					{{Key: "$set", Value: bson.D{
+						{Key: models.OutboxFieldChannel, Value: models.ChannelTelegram},
+						{Key: models.OutboxFieldAddress, Value: bson.D{{Key: "$toString", Value: "$recipient"}}},
+					}}},
+					{{Key: "$unset", Value: "recipient"}},
+				},
+			)
+			return errors.WrapFail(err, "convert outbox recipients")
+		},
+	},
+	{
+		version: 4,
+		name:    "create applications indexes",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, _ Config) error {
+			_, err := db.Collection(sources.Applications).Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: models.ApplicationFieldCandidate, Value: 1}},
+					Options: options.Index().SetName("candidate"),
+				},
+				{
+					Keys:    bson.D{{Key: models.ApplicationFieldInterviews, Value: 1}},
+					Options: options.Index().SetName("interviews"),
+				},
+			})
+			return errors.WrapFail(err, "create applications indexes")
+		},
+	},
+	{
+		version: 5,
+		name:    "create interview panelists index",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, _ Config) error {
+			_, err := db.Collection(sources.Interviews).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: mng.Path(models.InterviewFieldPanelists, models.PanelistFieldUsername), Value: 1}},
+				Options: options.Index().SetName("panelists"),
+			})
+			return errors.WrapFail(err, "create panelists index")
+		},
+	},
+	{
+		version: 6,
+		name:    "shift legacy meeting times to UTC",
+		// times were stored shifted by utcDiff of the bot config,
+		// shifting them back twice would break them
+		once: true,
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, cfg Config) error {
+			diff := cfg.LegacyUTCDiff.Milliseconds()
+			if diff == 0 {
+				return nil
+			}
+
+			interviews := db.Collection(sources.Interviews)
+			_, err := interviews.UpdateMany(
+				ctx,
+				bson.D{{Key: models.InterviewFieldMeet, Value: bson.D{{Key: "$type", Value: "array"}}}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.D{
+					{Key: models.InterviewFieldMeet, Value: shiftTimes("$"+models.InterviewFieldMeet, diff)},
+				}}}},
+			)
+			if err != nil {
+				return errors.WrapFail(err, "shift interview meetings")
+			}
+
+			// notification log keeps start of the meeting it was sent for
+			unixTime := mng.Path(models.InterviewFieldLastNotification, models.NotificationFieldUnixTime)
+			_, err = interviews.UpdateMany(
+				ctx,
+				bson.D{{Key: unixTime, Value: bson.D{{Key: "$exists", Value: true}}}},
+				bson.D{{Key: "$inc", Value: bson.D{{Key: unixTime, Value: -diff}}}},
+			)
+			if err != nil {
+				return errors.WrapFail(err, "shift interview notifications")
+			}
+
+			users := db.Collection(sources.Users)
+			_, err = users.UpdateMany(
+				ctx,
+				bson.D{{Key: models.UserFieldAssigned, Value: bson.D{{Key: "$type", Value: "array"}}}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.D{
+					{Key: models.UserFieldAssigned, Value: shiftMeetings("$"+models.UserFieldAssigned, diff)},
+				}}}},
+			)
+			if err != nil {
+				return errors.WrapFail(err, "shift assigned meetings")
+			}
+
+			exceptions := mng.Path(models.UserFieldAvailability, models.AvailabilityFieldExceptions)
+			_, err = users.UpdateMany(
+				ctx,
+				bson.D{{Key: exceptions, Value: bson.D{{Key: "$type", Value: "array"}}}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.D{
+					{Key: exceptions, Value: shiftMeetings("$"+exceptions, diff)},
+				}}}},
+			)
+			return errors.WrapFail(err, "shift availability exceptions")
+		},
+	},
+	{
+		version: 7,
+		name:    "create dialogs ttl index",
+		up: func(ctx context.Context, db *mongo.Database, sources Sources, cfg Config) error {
+			dialogs := mongoDialogs{c: db.Collection(sources.Dialogs)}
+			return errors.WrapFail(dialogs.createTTL(ctx, cfg.DialogTTL), "create dialogs ttl index")
+		},
+	},
+}
+
+// shiftTimes is an aggregation expression subtracting diff from every time of the array
+func shiftTimes(array string, diff int64) bson.D {
+	return bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: array},
+		{Key: "as", Value: "t"},
+		{Key: "in", Value: bson.D{{Key: "$subtract", Value: bson.A{"$$t", diff}}}},
+	}}}
+}
+
+// shiftMeetings is shiftTimes for every meeting of the array
+func shiftMeetings(array string, diff int64) bson.D {
+	return bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: array},
+		{Key: "as", Value: "meet"},
+		{Key: "in", Value: shiftTimes("$$meet", diff)},
+	}}}
+}
+
+type appliedMigration struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// Migrate applies migrations which have not been recorded yet
+func (m *mongoClient) Migrate(ctx context.Context, cfg Config) error {
+	return migrate(ctx, m.db, m.sources, cfg, migrations)
+}
+
+func migrate(ctx context.Context, db *mongo.Database, sources Sources, cfg Config, all []migration) error {
+	applied := db.Collection(sources.Migrations)
+
+	var last appliedMigration
+	err := applied.FindOne(
+		ctx,
+		bson.D{},
+		options.FindOne().SetSort(bson.D{{Key: "_id", Value: -1}}),
+	).Decode(&last)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return errors.WrapFail(err, "get last applied migration")
+	}
+
+	for _, mig := range pending(all, last.Version) {
+		if mig.once {
+			err = applyOnce(ctx, db, sources, cfg, mig)
+			if err != nil {
+				return errors.WrapFail(err, "apply migration %d %q", mig.version, mig.name)
+			}
+			continue
+		}
+
+		err = mig.up(ctx, db, sources, cfg)
+		if err != nil {
+			return errors.WrapFail(err, "apply migration %d %q", mig.version, mig.name)
+		}
+
+		_, err = applied.InsertOne(ctx, appliedMigration{
+			Version:   mig.version,
+			Name:      mig.name,
+			AppliedAt: time.Now(),
+		})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return errors.WrapFail(err, "record migration %d", mig.version)
+		}
+	}
+
+	return nil
+}
+
+// applyOnce records the migration before applying it in the same transaction,
+// so it is neither rerun after a crash nor applied by two replicas
+func applyOnce(ctx context.Context, db *mongo.Database, sources Sources, cfg Config, mig migration) error {
+	session, err := db.Client().StartSession()
+	if err != nil {
+		return errors.WrapFail(err, "start session")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		_, err := db.Collection(sources.Migrations).InsertOne(ctx, appliedMigration{
+			Version:   mig.version,
+			Name:      mig.name,
+			AppliedAt: time.Now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, mig.up(ctx, db, sources, cfg)
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// already applied by another replica
+		return nil
+	}
+	return err
+}
+
+// pending returns migrations newer than the given version
+func pending(all []migration, version int) []migration {
+	for i, mig := range all {
+		if mig.version > version {
+			return all[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func TestMigrations_ordered(t *testing.T) {
+	for i, mig := range migrations {
+		require.NotEmpty(t, mig.name)
+		require.NotNil(t, mig.up)
+		if i > 0 {
+			require.Greater(t, mig.version, migrations[i-1].version, "versions must increase")
+		} else {
+			require.Positive(t, mig.version)
+		}
+	}
+}
+
+func Test_pending(t *testing.T) {
+	all := []migration{{version: 1}, {version: 2}, {version: 5}}
+
+	versions := func(migs []migration) []int {
+		var v []int
+		for _, mig := range migs {
+			v = append(v, mig.version)
+		}
+		return v
+	}
+
+	require.Equal(t, []int{1, 2, 5}, versions(pending(all, 0)))
+	require.Equal(t, []int{5}, versions(pending(all, 2)))
+	require.Empty(t, pending(all, 5))
+}
+
+func Test_migrate_pending(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("only pending are applied and recorded", func(mt *mtest.T) {
+		var applied []int
+		all := []migration{{version: 1}, {version: 2}, {version: 3}}
+		for i := range all {
+			version := all[i].version
+			all[i].name = fmt.Sprintf("migration %d", version)
+			all[i].up = func(context.Context, *mongo.Database, Sources, Config) error {
+				applied = append(applied, version)
+				return nil
+			}
+		}
+
+		ns := mt.DB.Name() + ".migrations"
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "_id", Value: 1}}),
+			mtest.CreateSuccessResponse(),
+			// concurrent replica has recorded the migration first
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key"}),
+		)
+
+		err := migrate(context.Background(), mt.DB, Sources{Migrations: "migrations"}, Config{}, all)
+		require.NoError(mt, err)
+		require.Equal(mt, []int{2, 3}, applied)
+
+		var inserted []int32
+		for {
+			event := mt.GetStartedEvent()
+			if event == nil {
+				break
+			}
+			if event.CommandName == "insert" {
+				doc := event.Command.Lookup("documents").Array().Index(0).Value().Document()
+				inserted = append(inserted, doc.Lookup("_id").Int32())
+			}
+		}
+		require.Equal(mt, []int32{2, 3}, inserted)
+	})
+}
+
+// TestMigrate runs migrations against a real database, see TestMongoClient
+func TestMigrate(t *testing.T) {
+	url := os.Getenv("MEOWBOT_TEST_MONGO_URL")
+	if url == "" {
+		t.Skip("MEOWBOT_TEST_MONGO_URL is not set")
+	}
+
+	ctx := context.Background()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(url).SetAuth(options.Credential{
+		Username: os.Getenv("MEOWBOT_TEST_MONGO_USERNAME"),
+		Password: os.Getenv("MEOWBOT_TEST_MONGO_PASSWORD"),
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Disconnect(ctx) })
+
+	db := client.Database(fmt.Sprintf("migrationstest_%d", time.Now().UnixNano()))
+	t.Cleanup(func() { _ = db.Drop(ctx) })
+
+	sources := Sources{
+		Interviews:   "interviews",
+		Users:        "users",
+		Dialogs:      "dialogs",
+		Outbox:       defaultOutboxCollection,
+		Applications: defaultApplicationsCollection,
+		Migrations:   defaultMigrationsCollection,
+	}
+
+	// messages of the outbox released before v3 are addressed by telegram id
+	_, err = db.Collection(sources.Outbox).InsertMany(ctx, []any{
+		bson.D{{Key: "_id", Value: "legacy"}, {Key: "recipient", Value: int64(42)}, {Key: "text", Value: "meow"}},
+		bson.D{
+			{Key: "_id", Value: "addressed"},
+			{Key: models.OutboxFieldChannel, Value: models.ChannelEmail},
+			{Key: models.OutboxFieldAddress, Value: "cat@example.com"},
+		},
+	})
+	require.NoError(t, err)
+
+	// times stored before v6 are shifted by utcDiff of the bot config
+	cfg := Config{LegacyUTCDiff: 3 * time.Hour, DialogTTL: time.Hour}
+	shifted := func(t int64) int64 { return t + cfg.LegacyUTCDiff.Milliseconds() }
+	_, err = db.Collection(sources.Interviews).InsertOne(ctx, bson.D{
+		{Key: "_id", Value: "legacy"},
+		{Key: models.InterviewFieldMeet, Value: bson.A{shifted(1000), shifted(2000)}},
+		{Key: models.InterviewFieldLastNotification, Value: bson.D{{Key: models.NotificationFieldUnixTime, Value: shifted(1000)}}},
+	})
+	require.NoError(t, err)
+	_, err = db.Collection(sources.Users).InsertOne(ctx, bson.D{
+		{Key: models.UserFieldUsername, Value: "cat"},
+		{Key: models.UserFieldAssigned, Value: bson.A{bson.A{shifted(1000), shifted(2000)}}},
+		{Key: models.UserFieldAvailability, Value: bson.D{
+			{Key: models.AvailabilityFieldExceptions, Value: bson.A{bson.A{shifted(3000), shifted(4000)}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	// migrate is rerun to check that applied migrations are skipped
+	for range 2 {
+		require.NoError(t, migrate(ctx, db, sources, cfg, migrations))
+	}
+
+	var interview models.Interview
+	require.NoError(t, db.Collection(sources.Interviews).FindOne(ctx, bson.D{{Key: "_id", Value: "legacy"}}).Decode(&interview))
+	require.Equal(t, &[2]int64{1000, 2000}, interview.Meet)
+	require.Equal(t, int64(1000), interview.LastNotification.UnixTime)
+
+	var user models.User
+	require.NoError(t, db.Collection(sources.Users).FindOne(ctx, bson.D{{Key: models.UserFieldUsername, Value: "cat"}}).Decode(&user))
+	require.Equal(t, []models.Meeting{{1000, 2000}}, user.Assigned)
+	require.Equal(t, []models.Meeting{{3000, 4000}}, user.Availability.Exceptions)
+
+	var legacy bson.M
+	require.NoError(t, db.Collection(sources.Outbox).FindOne(ctx, bson.D{{Key: "_id", Value: "legacy"}}).Decode(&legacy))
+	require.Equal(t, string(models.ChannelTelegram), legacy[models.OutboxFieldChannel])
+	require.Equal(t, "42", legacy[models.OutboxFieldAddress])
+	require.Equal(t, "meow", legacy["text"])
+	require.NotContains(t, legacy, "recipient")
+
+	var addressed bson.M
+	require.NoError(t, db.Collection(sources.Outbox).FindOne(ctx, bson.D{{Key: "_id", Value: "addressed"}}).Decode(&addressed))
+	require.Equal(t, string(models.ChannelEmail), addressed[models.OutboxFieldChannel])
+	require.Equal(t, "cat@example.com", addressed[models.OutboxFieldAddress])
+
+	var recorded []appliedMigration
+	cursor, err := db.Collection(sources.Migrations).Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	require.NoError(t, err)
+	require.NoError(t, cursor.All(ctx, &recorded))
+	require.Len(t, recorded, len(migrations))
+	for i, mig := range migrations {
+		require.Equal(t, mig.version, recorded[i].Version)
+		require.Equal(t, mig.name, recorded[i].Name)
+	}
+
+	specs, err := db.Collection(sources.Users).Indexes().ListSpecifications(ctx)
+	require.NoError(t, err)
+	require.Contains(t, indexNames(specs), "username_unique")
+
+	specs, err = db.Collection(sources.Interviews).Indexes().ListSpecifications(ctx)
+	require.NoError(t, err)
+	require.Subset(t, indexNames(specs), []string{"status_meet_start", "candidate", "interviewer", "panelists"})
+
+	dialogs := mongoDialogs{c: db.Collection(sources.Dialogs)}
+	require.Equal(t, int32(3600), dialogsTTL(t, dialogs))
+
+	// ttl changed in the config is applied on start
+	found, err := dialogs.ensureTTL(ctx, 2*time.Hour)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int32(7200), dialogsTTL(t, dialogs))
+}
+
+func dialogsTTL(t *testing.T, dialogs mongoDialogs) int32 {
+	specs, err := dialogs.c.Indexes().ListSpecifications(context.Background())
+	require.NoError(t, err)
+	for _, spec := range specs {
+		if spec.Name == dialogsTTLIndex {
+			require.NotNil(t, spec.ExpireAfterSeconds)
+			return *spec.ExpireAfterSeconds
+		}
+	}
+	require.Fail(t, "no ttl index")
+	return 0
+}
+
+func indexNames(specs []*mongo.IndexSpecification) []string {
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+	return names
+}
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chenmingyong0423/go-mongox"
+	"github.com/chenmingyong0423/go-mongox/builder/query"
+	"github.com/chenmingyong0423/go-mongox/builder/update"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultOutboxCollection = "outbox"
+
+type mongoOutbox struct {
+	c *mongox.Collection[models.OutboxMessage]
+}
+
+func (o mongoOutbox) Add(ctx context.Context, to models.Contact, text string) (string, error) {
+	now := time.Now().UnixMilli()
+	id := primitive.NewObjectID().Hex()
+
+	_, err := o.c.Creator().InsertOne(ctx, &models.OutboxMessage{
+		ID:          id,
+		Contact:     to,
+		Text:        text,
+		CreatedAt:   now,
+		NextAttempt: now,
+	})
+	if err != nil {
+		return "", errors.WrapFail(err, "insert outbox message")
+	}
+
+	return id, nil
+}
+
+func (o mongoOutbox) Pending(ctx context.Context, now int64, limit int) ([]models.OutboxMessage, error) {
+	found, err := o.c.Finder().
+		Filter(query.And(
+			query.Eq(models.OutboxFieldStatus, models.OutboxStatusPending),
+			query.Lte(models.OutboxFieldNextAttempt, now),
+		)).
+		Find(ctx, options.Find().
+			SetSort(bson.D{
+				{Key: models.OutboxFieldCreatedAt, Value: 1},
+				{Key: models.OutboxFieldID, Value: 1},
+			}).
+			SetLimit(int64(limit)),
+		)
+	if err != nil {
+		return nil, errors.WrapFail(err, "find pending outbox messages")
+	}
+
+	messages := make([]models.OutboxMessage, 0, len(found))
+	for _, msg := range found {
+		messages = append(messages, *msg)
+	}
+
+	return messages, nil
+}
+
+func (o mongoOutbox) Claim(ctx context.Context, id string, at int64, until int64) (bool, error) {
+	r, err := o.c.Updater().
+		Filter(query.And(
+			query.Id(id),
+			query.Eq(models.OutboxFieldStatus, models.OutboxStatusPending),
+			query.Eq(models.OutboxFieldNextAttempt, at),
+		)).
+		Updates(
+			update.BsonBuilder().
+				Set(models.OutboxFieldNextAttempt, until).
+				Inc(models.OutboxFieldAttempts, 1).
+				Build(),
+		).
+		UpdateOne(ctx)
+	if err != nil {
+		return false, errors.WrapFail(err, "claim outbox message")
+	}
+
+	return r.ModifiedCount > 0, nil
+}
+
+func (o mongoOutbox) Sent(ctx context.Context, id string, at int64) error {
+	_, err := o.c.Updater().
+		Filter(query.Id(id)).
+		Updates(
+			update.BsonBuilder().
+				Set(models.OutboxFieldStatus, models.OutboxStatusSent).
+				Set(models.OutboxFieldSentAt, at).
+				Build(),
+		).
+		UpdateOne(ctx)
+	return errors.WrapFail(err, "mark outbox message sent")
+}
+
+func (o mongoOutbox) Retry(ctx context.Context, id string, reason string, next *int64) error {
+	upd := update.BsonBuilder().Set(models.OutboxFieldLastError, reason)
+	if next == nil {
+		upd.Set(models.OutboxFieldStatus, models.OutboxStatusFailed)
+	} else {
+		upd.Set(models.OutboxFieldNextAttempt, *next)
+	}
+
+	_, err := o.c.Updater().
+		Filter(query.Id(id)).
+		Updates(upd.Build()).
+		UpdateOne(ctx)
+	return errors.WrapFail(err, "record outbox message failure")
+}
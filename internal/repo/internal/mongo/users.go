@@ -6,6 +6,7 @@ import (
 	"github.com/chenmingyong0423/go-mongox"
 	"github.com/chenmingyong0423/go-mongox/builder/query"
 	"github.com/chenmingyong0423/go-mongox/builder/update"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -87,6 +88,10 @@ func (u mongoUsers) Get(ctx context.Context, username string) (*models.User, err
 		Filter(query.Eq(models.UserFieldUsername, username)).
 		FindOne(ctx)
 
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
 	if err != nil {
 		return nil, errors.WrapFail(err, "find user by username")
 	}
@@ -94,10 +99,52 @@ func (u mongoUsers) Get(ctx context.Context, username string) (*models.User, err
 	return user, nil
 }
 
-func (u mongoUsers) Match(ctx context.Context, slot [2]int64) ([]models.User, error) {
-	interviewersOnly := query.Gt(models.UserFieldIntGrade, models.GradeNotInterviewer)
+func (u mongoUsers) List(ctx context.Context, filter models.UsersFilter) ([]models.User, error) {
+	conds := make([]any, 0, 2)
+	if filter.Category != nil {
+		conds = append(conds, query.Eq(models.UserFieldCategory, *filter.Category))
+	}
+	if filter.InterviewersOnly {
+		conds = append(conds, query.Gt(models.UserFieldIntGrade, models.GradeNotInterviewer))
+	}
+
+	q := bson.D{}
+	if len(conds) > 0 {
+		q = query.BsonBuilder().And(conds...).Build()
+	}
+
+	found, err := u.c.Finder().
+		Filter(q).
+		Find(ctx, options.Find().SetSort(bson.D{{Key: models.UserFieldUsername, Value: 1}}))
+	if err != nil {
+		return nil, errors.WrapFail(err, "find users by filter")
+	}
+
+	users := make([]models.User, 0, len(found))
+	for _, user := range found {
+		users = append(users, *user)
+	}
+
+	return users, nil
+}
+
+func (u mongoUsers) Match(
+	ctx context.Context,
+	slot [2]int64,
+	filter models.InterviewerFilter,
+) ([]models.User, error) {
+	conds := []any{
+		query.Gt(models.UserFieldIntGrade, models.GradeNotInterviewer),
+		query.Gte(models.UserFieldIntGrade, filter.MinGrade),
+	}
+	if len(filter.Usernames) > 0 {
+		conds = append(conds, query.In(models.UserFieldUsername, filter.Usernames...))
+	}
+	if len(filter.Skills) > 0 {
+		conds = append(conds, query.In(models.UserFieldSkills, filter.Skills...))
+	}
 
-	c, err := u.c.Collection().Find(ctx, interviewersOnly)
+	c, err := u.c.Collection().Find(ctx, query.BsonBuilder().And(conds...).Build())
 	if err != nil {
 		return nil, errors.WrapFail(err, "select users to match")
 	}
@@ -105,16 +152,203 @@ func (u mongoUsers) Match(ctx context.Context, slot [2]int64) ([]models.User, er
 	maxUsers := 1024
 
 	matched, err := mng.FilterFunc(ctx, c, &maxUsers, func(user models.User) bool {
-		_, canAdd := user.AddMeeting(slot)
-		return canAdd
+		return filter.Matches(user, slot)
 	})
 	if err != nil {
 		return nil, errors.WrapFail(err, "filter users")
 	}
 
+	models.RankBySkills(matched, filter.Skills)
 	return matched, nil
 }
 
+func (u mongoUsers) SetAvailability(
+	ctx context.Context,
+	username string,
+	availability *models.Availability,
+) (*models.User, error) {
+	upd := update.BsonBuilder()
+	if availability == nil {
+		upd.Unset(models.UserFieldAvailability)
+	} else {
+		upd.Set(models.UserFieldAvailability, availability)
+	}
+
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		upd.Build(),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
+func (u mongoUsers) SetNotifications(
+	ctx context.Context,
+	username string,
+	notifications models.Notifications,
+) (*models.User, error) {
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		update.Set(models.UserFieldNotifications, notifications),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
+func (u mongoUsers) SetLanguage(
+	ctx context.Context,
+	username string,
+	language models.Language,
+) (*models.User, error) {
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		update.Set(models.UserFieldLanguage, language),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
+func (u mongoUsers) SetTimeZone(
+	ctx context.Context,
+	username string,
+	timeZone string,
+) (*models.User, error) {
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		update.Set(models.UserFieldTimeZone, timeZone),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
+func (u mongoUsers) SetLimits(
+	ctx context.Context,
+	username string,
+	limits models.Limits,
+) (*models.User, error) {
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		update.Set(models.UserFieldLimits, limits),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
+func (u mongoUsers) SetSkills(
+	ctx context.Context,
+	username string,
+	skills []string,
+) (*models.User, error) {
+	r := u.c.Collection().FindOneAndUpdate(
+		ctx,
+		query.Eq(models.UserFieldUsername, username),
+		update.Set(models.UserFieldSkills, skills),
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	err := r.Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "do findOneAndUpdate")
+	}
+
+	var parsed models.User
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "parse user")
+	}
+
+	return &parsed, nil
+}
+
 func (u mongoUsers) UpdateMeetings(
 	ctx context.Context,
 	username string,
@@ -0,0 +1,83 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chenmingyong0423/go-mongox"
+	"github.com/chenmingyong0423/go-mongox/builder/query"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultVacanciesCollection = "vacancies"
+
+type mongoVacancies struct {
+	c *mongox.Collection[models.Vacancy]
+}
+
+func (v mongoVacancies) Upsert(ctx context.Context, vacancy models.Vacancy) error {
+	_, err := v.c.Collection().ReplaceOne(
+		ctx,
+		query.Id(vacancy.ID),
+		vacancy,
+		options.Replace().SetUpsert(true),
+	)
+	return errors.WrapFail(err, "upsert vacancy")
+}
+
+func (v mongoVacancies) Get(ctx context.Context, id string) (*models.Vacancy, error) {
+	found, err := v.c.Finder().
+		Filter(query.Id(id)).
+		FindOne(ctx)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "find vacancy by id")
+	}
+
+	return found, nil
+}
+
+func (v mongoVacancies) List(ctx context.Context) ([]models.Vacancy, error) {
+	found, err := v.c.Finder().
+		Filter(bson.D{}).
+		Find(ctx, options.Find().SetSort(bson.D{{Key: models.VacancyFieldID, Value: 1}}))
+	if err != nil {
+		return nil, errors.WrapFail(err, "find vacancies")
+	}
+
+	vacancies := make([]models.Vacancy, 0, len(found))
+	for _, vacancy := range found {
+		vacancies = append(vacancies, *vacancy)
+	}
+
+	return vacancies, nil
+}
+
+func (v mongoVacancies) Delete(ctx context.Context, id string) (*models.Vacancy, error) {
+	r := v.c.Collection().FindOneAndDelete(ctx, query.Id(id))
+	err := r.Err()
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapFail(err, "find one and delete")
+	}
+
+	var parsed models.Vacancy
+	err = r.Decode(&parsed)
+	if err != nil {
+		return nil, errors.WrapFail(err, "decode deleted vacancy")
+	}
+
+	return &parsed, nil
+}
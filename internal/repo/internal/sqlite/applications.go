@@ -0,0 +1,213 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const applicationColumns = `id, vacancy, candidate, stage, status, created_at`
+
+type sqliteApplications struct {
+	c *sqliteClient
+}
+
+func (a sqliteApplications) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	interview string,
+) (string, error) {
+	var id int64
+	err := a.c.atomic(ctx, func(ex executor) error {
+		r, err := ex.ExecContext(ctx,
+			`INSERT INTO applications (vacancy, candidate, status, created_at) VALUES (?, ?, ?, ?)`,
+			vacancy, candidate, models.ApplicationStatusActive, time.Now().UnixMilli(),
+		)
+		if err != nil {
+			return errors.WrapFail(err, "insert application")
+		}
+
+		id, err = r.LastInsertId()
+		if err != nil {
+			return errors.WrapFail(err, "get application id")
+		}
+
+		_, err = ex.ExecContext(ctx,
+			`INSERT INTO application_interviews (application, stage, interview) VALUES (?, 0, ?)`,
+			id, interview,
+		)
+		return errors.WrapFail(err, "insert first stage interview")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
+
+func (a sqliteApplications) Get(ctx context.Context, id string) (*models.Application, error) {
+	found, err := a.query(ctx, "id = ?", []any{id})
+	if err != nil || len(found) == 0 {
+		return nil, errors.WrapFail(err, "find application by id")
+	}
+
+	return &found[0], nil
+}
+
+func (a sqliteApplications) FindByInterview(ctx context.Context, interview string) (*models.Application, error) {
+	found, err := a.query(ctx,
+		"id IN (SELECT application FROM application_interviews WHERE interview = ?)",
+		[]any{interview},
+	)
+	if err != nil || len(found) == 0 {
+		return nil, errors.WrapFail(err, "find application by interview")
+	}
+
+	return &found[0], nil
+}
+
+func (a sqliteApplications) List(ctx context.Context, filter models.ApplicationsFilter) ([]models.Application, error) {
+	conds := []string{"1"}
+	var args []any
+
+	where := func(cond string, value any) {
+		conds = append(conds, cond)
+		args = append(args, value)
+	}
+
+	if filter.Vacancy != nil {
+		where("vacancy = ?", *filter.Vacancy)
+	}
+	if filter.Candidate != nil {
+		where("candidate = ?", *filter.Candidate)
+	}
+	if filter.Status != nil {
+		where("status = ?", *filter.Status)
+	}
+
+	found, err := a.query(ctx, strings.Join(conds, " AND "), args)
+	return found, errors.WrapFail(err, "find applications by filter")
+}
+
+func (a sqliteApplications) Advance(ctx context.Context, id string, interview string) error {
+	return a.c.atomic(ctx, func(ex executor) error {
+		r, err := ex.ExecContext(ctx,
+			`UPDATE applications SET stage = stage + 1 WHERE id = ? AND status = ?`,
+			id, models.ApplicationStatusActive,
+		)
+		err = checkApplicationModified(r, err)
+		if err != nil {
+			return err
+		}
+
+		_, err = ex.ExecContext(ctx, `
+			INSERT INTO application_interviews (application, stage, interview)
+			SELECT id, stage, ? FROM applications WHERE id = ?`,
+			interview, id,
+		)
+		return errors.WrapFail(err, "insert stage interview")
+	})
+}
+
+func (a sqliteApplications) Close(ctx context.Context, id string, status models.ApplicationStatus) error {
+	r, err := a.c.exec(ctx).ExecContext(ctx,
+		`UPDATE applications SET status = ? WHERE id = ? AND status = ?`,
+		status, id, models.ApplicationStatusActive,
+	)
+	return checkApplicationModified(r, err)
+}
+
+// query selects applications matching where clause ordered by id with their interviews
+func (a sqliteApplications) query(ctx context.Context, where string, args []any) ([]models.Application, error) {
+	var found []models.Application
+	err := a.c.read(ctx, func(ex executor) error {
+		rows, err := ex.QueryContext(ctx,
+			`SELECT `+applicationColumns+` FROM applications WHERE `+where+` ORDER BY id`,
+			args...,
+		)
+		if err != nil {
+			return errors.WrapFail(err, "select applications")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				application models.Application
+				id          int64
+			)
+
+			err = rows.Scan(
+				&id, &application.Vacancy, &application.Candidate,
+				&application.Stage, &application.Status, &application.CreatedAt,
+			)
+			if err != nil {
+				return errors.WrapFail(err, "scan application")
+			}
+
+			application.ID = strconv.FormatInt(id, 10)
+			found = append(found, application)
+		}
+
+		err = rows.Err()
+		if err != nil {
+			return errors.WrapFail(err, "iterate applications")
+		}
+
+		rows.Close()
+
+		for i := range found {
+			found[i].Interviews, err = getApplicationInterviews(ctx, ex, found[i].ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	return found, err
+}
+
+func getApplicationInterviews(ctx context.Context, ex executor, id string) ([]string, error) {
+	rows, err := ex.QueryContext(ctx,
+		`SELECT interview FROM application_interviews WHERE application = ? ORDER BY stage`, id,
+	)
+	if err != nil {
+		return nil, errors.WrapFail(err, "select application interviews")
+	}
+	defer rows.Close()
+
+	var interviews []string
+	for rows.Next() {
+		var interview string
+		err = rows.Scan(&interview)
+		if err != nil {
+			return nil, errors.WrapFail(err, "scan application interview")
+		}
+		interviews = append(interviews, interview)
+	}
+
+	return interviews, errors.WrapFail(rows.Err(), "iterate application interviews")
+}
+
+func checkApplicationModified(r sql.Result, err error) error {
+	if err != nil {
+		return errors.WrapFail(err, "update application by id")
+	}
+
+	modified, err := r.RowsAffected()
+	if err != nil {
+		return errors.WrapFail(err, "get affected rows")
+	}
+
+	if modified == 0 {
+		return errors.Error("no applications updated")
+	}
+
+	return nil
+}
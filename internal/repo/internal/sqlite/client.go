@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"sort"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const defaultBusyTimeout = 5 * time.Second
+
+type Config struct {
+	// Path is a database file, it is created if it does not exist
+	Path string `yaml:"path"`
+
+	// BusyTimeout is how long to wait for the write lock held by another txn
+	BusyTimeout time.Duration `yaml:"busyTimeout"`
+
+	// DialogTTL is time after which unfinished bot dialog is forgotten
+	DialogTTL time.Duration `yaml:"dialogTTL"`
+
+	// LegacyUTCDiff is utcDiff of the bot config, meeting times stored
+	// before they were kept in UTC are shifted back by it once
+	LegacyUTCDiff time.Duration `yaml:"-"`
+}
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// NewSQLiteClient opens the database and applies pending migrations
+func NewSQLiteClient(ctx context.Context, cfg Config) (*sqliteClient, error) {
+	if cfg.Path == "" {
+		return nil, errors.Error("sqlite database path must be provided")
+	}
+
+	busyTimeout := cfg.BusyTimeout
+	if busyTimeout <= 0 {
+		busyTimeout = defaultBusyTimeout
+	}
+
+	params := url.Values{}
+	params.Set("_busy_timeout", fmt.Sprint(busyTimeout.Milliseconds()))
+	params.Set("_journal_mode", "WAL")
+	params.Set("_foreign_keys", "on")
+
+	db, err := sql.Open("sqlite3", "file:"+cfg.Path+"?"+params.Encode())
+	if err != nil {
+		return nil, errors.WrapFail(err, "open sqlite database")
+	}
+
+	err = migrate(ctx, db, cfg)
+	if err != nil {
+		_ = db.Close()
+		return nil, errors.WrapFail(err, "migrate sqlite database")
+	}
+
+	c := &sqliteClient{db: db}
+	c.interviews = sqliteInterviews{c: c}
+	c.users = sqliteUsers{c: c}
+	c.dialogs = sqliteDialogs{c: c, ttl: cfg.DialogTTL}
+	c.outbox = sqliteOutbox{c: c}
+	c.vacancies = sqliteVacancies{c: c}
+	c.applications = sqliteApplications{c: c}
+
+	err = c.dialogs.deleteExpired(ctx)
+	if err != nil {
+		_ = db.Close()
+		return nil, errors.WrapFail(err, "delete expired dialogs")
+	}
+
+	return c, nil
+}
+
+// migrationSteps are run after the script of the same name in its
+// transaction, they change data in ways which SQL cannot express
+var migrationSteps = map[string]func(ctx context.Context, tx *sql.Tx, cfg Config) error{
+	"migrations/0014_legacy_times_to_utc.sql": shiftLegacyTimes,
+}
+
+// migrate applies migrations/NNNN_*.sql which are newer than
+// database user_version, each one in its own transaction
+func migrate(ctx context.Context, db *sql.DB, cfg Config) error {
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return errors.WrapFail(err, "list migrations")
+	}
+	sort.Strings(names)
+
+	var version int
+	err = db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version)
+	if err != nil {
+		return errors.WrapFail(err, "get schema version")
+	}
+
+	for i := version; i < len(names); i++ {
+		script, err := migrations.ReadFile(names[i])
+		if err != nil {
+			return errors.WrapFail(err, "read migration %s", names[i])
+		}
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.WrapFail(err, "begin migration txn")
+		}
+
+		_, err = tx.ExecContext(ctx, string(script))
+		if step := migrationSteps[names[i]]; err == nil && step != nil {
+			err = step(ctx, tx, cfg)
+		}
+		if err == nil {
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", i+1))
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return errors.WrapFail(err, "apply migration %s", names[i])
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return errors.WrapFail(err, "commit migration %s", names[i])
+		}
+	}
+
+	return nil
+}
+
+// shiftLegacyTimes shifts meeting times back to UTC, they were stored
+// shifted by utcDiff of the bot config
+func shiftLegacyTimes(ctx context.Context, tx *sql.Tx, cfg Config) error {
+	diff := cfg.LegacyUTCDiff.Milliseconds()
+	if diff == 0 {
+		return nil
+	}
+
+	// notification log keeps start of the meeting it was sent for
+	_, err := tx.ExecContext(ctx, `
+		UPDATE interviews
+		SET meet_start = meet_start - ?1, meet_end = meet_end - ?1, notified_at = notified_at - ?1`,
+		diff,
+	)
+	if err != nil {
+		return errors.WrapFail(err, "shift interview meetings")
+	}
+
+	// meet_start is a part of the primary key, so rows are moved out of the way
+	// first to keep it unique while they are updated one by one
+	_, err = tx.ExecContext(ctx, `UPDATE meetings SET meet_start = -meet_start`)
+	if err == nil {
+		_, err = tx.ExecContext(ctx, `UPDATE meetings SET meet_start = -meet_start - ?1, meet_end = meet_end - ?1`, diff)
+	}
+	if err != nil {
+		return errors.WrapFail(err, "shift assigned meetings")
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT username, availability FROM users WHERE availability IS NOT NULL`)
+	if err != nil {
+		return errors.WrapFail(err, "select availability")
+	}
+
+	availability := make(map[string]models.Availability)
+	for rows.Next() {
+		var (
+			username string
+			raw      string
+			a        models.Availability
+		)
+		err = rows.Scan(&username, &raw)
+		if err == nil {
+			err = json.Unmarshal([]byte(raw), &a)
+		}
+		if err != nil {
+			_ = rows.Close()
+			return errors.WrapFail(err, "read availability")
+		}
+		availability[username] = a
+	}
+	err = rows.Err()
+	if err != nil {
+		return errors.WrapFail(err, "read availability")
+	}
+
+	for username, a := range availability {
+		if len(a.Exceptions) == 0 {
+			continue
+		}
+
+		for i := range a.Exceptions {
+			a.Exceptions[i][0] -= diff
+			a.Exceptions[i][1] -= diff
+		}
+
+		raw, err := json.Marshal(a)
+		if err != nil {
+			return errors.WrapFail(err, "encode availability")
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE users SET availability = ? WHERE username = ?`, string(raw), username)
+		if err != nil {
+			return errors.WrapFail(err, "shift availability exceptions")
+		}
+	}
+
+	return nil
+}
+
+type sqliteClient struct {
+	db         *sql.DB
+	interviews sqliteInterviews
+	users      sqliteUsers
+	dialogs    sqliteDialogs
+	outbox     sqliteOutbox
+
+	vacancies    sqliteVacancies
+	applications sqliteApplications
+}
+
+func (c *sqliteClient) Interviews() models.InterviewsRepo {
+	return c.interviews
+}
+
+func (c *sqliteClient) Users() models.UsersRepo {
+	return c.users
+}
+
+func (c *sqliteClient) Dialogs() models.DialogsRepo {
+	return c.dialogs
+}
+
+func (c *sqliteClient) Outbox() models.OutboxRepo {
+	return c.outbox
+}
+
+func (c *sqliteClient) Vacancies() models.VacanciesRepo {
+	return c.vacancies
+}
+
+func (c *sqliteClient) Applications() models.ApplicationsRepo {
+	return c.applications
+}
+
+func (c *sqliteClient) Close(context.Context) error {
+	return errors.WrapFail(c.db.Close(), "close sqlite database")
+}
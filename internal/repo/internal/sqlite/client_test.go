@@ -0,0 +1,174 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+func TestNewSQLiteClient_reopen(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{Path: filepath.Join(t.TempDir(), "meowbot.db")}
+
+	c, err := NewSQLiteClient(ctx, cfg)
+	require.NoError(t, err)
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+	require.NoError(t, c.Close(ctx))
+
+	c, err = NewSQLiteClient(ctx, cfg)
+	require.NoError(t, err, "migrations are not applied twice")
+	defer c.Close(ctx)
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	require.NoError(t, err)
+
+	var version int
+	require.NoError(t, c.db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version))
+	require.Equal(t, len(names), version)
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+}
+
+func TestNewSQLiteClient_noPath(t *testing.T) {
+	_, err := NewSQLiteClient(context.Background(), Config{})
+	require.Error(t, err)
+}
+
+func TestNewSQLiteClient_legacyTimes(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{Path: filepath.Join(t.TempDir(), "meowbot.db"), LegacyUTCDiff: 3 * time.Hour}
+	shifted := func(t int64) int64 { return t + cfg.LegacyUTCDiff.Milliseconds() }
+
+	// database of the release which stored times shifted by utcDiff
+	db, err := sql.Open("sqlite3", "file:"+cfg.Path)
+	require.NoError(t, err)
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	require.NoError(t, err)
+	for _, name := range names[:13] {
+		script, err := migrations.ReadFile(name)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, string(script))
+		require.NoError(t, err, name)
+	}
+
+	for _, stmt := range []string{
+		"PRAGMA user_version = 13",
+		fmt.Sprintf(
+			`INSERT INTO interviews (id, vacancy, candidate, meet_start, meet_end, notified_at) VALUES ('legacy', 'go', 'cand', %d, %d, %d)`,
+			shifted(1000), shifted(2000), shifted(1000),
+		),
+		fmt.Sprintf(
+			`INSERT INTO users (username, availability) VALUES ('cat', '{"weekly":null,"exceptions":[[%d,%d]]}')`,
+			shifted(5000), shifted(6000),
+		),
+		// the first meeting takes start of the second one after the shift
+		fmt.Sprintf(
+			`INSERT INTO meetings (username, meet_start, meet_end) VALUES ('cat', %d, %d), ('cat', %d, %d)`,
+			shifted(shifted(1000)), shifted(shifted(2000)), shifted(1000), shifted(2000),
+		),
+	} {
+		_, err = db.ExecContext(ctx, stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.NoError(t, db.Close())
+
+	// reopen checks that times are shifted once
+	for range 2 {
+		c, err := NewSQLiteClient(ctx, cfg)
+		require.NoError(t, err)
+		require.NoError(t, c.Close(ctx))
+	}
+
+	c, err := NewSQLiteClient(ctx, cfg)
+	require.NoError(t, err)
+	defer c.Close(ctx)
+
+	interview, err := c.Interviews().Find(ctx, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, &[2]int64{1000, 2000}, interview.Meet)
+	require.Equal(t, int64(1000), interview.LastNotification.UnixTime)
+
+	user, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, []models.Meeting{{1000, 2000}, {shifted(1000), shifted(2000)}}, user.Assigned)
+	require.Equal(t, []models.Meeting{{5000, 6000}}, user.Availability.Exceptions)
+}
+
+func TestSQLiteTxn_concurrentReadWrite(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := NewSQLiteClient(ctx, Config{Path: filepath.Join(t.TempDir(), "meowbot.db")})
+	require.NoError(t, err)
+	defer c.Close(ctx)
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	const writers = 8
+
+	// every txn reads the interview before writing it, deferred txns would fail
+	// to upgrade their read lock instead of waiting for each other
+	var wg sync.WaitGroup
+	errs := make([]error, writers)
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[w] = func() error {
+				s, err := c.NewSession()
+				if err != nil {
+					return err
+				}
+				defer s.Close(ctx)
+
+				sctx := s.BindContext(ctx)
+				tx, err := s.Txn().SetIsolation(txn.Serializable).Start(sctx)
+				if err != nil {
+					return err
+				}
+				defer tx.Close(sctx)
+
+				found, err := c.Interviews().Find(sctx, id)
+				if err != nil {
+					return err
+				}
+
+				// let other txns read meanwhile
+				time.Sleep(10 * time.Millisecond)
+
+				zoom := found.Zoom + "x"
+				err = c.Interviews().Update(sctx, id, nil, nil, nil, &zoom, nil, nil, nil)
+				if err != nil {
+					return err
+				}
+
+				return tx.Commit(sctx)
+			}()
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("x", writers), found.Zoom, "no update is lost")
+}
@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// sqliteDialogs keeps data values encoded with bson, so they
+// are decoded exactly the same way as with mongo storage
+type sqliteDialogs struct {
+	c   *sqliteClient
+	ttl time.Duration
+}
+
+func (d sqliteDialogs) GetState(ctx context.Context, chatID, userID int64) (string, error) {
+	var state string
+	err := d.c.exec(ctx).QueryRowContext(ctx,
+		`SELECT state FROM dialogs WHERE chat = ? AND user = ? AND updated_at >= ?`,
+		chatID, userID, d.expiredBefore(),
+	).Scan(&state)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	return state, errors.WrapFail(err, "find dialog")
+}
+
+func (d sqliteDialogs) SetState(ctx context.Context, chatID, userID int64, state string) error {
+	return d.upsert(ctx, chatID, userID, func(ex executor) error {
+		_, err := ex.ExecContext(ctx,
+			`UPDATE dialogs SET state = ? WHERE chat = ? AND user = ?`,
+			state, chatID, userID,
+		)
+		return errors.WrapFail(err, "set dialog state")
+	})
+}
+
+func (d sqliteDialogs) ResetState(ctx context.Context, chatID, userID int64, withData bool) error {
+	if withData {
+		_, err := d.c.exec(ctx).ExecContext(ctx,
+			`DELETE FROM dialogs WHERE chat = ? AND user = ?`,
+			chatID, userID,
+		)
+		return errors.WrapFail(err, "delete dialog")
+	}
+
+	return d.SetState(ctx, chatID, userID, "")
+}
+
+func (d sqliteDialogs) UpdateData(ctx context.Context, chatID, userID int64, key string, data any) error {
+	if data == nil {
+		return d.upsert(ctx, chatID, userID, func(ex executor) error {
+			_, err := ex.ExecContext(ctx,
+				`DELETE FROM dialog_data WHERE chat = ? AND user = ? AND key = ?`,
+				chatID, userID, key,
+			)
+			return errors.WrapFail(err, "delete dialog data")
+		})
+	}
+
+	kind, raw, err := bson.MarshalValue(data)
+	if err != nil {
+		return errors.WrapFail(err, "encode dialog data")
+	}
+
+	return d.upsert(ctx, chatID, userID, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, `
+			INSERT INTO dialog_data (chat, user, key, kind, value) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (chat, user, key) DO UPDATE SET kind = excluded.kind, value = excluded.value`,
+			chatID, userID, key, int(kind), raw,
+		)
+		return errors.WrapFail(err, "set dialog data")
+	})
+}
+
+func (d sqliteDialogs) GetData(ctx context.Context, chatID, userID int64, key string, to any) (bool, error) {
+	var (
+		kind int
+		raw  []byte
+	)
+
+	err := d.c.exec(ctx).QueryRowContext(ctx, `
+		SELECT data.kind, data.value
+		FROM dialog_data data JOIN dialogs USING (chat, user)
+		WHERE chat = ? AND user = ? AND key = ? AND updated_at >= ?`,
+		chatID, userID, key, d.expiredBefore(),
+	).Scan(&kind, &raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapFail(err, "find dialog data")
+	}
+
+	err = bson.RawValue{Type: bsontype.Type(kind), Value: raw}.Unmarshal(to)
+	if err != nil {
+		return false, errors.WrapFail(err, "decode dialog data")
+	}
+
+	return true, nil
+}
+
+// upsert creates the dialog if needed (dropping the expired one) and applies f to it
+func (d sqliteDialogs) upsert(ctx context.Context, chatID, userID int64, f func(ex executor) error) error {
+	return d.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx,
+			`DELETE FROM dialogs WHERE chat = ? AND user = ? AND updated_at < ?`,
+			chatID, userID, d.expiredBefore(),
+		)
+		if err != nil {
+			return errors.WrapFail(err, "delete expired dialog")
+		}
+
+		_, err = ex.ExecContext(ctx, `
+			INSERT INTO dialogs (chat, user, updated_at) VALUES (?, ?, ?)
+			ON CONFLICT (chat, user) DO UPDATE SET updated_at = excluded.updated_at`,
+			chatID, userID, time.Now().UnixMilli(),
+		)
+		if err != nil {
+			return errors.WrapFail(err, "upsert dialog")
+		}
+
+		return f(ex)
+	})
+}
+
+func (d sqliteDialogs) deleteExpired(ctx context.Context) error {
+	_, err := d.c.db.ExecContext(ctx, `DELETE FROM dialogs WHERE updated_at < ?`, d.expiredBefore())
+	return err
+}
+
+// expiredBefore returns update time in unix millis before which dialogs are expired
+func (d sqliteDialogs) expiredBefore() int64 {
+	if d.ttl <= 0 {
+		return 0
+	}
+	return time.Now().Add(-d.ttl).UnixMilli()
+}
@@ -0,0 +1,403 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const upcomingLimit = 1024
+
+const interviewColumns = `id, vacancy, candidate, interviewer, candidate_tg, interviewer_tg,
+	data, zoom, duration, status, meet_start, meet_end, cancelled_by,
+	notified_at, notified_interviewer, notified_candidate, scorecard,
+	attended_interviewer, attended_candidate, outcome,
+	panel_interviewers, panel_shadows, panelists, min_grade, skills`
+
+type sqliteInterviews struct {
+	c *sqliteClient
+}
+
+func (s sqliteInterviews) Create(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	duration time.Duration,
+) (string, error) {
+	randomSuffix := strconv.Itoa(rand.Intn(90) + 10)
+	timestamp := strconv.FormatInt(time.Now().UnixMicro(), 16)
+	id := timestamp + randomSuffix
+
+	_, err := s.c.exec(ctx).ExecContext(ctx,
+		`INSERT INTO interviews (id, vacancy, candidate, duration) VALUES (?, ?, ?, ?)`,
+		id, vacancy, candidate, int64(duration),
+	)
+	if err != nil {
+		return "", errors.WrapFail(err, "insert interview")
+	}
+
+	return id, nil
+}
+
+func (s sqliteInterviews) Delete(ctx context.Context, id string) (*models.Interview, error) {
+	found, err := scanInterview(s.c.exec(ctx).QueryRowContext(ctx,
+		`DELETE FROM interviews WHERE id = ? RETURNING `+interviewColumns, id,
+	))
+	return found, errors.WrapFail(err, "delete interview")
+}
+
+func (s sqliteInterviews) Update(
+	ctx context.Context,
+	id string,
+	vacancy *string,
+	candidate *string,
+	data *[]byte,
+	zoom *string,
+	duration *time.Duration,
+	minGrade *int,
+	skills *[]string,
+) error {
+	var (
+		sets []string
+		args []any
+	)
+
+	set := func(column string, value any) {
+		sets = append(sets, column+" = ?")
+		args = append(args, value)
+	}
+
+	if vacancy != nil {
+		set("vacancy", *vacancy)
+	}
+	if candidate != nil {
+		set("candidate", *candidate)
+		set("candidate_tg", 0)
+	}
+	if data != nil {
+		set("data", *data)
+	}
+	if zoom != nil {
+		set("zoom", *zoom)
+	}
+	if duration != nil {
+		set("duration", int64(*duration))
+	}
+	if minGrade != nil {
+		set("min_grade", *minGrade)
+	}
+	if skills != nil {
+		encoded, err := encodeSkills(*skills)
+		if err != nil {
+			return err
+		}
+		set("skills", encoded)
+	}
+
+	if len(sets) == 0 {
+		return nil
+	}
+
+	_, err := s.c.exec(ctx).ExecContext(ctx,
+		`UPDATE interviews SET `+strings.Join(sets, ", ")+` WHERE id = ?`,
+		append(args, id)...,
+	)
+	return errors.WrapFail(err, "update interview")
+}
+
+func (s sqliteInterviews) Schedule(
+	ctx context.Context,
+	id string,
+	candidate models.User,
+	interviewer models.User,
+	panel []models.Panelist,
+	meet models.Meeting,
+) error {
+	var panelists sql.NullString
+	if panel != nil {
+		encoded, err := json.Marshal(panel)
+		if err != nil {
+			return errors.WrapFail(err, "encode panelists")
+		}
+		panelists = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	_, err := s.c.exec(ctx).ExecContext(ctx, `
+		UPDATE interviews
+		SET status = ?, interviewer_tg = ?, interviewer = ?, panelists = ?, candidate_tg = ?,
+			meet_start = ?, meet_end = ?,
+			notified_at = NULL, notified_interviewer = 0, notified_candidate = 0
+		WHERE id = ?`,
+		models.InterviewStatusScheduled, interviewer.Telegram, interviewer.Username, panelists, candidate.Telegram,
+		meet[0], meet[1],
+		id,
+	)
+	return errors.WrapFail(err, "update interview")
+}
+
+func (s sqliteInterviews) SetPanel(ctx context.Context, id string, panel models.Panel) error {
+	r, err := s.c.exec(ctx).ExecContext(ctx,
+		`UPDATE interviews SET panel_interviewers = ?, panel_shadows = ? WHERE id = ?`,
+		panel.Interviewers, panel.Shadows, id,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) RemovePanelist(ctx context.Context, id string, username string) error {
+	r, err := s.c.exec(ctx).ExecContext(ctx, `
+		UPDATE interviews
+		SET panelists = CASE WHEN panelists IS NULL THEN NULL ELSE (
+			SELECT json_group_array(json(value)) FROM json_each(panelists)
+			WHERE json_extract(value, '$.username') != ?
+		) END
+		WHERE id = ?`,
+		username, id,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) Notify(ctx context.Context, id string, at int64, notified [2]bool) error {
+	_, err := s.c.exec(ctx).ExecContext(ctx, `
+		UPDATE interviews
+		SET notified_at = ?, notified_interviewer = ?, notified_candidate = ?
+		WHERE id = ?`,
+		at, notified[models.RoleInterviewer], notified[models.RoleCandidate], id,
+	)
+	return errors.WrapFail(err, "update interview")
+}
+
+func (s sqliteInterviews) Find(ctx context.Context, id string) (*models.Interview, error) {
+	found, err := scanInterview(s.c.exec(ctx).QueryRowContext(ctx,
+		`SELECT `+interviewColumns+` FROM interviews WHERE id = ?`, id,
+	))
+	return found, errors.WrapFail(err, "find interview by id")
+}
+
+func (s sqliteInterviews) FindByUser(ctx context.Context, username string) ([]*models.Interview, error) {
+	found, err := s.query(ctx, `
+		candidate = ? OR interviewer = ? OR
+		EXISTS (SELECT 1 FROM json_each(panelists) WHERE json_extract(value, '$.username') = ?)`,
+		[]any{username, username, username}, 0,
+	)
+	return found, errors.WrapFail(err, "find interviews by user")
+}
+
+func (s sqliteInterviews) List(ctx context.Context, filter models.InterviewsFilter) ([]*models.Interview, error) {
+	conds := []string{"1"}
+	var args []any
+
+	where := func(cond string, value any) {
+		conds = append(conds, cond)
+		args = append(args, value)
+	}
+
+	if filter.Status != nil {
+		where("status = ?", *filter.Status)
+	}
+	if filter.Outcome != nil {
+		where("outcome = ?", *filter.Outcome)
+	}
+	if filter.Vacancy != nil {
+		where("vacancy = ?", *filter.Vacancy)
+	}
+	if filter.Candidate != nil {
+		where("candidate = ?", *filter.Candidate)
+	}
+	if filter.Interviewer != nil {
+		where("interviewer = ?", *filter.Interviewer)
+	}
+	if filter.From != nil {
+		where("meet_start >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		where("meet_start < ?", *filter.To)
+	}
+
+	found, err := s.query(ctx, strings.Join(conds, " AND "), args, 0)
+	return found, errors.WrapFail(err, "find interviews by filter")
+}
+
+func (s sqliteInterviews) GetUpcoming(ctx context.Context, lastNotifyBefore, startsBefore int64) ([]*models.Interview, error) {
+	found, err := s.query(ctx, `
+		status = ? AND meet_start < ? AND (
+			notified_at IS NULL OR notified_at < ? OR
+			notified_interviewer = 0 OR notified_candidate = 0
+		)`,
+		[]any{models.InterviewStatusScheduled, startsBefore, lastNotifyBefore},
+		upcomingLimit,
+	)
+	return found, errors.WrapFail(err, "find interviews started at without recent notifications")
+}
+
+func (s sqliteInterviews) Cancel(ctx context.Context, id string, side models.Role) error {
+	r, err := s.c.exec(ctx).ExecContext(ctx, `
+		UPDATE interviews
+		SET meet_start = NULL, meet_end = NULL,
+			notified_at = NULL, notified_interviewer = 0, notified_candidate = 0,
+			interviewer_tg = 0, interviewer = '', panelists = NULL, zoom = '',
+			status = ?, cancelled_by = ?
+		WHERE id = ? AND NOT (
+			status = ? AND cancelled_by = ? AND meet_start IS NULL AND notified_at IS NULL AND
+			interviewer_tg = 0 AND interviewer = '' AND panelists IS NULL AND zoom = ''
+		)`,
+		models.InterviewStatusCancelled, side, id, models.InterviewStatusCancelled, side,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) Done(ctx context.Context, id string) error {
+	r, err := s.c.exec(ctx).ExecContext(ctx,
+		`UPDATE interviews SET status = ? WHERE id = ? AND status != ?`,
+		models.InterviewStatusFinished, id, models.InterviewStatusFinished,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) Attend(ctx context.Context, id string, attendance [2]models.Attendance) error {
+	r, err := s.c.exec(ctx).ExecContext(ctx,
+		`UPDATE interviews SET attended_interviewer = ?, attended_candidate = ?, outcome = ? WHERE id = ?`,
+		attendance[models.RoleInterviewer], attendance[models.RoleCandidate], models.ResolveOutcome(attendance), id,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) Score(ctx context.Context, id string, scorecard models.Scorecard) error {
+	encoded, err := json.Marshal(scorecard)
+	if err != nil {
+		return errors.WrapFail(err, "encode scorecard")
+	}
+
+	r, err := s.c.exec(ctx).ExecContext(ctx,
+		`UPDATE interviews SET scorecard = ? WHERE id = ?`, string(encoded), id,
+	)
+	return checkModified(r, err)
+}
+
+func (s sqliteInterviews) FixTg(ctx context.Context, username string, tg int64) error {
+	ex := s.c.exec(ctx)
+
+	_, err := ex.ExecContext(ctx, `UPDATE interviews SET candidate_tg = ? WHERE candidate = ?`, tg, username)
+	if err != nil {
+		return errors.WrapFail(err, "fix tg for candidate")
+	}
+
+	_, err = ex.ExecContext(ctx, `UPDATE interviews SET interviewer_tg = ? WHERE interviewer = ?`, tg, username)
+	if err != nil {
+		return errors.WrapFail(err, "fix tg for interviewer")
+	}
+
+	return nil
+}
+
+// query selects interviews matching where clause ordered by id, limit <= 0 means no limit
+func (s sqliteInterviews) query(ctx context.Context, where string, args []any, limit int) ([]*models.Interview, error) {
+	q := `SELECT ` + interviewColumns + ` FROM interviews WHERE ` + where + ` ORDER BY id`
+	if limit > 0 {
+		q += ` LIMIT ` + strconv.Itoa(limit)
+	}
+
+	rows, err := s.c.exec(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WrapFail(err, "select interviews")
+	}
+	defer rows.Close()
+
+	var found []*models.Interview
+	for rows.Next() {
+		interview, err := scanInterview(rows)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, interview)
+	}
+
+	return found, errors.WrapFail(rows.Err(), "iterate interviews")
+}
+
+func checkModified(r sql.Result, err error) error {
+	if err != nil {
+		return errors.WrapFail(err, "update interview by id")
+	}
+
+	modified, err := r.RowsAffected()
+	if err != nil {
+		return errors.WrapFail(err, "get affected rows")
+	}
+
+	if modified == 0 {
+		return errors.Error("no interviews updated")
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanInterview reads row selected with interviewColumns, returns nil if there are no rows
+func scanInterview(row scanner) (*models.Interview, error) {
+	var (
+		i                  models.Interview
+		duration           int64
+		meetStart, meetEnd sql.NullInt64
+		notifiedAt         sql.NullInt64
+		notified           [2]bool
+		scorecard          sql.NullString
+		panelists          sql.NullString
+		skills             string
+	)
+
+	err := row.Scan(
+		&i.ID, &i.Vacancy, &i.CandidateUN, &i.InterviewerUN, &i.CandidateTg, &i.InterviewerTg,
+		&i.Data, &i.Zoom, &duration, &i.Status, &meetStart, &meetEnd, &i.CancelledBy,
+		&notifiedAt, &notified[models.RoleInterviewer], &notified[models.RoleCandidate], &scorecard,
+		&i.Attendance[models.RoleInterviewer], &i.Attendance[models.RoleCandidate], &i.Outcome,
+		&i.Panel.Interviewers, &i.Panel.Shadows, &panelists, &i.MinGrade, &skills,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapFail(err, "scan interview")
+	}
+
+	i.Duration = time.Duration(duration)
+
+	if meetStart.Valid {
+		i.Meet = &[2]int64{meetStart.Int64, meetEnd.Int64}
+	}
+
+	if notifiedAt.Valid {
+		i.LastNotification = &models.NotificationLog{UnixTime: notifiedAt.Int64, Notified: notified}
+	}
+
+	if scorecard.Valid {
+		i.Scorecard = &models.Scorecard{}
+		err = json.Unmarshal([]byte(scorecard.String), i.Scorecard)
+		if err != nil {
+			return nil, errors.WrapFail(err, "decode scorecard")
+		}
+	}
+
+	if panelists.Valid {
+		err = json.Unmarshal([]byte(panelists.String), &i.Panelists)
+		if err != nil {
+			return nil, errors.WrapFail(err, "decode panelists")
+		}
+	}
+
+	i.Skills, err = decodeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
@@ -0,0 +1,55 @@
+CREATE TABLE interviews (
+    id                   TEXT PRIMARY KEY,
+    vacancy              TEXT    NOT NULL,
+    candidate            TEXT    NOT NULL,
+    interviewer          TEXT    NOT NULL DEFAULT '',
+    candidate_tg         INTEGER NOT NULL DEFAULT 0,
+    interviewer_tg       INTEGER NOT NULL DEFAULT 0,
+    data                 BLOB,
+    zoom                 TEXT    NOT NULL DEFAULT '',
+    duration             INTEGER NOT NULL DEFAULT 0,
+    status               INTEGER NOT NULL DEFAULT 0,
+    meet_start           INTEGER,
+    meet_end             INTEGER,
+    cancelled_by         INTEGER NOT NULL DEFAULT 0,
+    notified_at          INTEGER,
+    notified_interviewer INTEGER NOT NULL DEFAULT 0,
+    notified_candidate   INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX interviews_candidate ON interviews (candidate);
+CREATE INDEX interviews_interviewer ON interviews (interviewer);
+CREATE INDEX interviews_status_meet ON interviews (status, meet_start);
+
+CREATE TABLE users (
+    username     TEXT PRIMARY KEY,
+    telegram     INTEGER NOT NULL DEFAULT 0,
+    category     INTEGER NOT NULL DEFAULT 0,
+    int_grade    INTEGER NOT NULL DEFAULT 0,
+    availability TEXT
+);
+
+CREATE TABLE meetings (
+    username   TEXT    NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+    meet_start INTEGER NOT NULL,
+    meet_end   INTEGER NOT NULL,
+    PRIMARY KEY (username, meet_start)
+);
+
+CREATE TABLE dialogs (
+    chat       INTEGER NOT NULL,
+    user       INTEGER NOT NULL,
+    state      TEXT    NOT NULL DEFAULT '',
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (chat, user)
+);
+
+CREATE TABLE dialog_data (
+    chat  INTEGER NOT NULL,
+    user  INTEGER NOT NULL,
+    key   TEXT    NOT NULL,
+    kind  INTEGER NOT NULL,
+    value BLOB    NOT NULL,
+    PRIMARY KEY (chat, user, key),
+    FOREIGN KEY (chat, user) REFERENCES dialogs (chat, user) ON DELETE CASCADE
+);
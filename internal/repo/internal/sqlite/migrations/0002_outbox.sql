@@ -0,0 +1,13 @@
+CREATE TABLE outbox (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    recipient    INTEGER NOT NULL,
+    text         TEXT    NOT NULL,
+    status       INTEGER NOT NULL DEFAULT 0,
+    created_at   INTEGER NOT NULL,
+    next_attempt INTEGER NOT NULL,
+    attempts     INTEGER NOT NULL DEFAULT 0,
+    last_error   TEXT    NOT NULL DEFAULT '',
+    sent_at      INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX outbox_status_next_attempt ON outbox (status, next_attempt);
@@ -0,0 +1,6 @@
+ALTER TABLE users ADD COLUMN notifications TEXT;
+
+ALTER TABLE outbox ADD COLUMN channel TEXT NOT NULL DEFAULT 'telegram';
+ALTER TABLE outbox ADD COLUMN address TEXT NOT NULL DEFAULT '';
+UPDATE outbox SET address = CAST(recipient AS TEXT);
+ALTER TABLE outbox DROP COLUMN recipient;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN chosen_locale TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN telegram_locale TEXT NOT NULL DEFAULT '';
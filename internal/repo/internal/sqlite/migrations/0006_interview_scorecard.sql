@@ -0,0 +1 @@
+ALTER TABLE interviews ADD COLUMN scorecard TEXT;
@@ -0,0 +1,3 @@
+ALTER TABLE interviews ADD COLUMN attended_interviewer INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE interviews ADD COLUMN attended_candidate INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE interviews ADD COLUMN outcome INTEGER NOT NULL DEFAULT 0;
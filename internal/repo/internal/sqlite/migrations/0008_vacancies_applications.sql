@@ -0,0 +1,29 @@
+CREATE TABLE vacancies (
+    id           TEXT PRIMARY KEY,
+    title        TEXT    NOT NULL DEFAULT '',
+    interviewers TEXT    NOT NULL DEFAULT '[]',
+    min_grade    INTEGER NOT NULL DEFAULT 0,
+    duration     INTEGER NOT NULL DEFAULT 0,
+    zoom         TEXT    NOT NULL DEFAULT '',
+    stages       TEXT    NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE applications (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    vacancy    TEXT    NOT NULL,
+    candidate  TEXT    NOT NULL,
+    stage      INTEGER NOT NULL DEFAULT 0,
+    status     INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX applications_candidate ON applications (candidate);
+
+CREATE TABLE application_interviews (
+    application INTEGER NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    stage       INTEGER NOT NULL,
+    interview   TEXT    NOT NULL,
+    PRIMARY KEY (application, stage)
+);
+
+CREATE INDEX application_interviews_interview ON application_interviews (interview);
@@ -0,0 +1,5 @@
+ALTER TABLE interviews ADD COLUMN panel_interviewers INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE interviews ADD COLUMN panel_shadows INTEGER NOT NULL DEFAULT 0;
+
+-- JSON array of panelists booked besides the lead interviewer
+ALTER TABLE interviews ADD COLUMN panelists TEXT;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN limit_per_day INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN limit_per_week INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE interviews ADD COLUMN min_grade INTEGER NOT NULL DEFAULT 0;
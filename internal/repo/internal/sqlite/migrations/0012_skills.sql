@@ -0,0 +1,4 @@
+-- JSON arrays of skill tags
+ALTER TABLE users ADD COLUMN skills TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE vacancies ADD COLUMN skills TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE interviews ADD COLUMN skills TEXT NOT NULL DEFAULT '[]';
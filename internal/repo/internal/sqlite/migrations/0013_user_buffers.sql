@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN buffer_before INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN buffer_after INTEGER NOT NULL DEFAULT 0;
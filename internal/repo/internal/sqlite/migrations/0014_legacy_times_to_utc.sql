@@ -0,0 +1,2 @@
+-- meeting times were stored shifted by utcDiff of the bot config,
+-- they are shifted back by shiftLegacyTimes which knows the diff
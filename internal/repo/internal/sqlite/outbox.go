@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const outboxColumns = `id, channel, address, text, status, created_at, next_attempt, attempts, last_error, sent_at`
+
+type sqliteOutbox struct {
+	c *sqliteClient
+}
+
+func (o sqliteOutbox) Add(ctx context.Context, to models.Contact, text string) (string, error) {
+	now := time.Now().UnixMilli()
+
+	r, err := o.c.exec(ctx).ExecContext(ctx,
+		`INSERT INTO outbox (channel, address, text, created_at, next_attempt) VALUES (?, ?, ?, ?, ?)`,
+		to.Channel, to.Address, text, now, now,
+	)
+	if err != nil {
+		return "", errors.WrapFail(err, "insert outbox message")
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return "", errors.WrapFail(err, "get outbox message id")
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
+
+func (o sqliteOutbox) Pending(ctx context.Context, now int64, limit int) ([]models.OutboxMessage, error) {
+	var found []models.OutboxMessage
+	err := o.c.read(ctx, func(ex executor) error {
+		rows, err := ex.QueryContext(ctx,
+			`SELECT `+outboxColumns+` FROM outbox
+			WHERE status = ? AND next_attempt <= ?
+			ORDER BY created_at, id LIMIT ?`,
+			models.OutboxStatusPending, now, limit,
+		)
+		if err != nil {
+			return errors.WrapFail(err, "select pending outbox messages")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				msg models.OutboxMessage
+				id  int64
+			)
+
+			err = rows.Scan(
+				&id, &msg.Channel, &msg.Address, &msg.Text, &msg.Status, &msg.CreatedAt,
+				&msg.NextAttempt, &msg.Attempts, &msg.LastError, &msg.SentAt,
+			)
+			if err != nil {
+				return errors.WrapFail(err, "scan outbox message")
+			}
+
+			msg.ID = strconv.FormatInt(id, 10)
+			found = append(found, msg)
+		}
+
+		return errors.WrapFail(rows.Err(), "iterate outbox messages")
+	})
+	return found, err
+}
+
+func (o sqliteOutbox) Claim(ctx context.Context, id string, at int64, until int64) (bool, error) {
+	r, err := o.c.exec(ctx).ExecContext(ctx,
+		`UPDATE outbox SET next_attempt = ?, attempts = attempts + 1
+		WHERE id = ? AND status = ? AND next_attempt = ?`,
+		until, id, models.OutboxStatusPending, at,
+	)
+	if err != nil {
+		return false, errors.WrapFail(err, "claim outbox message")
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return false, errors.WrapFail(err, "get affected rows")
+	}
+
+	return n > 0, nil
+}
+
+func (o sqliteOutbox) Sent(ctx context.Context, id string, at int64) error {
+	_, err := o.c.exec(ctx).ExecContext(ctx,
+		`UPDATE outbox SET status = ?, sent_at = ? WHERE id = ?`,
+		models.OutboxStatusSent, at, id,
+	)
+	return errors.WrapFail(err, "mark outbox message sent")
+}
+
+func (o sqliteOutbox) Retry(ctx context.Context, id string, reason string, next *int64) error {
+	var err error
+	if next == nil {
+		_, err = o.c.exec(ctx).ExecContext(ctx,
+			`UPDATE outbox SET status = ?, last_error = ? WHERE id = ?`,
+			models.OutboxStatusFailed, reason, id,
+		)
+	} else {
+		_, err = o.c.exec(ctx).ExecContext(ctx,
+			`UPDATE outbox SET next_attempt = ?, last_error = ? WHERE id = ?`,
+			*next, reason, id,
+		)
+	}
+	return errors.WrapFail(err, "record outbox message failure")
+}
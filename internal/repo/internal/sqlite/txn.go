@@ -0,0 +1,236 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+// executor is implemented by *sql.DB, *sql.Conn and *sql.Tx
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type sessionKey struct{}
+
+func (c *sqliteClient) NewSession() (txn.Session, error) {
+	return &session{db: c.db}, nil
+}
+
+// exec returns txn running in the session bound to ctx or the database itself
+func (c *sqliteClient) exec(ctx context.Context) executor {
+	if conn := txFromContext(ctx); conn != nil {
+		return conn
+	}
+	return c.db
+}
+
+// atomic runs f in txn of ctx if any, otherwise in a new txn which takes the write
+// lock at once, so that read-check-write sequences do not race with other writers
+func (c *sqliteClient) atomic(ctx context.Context, f func(ex executor) error) error {
+	if conn := txFromContext(ctx); conn != nil {
+		return f(conn)
+	}
+
+	conn, err := beginImmediate(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = f(conn)
+	if err != nil {
+		return errors.Join(err, rollback(ctx, conn))
+	}
+
+	_, err = conn.ExecContext(ctx, "COMMIT")
+	if err != nil {
+		return errors.Join(errors.WrapFail(err, "commit txn"), rollback(ctx, conn))
+	}
+	return nil
+}
+
+// beginImmediate starts txn on a dedicated connection taking the write lock at once,
+// the driver ignores sql.TxOptions and BeginTx would start a deferred one. Concurrent
+// writers wait for the lock within busy timeout instead of failing on upgrade from read.
+func beginImmediate(ctx context.Context, db *sql.DB) (*sql.Conn, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, errors.WrapFail(err, "get connection")
+	}
+
+	_, err = conn.ExecContext(ctx, "BEGIN IMMEDIATE")
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.WrapFail(err, "begin txn")
+	}
+
+	return conn, nil
+}
+
+// rollback aborts txn started by beginImmediate, it is a no-op if there is none
+func rollback(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(context.WithoutCancel(ctx), "ROLLBACK")
+	if err != nil && strings.Contains(err.Error(), "no transaction is active") {
+		return nil
+	}
+	return errors.WrapFail(err, "rollback txn")
+}
+
+// read runs f in txn of ctx if any, otherwise in a new read-only txn,
+// so that several selects see consistent data
+func (c *sqliteClient) read(ctx context.Context, f func(ex executor) error) error {
+	if conn := txFromContext(ctx); conn != nil {
+		return f(conn)
+	}
+
+	tx, err := c.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return errors.WrapFail(err, "begin txn")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	return f(tx)
+}
+
+type session struct {
+	db *sql.DB
+
+	mu      sync.Mutex
+	running *sql.Conn
+}
+
+func (s *session) BindContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+func (s *session) Txn() txn.Txn {
+	return &sqliteTxn{session: s}
+}
+
+func (s *session) Close(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running != nil {
+		_ = rollback(ctx, s.running)
+		_ = s.running.Close()
+		s.running = nil
+	}
+}
+
+// txFromContext returns connection of txn running in the session bound to ctx, nil if there is none
+func txFromContext(ctx context.Context) *sql.Conn {
+	s, ok := ctx.Value(sessionKey{}).(*session)
+	if !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// sqliteTxn is a write transaction started with BEGIN IMMEDIATE. It holds the write
+// lock of the database from the start, so read-then-write sequences of concurrent
+// txns wait for each other like atomic() does instead of failing with SQLITE_BUSY.
+type sqliteTxn struct {
+	session *session
+	conn    *sql.Conn
+	err     error
+}
+
+func (t *sqliteTxn) SetModel(txn.ConsistencyModel) txn.Txn {
+	// single writer makes all committed txns linearizable
+	return t
+}
+
+// SetIsolation only checks the level is known: the driver has no per-txn isolation,
+// txns holding the write lock from the start are serialized with all other writers
+func (t *sqliteTxn) SetIsolation(lvl txn.IsolationLevel) txn.Txn {
+	if t.err != nil {
+		return t
+	}
+
+	switch lvl {
+	case txn.ReadUncommitted, txn.ReadCommitted, txn.SnapshotIsolation, txn.Serializable:
+	default:
+		t.err = errors.Error("unsupported isolation level")
+	}
+
+	return t
+}
+
+func (t *sqliteTxn) Start(ctx context.Context) (txn.ActiveTxn, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	s := t.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running != nil {
+		return nil, errors.Error("transaction already in progress")
+	}
+
+	conn, err := beginImmediate(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	t.conn = conn
+	s.running = conn
+	return t, nil
+}
+
+func (t *sqliteTxn) Abort(ctx context.Context) error {
+	if !t.detach() {
+		return sql.ErrTxDone
+	}
+	defer t.conn.Close()
+
+	return rollback(ctx, t.conn)
+}
+
+func (t *sqliteTxn) Commit(ctx context.Context) error {
+	if !t.detach() {
+		return sql.ErrTxDone
+	}
+	defer t.conn.Close()
+
+	_, err := t.conn.ExecContext(ctx, "COMMIT")
+	if err != nil {
+		return errors.Join(errors.WrapFail(err, "commit txn"), rollback(ctx, t.conn))
+	}
+	return nil
+}
+
+func (t *sqliteTxn) Close(ctx context.Context) error {
+	if !t.detach() {
+		return nil
+	}
+	defer t.conn.Close()
+
+	return errors.WrapFail(rollback(ctx, t.conn), "rollback running txn")
+}
+
+// detach unbinds the txn from the session, false if it has been finished already
+func (t *sqliteTxn) detach() bool {
+	s := t.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running != t.conn {
+		return false
+	}
+
+	s.running = nil
+	return true
+}
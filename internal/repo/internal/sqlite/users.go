@@ -0,0 +1,476 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const matchLimit = 1024
+
+const userColumns = `username, telegram, category, int_grade, availability, notifications, chosen_locale, telegram_locale, time_zone,
+	limit_per_day, limit_per_week, buffer_before, buffer_after, skills`
+
+type sqliteUsers struct {
+	c *sqliteClient
+}
+
+func (s sqliteUsers) Update(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+) (*models.User, error) {
+	return s.findOneAndUpdate(ctx, username, telegramID, category, intGrade, false)
+}
+
+func (s sqliteUsers) Upsert(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+) (*models.User, error) {
+	return s.findOneAndUpdate(ctx, username, telegramID, category, intGrade, true)
+}
+
+// findOneAndUpdate returns user state before the update,
+// so it is nil for a just inserted user.
+func (s sqliteUsers) findOneAndUpdate(
+	ctx context.Context,
+	username string,
+	telegramID *int64,
+	category *models.UserCategory,
+	intGrade *int,
+	upsert bool,
+) (*models.User, error) {
+	var old *models.User
+	err := s.c.atomic(ctx, func(ex executor) error {
+		var err error
+		old, err = getUser(ctx, ex, username)
+		if err != nil {
+			return err
+		}
+
+		if old == nil && !upsert {
+			return nil
+		}
+
+		_, err = ex.ExecContext(ctx, `
+			INSERT INTO users (username, telegram, category, int_grade)
+			VALUES (?1, COALESCE(?2, 0), COALESCE(?3, 0), COALESCE(?4, 0))
+			ON CONFLICT (username) DO UPDATE SET
+				telegram = COALESCE(?2, telegram),
+				category = COALESCE(?3, category),
+				int_grade = COALESCE(?4, int_grade)`,
+			username, telegramID, category, intGrade,
+		)
+		return errors.WrapFail(err, "upsert user")
+	})
+	return old, err
+}
+
+func (s sqliteUsers) Get(ctx context.Context, username string) (*models.User, error) {
+	var found *models.User
+	err := s.c.read(ctx, func(ex executor) error {
+		var err error
+		found, err = getUser(ctx, ex, username)
+		return err
+	})
+	return found, err
+}
+
+func (s sqliteUsers) List(ctx context.Context, filter models.UsersFilter) ([]models.User, error) {
+	conds := []string{"1"}
+	var args []any
+
+	if filter.Category != nil {
+		conds = append(conds, "category = ?")
+		args = append(args, *filter.Category)
+	}
+	if filter.InterviewersOnly {
+		conds = append(conds, "int_grade > ?")
+		args = append(args, models.GradeNotInterviewer)
+	}
+
+	found, err := s.query(ctx, strings.Join(conds, " AND "), args, nil)
+	if err != nil {
+		return nil, errors.WrapFail(err, "find users by filter")
+	}
+
+	if found == nil {
+		found = []models.User{}
+	}
+
+	return found, nil
+}
+
+func (s sqliteUsers) Match(
+	ctx context.Context,
+	slot [2]int64,
+	filter models.InterviewerFilter,
+) ([]models.User, error) {
+	limit := matchLimit
+	where := "int_grade > ? AND int_grade >= ?"
+	args := []any{models.GradeNotInterviewer, filter.MinGrade}
+
+	matched, err := s.query(ctx, where, args, func(user models.User) bool {
+		if limit == 0 {
+			return false
+		}
+
+		canAdd := filter.Matches(user, slot)
+		if canAdd {
+			limit--
+		}
+		return canAdd
+	})
+	if err != nil {
+		return nil, errors.WrapFail(err, "select users to match")
+	}
+
+	models.RankBySkills(matched, filter.Skills)
+	return matched, nil
+}
+
+func (s sqliteUsers) SetAvailability(
+	ctx context.Context,
+	username string,
+	availability *models.Availability,
+) (*models.User, error) {
+	var encoded sql.NullString
+	if availability != nil {
+		raw, err := json.Marshal(availability)
+		if err != nil {
+			return nil, errors.WrapFail(err, "encode availability")
+		}
+		encoded = sql.NullString{String: string(raw), Valid: true}
+	}
+
+	var updated *models.User
+	err := s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, `UPDATE users SET availability = ? WHERE username = ?`, encoded, username)
+		if err != nil {
+			return errors.WrapFail(err, "update availability")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+func (s sqliteUsers) SetNotifications(
+	ctx context.Context,
+	username string,
+	notifications models.Notifications,
+) (*models.User, error) {
+	encoded, err := json.Marshal(notifications)
+	if err != nil {
+		return nil, errors.WrapFail(err, "encode notifications")
+	}
+
+	var updated *models.User
+	err = s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, `UPDATE users SET notifications = ? WHERE username = ?`, string(encoded), username)
+		if err != nil {
+			return errors.WrapFail(err, "update notifications")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+func (s sqliteUsers) SetLanguage(
+	ctx context.Context,
+	username string,
+	language models.Language,
+) (*models.User, error) {
+	var updated *models.User
+	err := s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx,
+			`UPDATE users SET chosen_locale = ?, telegram_locale = ? WHERE username = ?`,
+			language.Chosen, language.Telegram, username,
+		)
+		if err != nil {
+			return errors.WrapFail(err, "update language")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+func (s sqliteUsers) SetTimeZone(
+	ctx context.Context,
+	username string,
+	timeZone string,
+) (*models.User, error) {
+	var updated *models.User
+	err := s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, `UPDATE users SET time_zone = ? WHERE username = ?`, timeZone, username)
+		if err != nil {
+			return errors.WrapFail(err, "update time zone")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+func (s sqliteUsers) SetLimits(
+	ctx context.Context,
+	username string,
+	limits models.Limits,
+) (*models.User, error) {
+	var updated *models.User
+	err := s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx,
+			`UPDATE users SET limit_per_day = ?, limit_per_week = ?, buffer_before = ?, buffer_after = ? WHERE username = ?`,
+			limits.PerDay, limits.PerWeek, int64(limits.BufferBefore), int64(limits.BufferAfter), username,
+		)
+		if err != nil {
+			return errors.WrapFail(err, "update limits")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+func (s sqliteUsers) SetSkills(
+	ctx context.Context,
+	username string,
+	skills []string,
+) (*models.User, error) {
+	encoded, err := encodeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *models.User
+	err = s.c.atomic(ctx, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, `UPDATE users SET skills = ? WHERE username = ?`, encoded, username)
+		if err != nil {
+			return errors.WrapFail(err, "update skills")
+		}
+
+		updated, err = getUser(ctx, ex, username)
+		return err
+	})
+	return updated, err
+}
+
+// UpdateMeetings replaces assigned meetings only if they are still equal to old
+func (s sqliteUsers) UpdateMeetings(
+	ctx context.Context,
+	username string,
+	meets []models.Meeting,
+	old []models.Meeting,
+) (bool, error) {
+	var modified bool
+	err := s.c.atomic(ctx, func(ex executor) error {
+		user, err := getUser(ctx, ex, username)
+		if err != nil || user == nil {
+			return err
+		}
+
+		if !slices.Equal(user.Assigned, old) || slices.Equal(user.Assigned, meets) {
+			return nil
+		}
+
+		_, err = ex.ExecContext(ctx, `DELETE FROM meetings WHERE username = ?`, username)
+		if err != nil {
+			return errors.WrapFail(err, "delete meetings")
+		}
+
+		for _, meet := range meets {
+			_, err = ex.ExecContext(ctx,
+				`INSERT INTO meetings (username, meet_start, meet_end) VALUES (?, ?, ?)`,
+				username, meet[0], meet[1],
+			)
+			if err != nil {
+				return errors.WrapFail(err, "insert meeting")
+			}
+		}
+
+		modified = true
+		return nil
+	})
+	return modified, err
+}
+
+// query selects users matching where clause ordered by username with their meetings,
+// keep filters loaded users (nil keeps everything)
+func (s sqliteUsers) query(
+	ctx context.Context,
+	where string,
+	args []any,
+	keep func(user models.User) bool,
+) ([]models.User, error) {
+	var found []models.User
+	err := s.c.read(ctx, func(ex executor) error {
+		rows, err := ex.QueryContext(ctx,
+			`SELECT `+userColumns+` FROM users WHERE `+where+` ORDER BY username`,
+			args...,
+		)
+		if err != nil {
+			return errors.WrapFail(err, "select users")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			user, err := scanUser(rows)
+			if err != nil {
+				return err
+			}
+			found = append(found, *user)
+		}
+
+		err = rows.Err()
+		if err != nil {
+			return errors.WrapFail(err, "iterate users")
+		}
+
+		rows.Close()
+
+		for i := range found {
+			found[i].Assigned, err = getMeetings(ctx, ex, found[i].Username)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil || keep == nil {
+		return found, err
+	}
+
+	kept := make([]models.User, 0, len(found))
+	for _, user := range found {
+		if keep(user) {
+			kept = append(kept, user)
+		}
+	}
+	return kept, nil
+}
+
+// getUser returns user with meetings or nil if the user does not exist
+func getUser(ctx context.Context, ex executor, username string) (*models.User, error) {
+	user, err := scanUser(ex.QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE username = ?`, username,
+	))
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	user.Assigned, err = getMeetings(ctx, ex, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func getMeetings(ctx context.Context, ex executor, username string) ([]models.Meeting, error) {
+	rows, err := ex.QueryContext(ctx,
+		`SELECT meet_start, meet_end FROM meetings WHERE username = ? ORDER BY meet_start`, username,
+	)
+	if err != nil {
+		return nil, errors.WrapFail(err, "select meetings")
+	}
+	defer rows.Close()
+
+	var meets []models.Meeting
+	for rows.Next() {
+		var meet models.Meeting
+		err = rows.Scan(&meet[0], &meet[1])
+		if err != nil {
+			return nil, errors.WrapFail(err, "scan meeting")
+		}
+		meets = append(meets, meet)
+	}
+
+	return meets, errors.WrapFail(rows.Err(), "iterate meetings")
+}
+
+// scanUser reads row selected with userColumns without meetings, returns nil if there are no rows
+func scanUser(row scanner) (*models.User, error) {
+	var (
+		user          models.User
+		availability  sql.NullString
+		notifications sql.NullString
+		skills        string
+	)
+
+	err := row.Scan(
+		&user.Username, &user.Telegram, &user.Category, &user.IntGrade,
+		&availability, &notifications, &user.Language.Chosen, &user.Language.Telegram,
+		&user.TimeZone, &user.Limits.PerDay, &user.Limits.PerWeek,
+		&user.Limits.BufferBefore, &user.Limits.BufferAfter, &skills,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapFail(err, "scan user")
+	}
+
+	if availability.Valid {
+		user.Availability = &models.Availability{}
+		err = json.Unmarshal([]byte(availability.String), user.Availability)
+		if err != nil {
+			return nil, errors.WrapFail(err, "decode availability")
+		}
+	}
+
+	if notifications.Valid {
+		err = json.Unmarshal([]byte(notifications.String), &user.Notifications)
+		if err != nil {
+			return nil, errors.WrapFail(err, "decode notifications")
+		}
+	}
+
+	user.Skills, err = decodeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// encodeSkills returns JSON array of skills, never null
+func encodeSkills(skills []string) (string, error) {
+	if len(skills) == 0 {
+		return "[]", nil
+	}
+
+	encoded, err := json.Marshal(skills)
+	return string(encoded), errors.WrapFail(err, "encode skills")
+}
+
+// decodeSkills reads JSON array of skills, empty one is decoded to nil
+func decodeSkills(raw string) ([]string, error) {
+	var skills []string
+	err := json.Unmarshal([]byte(raw), &skills)
+	if err != nil {
+		return nil, errors.WrapFail(err, "decode skills")
+	}
+
+	if len(skills) == 0 {
+		return nil, nil
+	}
+	return skills, nil
+}
@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const vacancyColumns = `id, title, interviewers, min_grade, duration, zoom, stages, skills`
+
+type sqliteVacancies struct {
+	c *sqliteClient
+}
+
+func (v sqliteVacancies) Upsert(ctx context.Context, vacancy models.Vacancy) error {
+	interviewers, err := json.Marshal(vacancy.Interviewers)
+	if err != nil {
+		return errors.WrapFail(err, "encode interviewers")
+	}
+
+	stages, err := json.Marshal(vacancy.Stages)
+	if err != nil {
+		return errors.WrapFail(err, "encode stages")
+	}
+
+	skills, err := encodeSkills(vacancy.Skills)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.c.exec(ctx).ExecContext(ctx, `
+		INSERT INTO vacancies (`+vacancyColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			title = excluded.title, interviewers = excluded.interviewers, min_grade = excluded.min_grade,
+			duration = excluded.duration, zoom = excluded.zoom, stages = excluded.stages,
+			skills = excluded.skills`,
+		vacancy.ID, vacancy.Title, string(interviewers), vacancy.MinGrade,
+		int64(vacancy.Duration), vacancy.Zoom, string(stages), skills,
+	)
+	return errors.WrapFail(err, "upsert vacancy")
+}
+
+func (v sqliteVacancies) Get(ctx context.Context, id string) (*models.Vacancy, error) {
+	found, err := scanVacancy(v.c.exec(ctx).QueryRowContext(ctx,
+		`SELECT `+vacancyColumns+` FROM vacancies WHERE id = ?`, id,
+	))
+	return found, errors.WrapFail(err, "find vacancy by id")
+}
+
+func (v sqliteVacancies) List(ctx context.Context) ([]models.Vacancy, error) {
+	rows, err := v.c.exec(ctx).QueryContext(ctx, `SELECT `+vacancyColumns+` FROM vacancies ORDER BY id`)
+	if err != nil {
+		return nil, errors.WrapFail(err, "select vacancies")
+	}
+	defer rows.Close()
+
+	var found []models.Vacancy
+	for rows.Next() {
+		vacancy, err := scanVacancy(rows)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, *vacancy)
+	}
+
+	return found, errors.WrapFail(rows.Err(), "iterate vacancies")
+}
+
+func (v sqliteVacancies) Delete(ctx context.Context, id string) (*models.Vacancy, error) {
+	found, err := scanVacancy(v.c.exec(ctx).QueryRowContext(ctx,
+		`DELETE FROM vacancies WHERE id = ? RETURNING `+vacancyColumns, id,
+	))
+	return found, errors.WrapFail(err, "delete vacancy")
+}
+
+// scanVacancy reads row selected with vacancyColumns, returns nil if there are no rows
+func scanVacancy(row scanner) (*models.Vacancy, error) {
+	var (
+		v                    models.Vacancy
+		interviewers, stages string
+		skills               string
+		duration             int64
+	)
+
+	err := row.Scan(&v.ID, &v.Title, &interviewers, &v.MinGrade, &duration, &v.Zoom, &stages, &skills)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapFail(err, "scan vacancy")
+	}
+
+	v.Duration = time.Duration(duration)
+
+	err = json.Unmarshal([]byte(interviewers), &v.Interviewers)
+	if err != nil {
+		return nil, errors.WrapFail(err, "decode interviewers")
+	}
+
+	err = json.Unmarshal([]byte(stages), &v.Stages)
+	if err != nil {
+		return nil, errors.WrapFail(err, "decode stages")
+	}
+
+	v.Skills, err = decodeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
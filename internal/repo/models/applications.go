@@ -0,0 +1,105 @@
+package models
+
+import "context"
+
+// ApplicationsRepo keeps pipelines of candidates: an application links the candidate
+// to the vacancy and collects interviews of its stages
+type ApplicationsRepo interface {
+	// Create registers an active application at the first stage with its interview
+	Create(ctx context.Context, vacancy string, candidate string, interview string) (id string, err error)
+
+	// Get returns nil if application does not exist
+	Get(ctx context.Context, id string) (*Application, error)
+
+	// FindByInterview returns the application having the interview at any stage, nil if there is no such
+	FindByInterview(ctx context.Context, interview string) (*Application, error)
+
+	// List returns applications matching the filter ordered by id
+	List(ctx context.Context, filter ApplicationsFilter) ([]Application, error)
+
+	// Advance moves the active application to the next stage with the interview
+	Advance(ctx context.Context, id string, interview string) error
+
+	// Close sets the final status of the active application
+	Close(ctx context.Context, id string, status ApplicationStatus) error
+}
+
+type Application struct {
+	ID        string `json:"id"        bson:"_id"`
+	Vacancy   string `json:"vacancy"   bson:"vacancy"`
+	Candidate string `json:"candidate" bson:"candidate"`
+
+	// Stage is the index of the current stage in Vacancy.Stages
+	Stage int `json:"stage" bson:"stage"`
+
+	// Interviews are ids of interviews of passed stages and the current one, indexed by stage
+	Interviews []string `json:"interviews" bson:"interviews"`
+
+	Status    ApplicationStatus `json:"status"     bson:"status"`
+	CreatedAt int64             `json:"created_at" bson:"created_at"`
+}
+
+// Interview returns id of the current stage interview
+func (a Application) Interview() string {
+	if a.Stage < 0 || a.Stage >= len(a.Interviews) {
+		return ""
+	}
+	return a.Interviews[a.Stage]
+}
+
+const (
+	ApplicationFieldID         = "_id"
+	ApplicationFieldVacancy    = "vacancy"
+	ApplicationFieldCandidate  = "candidate"
+	ApplicationFieldStage      = "stage"
+	ApplicationFieldInterviews = "interviews"
+	ApplicationFieldStatus     = "status"
+	ApplicationFieldCreatedAt  = "created_at"
+)
+
+// ApplicationsFilter selects applications for List, nil fields match everything
+type ApplicationsFilter struct {
+	Vacancy   *string
+	Candidate *string
+	Status    *ApplicationStatus
+}
+
+type ApplicationStatus int
+
+const (
+	// ApplicationStatusActive is set while the candidate passes stages
+	ApplicationStatusActive = ApplicationStatus(iota)
+
+	// ApplicationStatusPassed is set when the last stage is passed
+	ApplicationStatusPassed
+
+	// ApplicationStatusRejected is set when a stage is not passed
+	ApplicationStatusRejected
+
+	// ApplicationStatusWithdrawn is set when HR has closed the application
+	ApplicationStatusWithdrawn
+)
+
+var applicationStatusNames = [...]string{
+	ApplicationStatusActive:    "active",
+	ApplicationStatusPassed:    "passed",
+	ApplicationStatusRejected:  "rejected",
+	ApplicationStatusWithdrawn: "withdrawn",
+}
+
+func (s ApplicationStatus) String() string {
+	if s < 0 || int(s) >= len(applicationStatusNames) {
+		return "unknown"
+	}
+	return applicationStatusNames[s]
+}
+
+// ParseApplicationStatus accepts status name, e.g. "active"
+func ParseApplicationStatus(name string) (ApplicationStatus, bool) {
+	for s, n := range applicationStatusNames {
+		if n == name {
+			return ApplicationStatus(s), true
+		}
+	}
+	return 0, false
+}
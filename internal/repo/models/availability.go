@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const minutesInDay = 24 * 60
+
+// Availability describes when user can be assigned to meetings.
+// Empty Weekly means no restrictions on working hours.
+type Availability struct {
+	Weekly     []WorkingHours `json:"weekly"     bson:"weekly"`
+	Exceptions []Meeting      `json:"exceptions" bson:"exceptions"`
+}
+
+// WorkingHours is a recurring window [From, To) in minutes since midnight.
+type WorkingHours struct {
+	Weekday time.Weekday `json:"weekday" bson:"weekday"`
+	From    int          `json:"from"    bson:"from"`
+	To      int          `json:"to"      bson:"to"`
+}
+
+const (
+	AvailabilityFieldWeekly     = "weekly"
+	AvailabilityFieldExceptions = "exceptions"
+)
+
+func (a Availability) Validate() error {
+	for _, w := range a.Weekly {
+		if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+			return errors.Error("invalid weekday %d", w.Weekday)
+		}
+
+		if w.From < 0 || w.To > minutesInDay || w.From >= w.To {
+			return errors.Error("invalid working hours [%d, %d)", w.From, w.To)
+		}
+	}
+
+	for _, e := range a.Exceptions {
+		if e[0] >= e[1] {
+			return errors.Error("invalid exception [%d, %d)", e[0], e[1])
+		}
+	}
+
+	return nil
+}
+
+// IsAvailable checks that meeting fits into user's working hours in their
+// time zone and does not overlap any exception (e.g. vacation).
+func (u User) IsAvailable(meeting Meeting) bool {
+	if u.Availability == nil {
+		return true
+	}
+
+	for _, e := range u.Availability.Exceptions {
+		if meeting[0] < e[1] && e[0] < meeting[1] {
+			return false
+		}
+	}
+
+	if len(u.Availability.Weekly) == 0 {
+		return true
+	}
+
+	// wall clock of the user, it differs from time since midnight on DST switch
+	start := time.UnixMilli(meeting[0]).In(u.Location())
+	clock := time.Duration(start.Hour())*time.Hour +
+		time.Duration(start.Minute())*time.Minute +
+		time.Duration(start.Second())*time.Second +
+		time.Duration(start.Nanosecond())
+
+	from := clock.Milliseconds()
+	to := from + meeting[1] - meeting[0]
+
+	minute := time.Minute.Milliseconds()
+	for _, w := range u.Availability.Weekly {
+		if w.Weekday == start.Weekday() && int64(w.From)*minute <= from && to <= int64(w.To)*minute {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_IsAvailable(t *testing.T) {
+	// 2024-05-13 is monday
+	at := func(day, hour, minute int) int64 {
+		return time.Date(2024, time.May, day, hour, minute, 0, 0, time.UTC).UnixMilli()
+	}
+
+	workdays := []WorkingHours{
+		{Weekday: time.Monday, From: 10 * 60, To: 13 * 60},
+		{Weekday: time.Monday, From: 14 * 60, To: 18 * 60},
+		{Weekday: time.Tuesday, From: 0, To: 24 * 60},
+	}
+
+	type testcase struct {
+		name         string
+		timeZone     string
+		availability *Availability
+		meeting      Meeting
+		want         bool
+	}
+
+	tests := [...]testcase{
+		{
+			name:    "no availability",
+			meeting: Meeting{at(13, 3, 0), at(13, 4, 0)},
+			want:    true,
+		},
+		{
+			name:         "no weekly restrictions",
+			availability: &Availability{},
+			meeting:      Meeting{at(13, 3, 0), at(13, 4, 0)},
+			want:         true,
+		},
+		{
+			name:         "inside window",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 10, 0), at(13, 11, 30)},
+			want:         true,
+		},
+		{
+			name:         "touches window bounds",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 14, 0), at(13, 18, 0)},
+			want:         true,
+		},
+		{
+			name:         "crosses lunch",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 12, 30), at(13, 14, 30)},
+			want:         false,
+		},
+		{
+			name:         "night",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 3, 0), at(13, 4, 0)},
+			want:         false,
+		},
+		{
+			name:         "day off",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(15, 10, 0), at(15, 11, 0)},
+			want:         false,
+		},
+		{
+			name:         "till midnight",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(14, 23, 0), at(15, 0, 0)},
+			want:         true,
+		},
+		{
+			name:         "working hours in time zone",
+			timeZone:     "Asia/Almaty",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 5, 0), at(13, 6, 0)},
+			want:         true,
+		},
+		{
+			name:         "utc hours outside of time zone ones",
+			timeZone:     "Asia/Almaty",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(13, 14, 0), at(13, 15, 0)},
+			want:         false,
+		},
+		{
+			name:         "weekday in time zone",
+			timeZone:     "America/New_York",
+			availability: &Availability{Weekly: workdays},
+			meeting:      Meeting{at(14, 3, 0), at(14, 4, 0)},
+			want:         false,
+		},
+		{
+			name: "vacation",
+			availability: &Availability{
+				Weekly:     workdays,
+				Exceptions: []Meeting{{at(13, 0, 0), at(14, 0, 0)}},
+			},
+			meeting: Meeting{at(13, 10, 0), at(13, 11, 0)},
+			want:    false,
+		},
+		{
+			name: "after vacation",
+			availability: &Availability{
+				Exceptions: []Meeting{{at(13, 0, 0), at(14, 0, 0)}},
+			},
+			meeting: Meeting{at(14, 0, 0), at(14, 1, 0)},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := User{Availability: tt.availability, TimeZone: tt.timeZone}
+			require.Equal(t, tt.want, u.IsAvailable(tt.meeting))
+		})
+	}
+}
+
+func TestAvailability_Validate(t *testing.T) {
+	type testcase struct {
+		name         string
+		availability Availability
+		wantErr      bool
+	}
+
+	tests := [...]testcase{
+		{
+			name: "valid",
+			availability: Availability{
+				Weekly:     []WorkingHours{{Weekday: time.Friday, From: 0, To: 24 * 60}},
+				Exceptions: []Meeting{{1, 2}},
+			},
+		},
+		{
+			name:         "bad weekday",
+			availability: Availability{Weekly: []WorkingHours{{Weekday: 7, From: 0, To: 60}}},
+			wantErr:      true,
+		},
+		{
+			name:         "empty window",
+			availability: Availability{Weekly: []WorkingHours{{From: 60, To: 60}}},
+			wantErr:      true,
+		},
+		{
+			name:         "after midnight",
+			availability: Availability{Weekly: []WorkingHours{{From: 60, To: 25 * 60}}},
+			wantErr:      true,
+		},
+		{
+			name:         "bad exception",
+			availability: Availability{Exceptions: []Meeting{{2, 1}}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.availability.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
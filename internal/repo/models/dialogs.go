@@ -0,0 +1,22 @@
+package models
+
+import "context"
+
+// DialogsRepo keeps state of unfinished bot dialogs, so
+// they survive restarts and are shared between replicas.
+// Abandoned dialogs may be expired by the storage.
+type DialogsRepo interface {
+	// GetState returns empty string for unknown dialog
+	GetState(ctx context.Context, chatID, userID int64) (string, error)
+
+	SetState(ctx context.Context, chatID, userID int64, state string) error
+
+	// ResetState sets initial state, with withData also drops dialog data
+	ResetState(ctx context.Context, chatID, userID int64, withData bool) error
+
+	// UpdateData sets value by key, nil data deletes it
+	UpdateData(ctx context.Context, chatID, userID int64, key string, data any) error
+
+	// GetData decodes value by key into to, returns false if there is no such key
+	GetData(ctx context.Context, chatID, userID int64, key string, to any) (found bool, err error)
+}
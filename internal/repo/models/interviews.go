@@ -1,19 +1,41 @@
 package models
 
-import "context"
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
 
 type InterviewsRepo interface {
 	// Create is API method for registering an interview. Data may contain confidential information.
-	Create(ctx context.Context, vacancy string, candidateTg string) (id string, err error)
+	Create(ctx context.Context, vacancy string, candidateTg string, duration time.Duration) (id string, err error)
 
 	// Delete completely removes interview object
 	Delete(ctx context.Context, id string) (found *Interview, err error)
 
 	// Update patches interview
-	Update(ctx context.Context, id string, vacancy *string, candidate *string, data *[]byte, zoom *string) error
+	Update(
+		ctx context.Context,
+		id string,
+		vacancy *string,
+		candidate *string,
+		data *[]byte,
+		zoom *string,
+		duration *time.Duration,
+		minGrade *int,
+		skills *[]string,
+	) error
+
+	// Schedule assigns interview to the lead interviewer and the rest of the panel, resets notification log
+	Schedule(ctx context.Context, id string, candidate User, interviewer User, panel []Panelist, slot Meeting) error
+
+	// SetPanel changes composition of interviewers, it is applied when the interview is scheduled next time
+	SetPanel(ctx context.Context, id string, panel Panel) error
 
-	// Schedule assigns interview to interviewer
-	Schedule(ctx context.Context, id string, candidate User, interviewer User, slot Meeting) error
+	// RemovePanelist removes the user from panelists of the scheduled interview, the composition is kept
+	RemovePanelist(ctx context.Context, id string, username string) error
 
 	// Notify saves information about notification
 	Notify(ctx context.Context, id string, at int64, notified [2]bool) error
@@ -21,19 +43,28 @@ type InterviewsRepo interface {
 	// Find checks whether an interview has been created or not
 	Find(ctx context.Context, id string) (*Interview, error)
 
-	// FindByUser returns all user's interviews
+	// FindByUser returns all user's interviews, including ones where the user is a panelist
 	FindByUser(ctx context.Context, username string) ([]*Interview, error)
 
+	// List returns interviews matching the filter
+	List(ctx context.Context, filter InterviewsFilter) ([]*Interview, error)
+
 	// GetUpcoming returns list of upcoming interviews of fixed (1024) size.
 	GetUpcoming(ctx context.Context, lastNotifyBefore, startsBefore int64) (interviews []*Interview, err error)
 
 	// Cancel cancels the interview, making it done without results.
 	Cancel(ctx context.Context, id string, side Role) (err error)
 
-	// Done marks the interview done. Some logic can be added for sending results template to be filling in
-	// to the interviewer, or something else.
+	// Done marks the interview done, then the interviewer is asked to fill in the scorecard.
 	Done(ctx context.Context, id string) (err error)
 
+	// Attend saves reports of participants about the finished meeting indexed by Role,
+	// the outcome is resolved from them
+	Attend(ctx context.Context, id string, attendance [2]Attendance) (err error)
+
+	// Score saves the interviewer's scorecard, replacing the previous one
+	Score(ctx context.Context, id string, scorecard Scorecard) (err error)
+
 	// FixTg sets candidateTg value for interviews with candidate == username
 	FixTg(ctx context.Context, username string, tg int64) (err error)
 }
@@ -51,9 +82,26 @@ type Interview struct {
 	Data []byte `json:"data"        bson:"data"`
 	Zoom string `json:"zoom"        bson:"zoom"`
 
+	Duration time.Duration `json:"duration" bson:"duration"`
+
+	// MinGrade is the lowest IntGrade of interviewers, the vacancy one is applied if it is higher
+	MinGrade int `json:"min_grade" bson:"min_grade"`
+
+	// Skills are tags required from interviewers, they replace the vacancy ones if set
+	Skills []string `json:"skills" bson:"skills"`
+
+	// Panel is the required composition of interviewers, Panelists are booked
+	// for the meeting besides the lead interviewer
+	Panel     Panel      `json:"panel"     bson:"panel"`
+	Panelists []Panelist `json:"panelists" bson:"panelists"`
+
 	Status      InterviewStatus `json:"status"       bson:"status"`
 	Meet        *[2]int64       `json:"meet"         bson:"meet"`
 	CancelledBy Role            `json:"cancelled_by" bson:"cancelled_by"`
+	Scorecard   *Scorecard      `json:"scorecard"    bson:"scorecard"`
+
+	Attendance [2]Attendance `json:"attendance" bson:"attendance"`
+	Outcome    Outcome       `json:"outcome"    bson:"outcome"`
 
 	LastNotification *NotificationLog `json:"last_notification" bson:"last_notification"`
 }
@@ -67,12 +115,144 @@ const (
 	InterviewFieldVacancy          = "vacancy"
 	InterviewFieldData             = "data"
 	InterviewFieldZoom             = "zoom"
+	InterviewFieldDuration         = "duration"
+	InterviewFieldMinGrade         = "min_grade"
+	InterviewFieldSkills           = "skills"
+	InterviewFieldPanel            = "panel"
+	InterviewFieldPanelists        = "panelists"
 	InterviewFieldMeet             = "meet"
 	InterviewFieldStatus           = "status"
 	InterviewFieldCancelledBy      = "cancelled_by"
+	InterviewFieldScorecard        = "scorecard"
+	InterviewFieldAttendance       = "attendance"
+	InterviewFieldOutcome          = "outcome"
 	InterviewFieldLastNotification = "last_notification"
 )
 
+// DefaultInterviewDuration is used for interviews created without explicit duration
+const DefaultInterviewDuration = time.Hour
+
+// MinInterviewDuration and MaxInterviewDuration bound explicit interview durations
+const (
+	MinInterviewDuration = time.Minute
+	MaxInterviewDuration = 24 * time.Hour
+)
+
+// ValidateDuration checks an explicit interview duration, callers treat zero as not set
+func ValidateDuration(d time.Duration) error {
+	if d < MinInterviewDuration || d > MaxInterviewDuration {
+		return errors.Error("duration must be between %s and %s", MinInterviewDuration, MaxInterviewDuration)
+	}
+	return nil
+}
+
+// MeetDuration returns duration of the meeting for this interview
+func (i Interview) MeetDuration() time.Duration {
+	if i.Duration <= 0 {
+		return DefaultInterviewDuration
+	}
+	return i.Duration
+}
+
+// Interviewers returns usernames of the lead interviewer and the panelists,
+// it is empty if the interview is not scheduled
+func (i Interview) Interviewers() []string {
+	if i.InterviewerUN == "" {
+		return nil
+	}
+
+	usernames := []string{i.InterviewerUN}
+	for _, p := range i.Panelists {
+		usernames = append(usernames, p.Username)
+	}
+	return usernames
+}
+
+// IsInterviewer reports whether the user is the lead interviewer or a panelist
+func (i Interview) IsInterviewer(username string) bool {
+	return username != "" && slices.Contains(i.Interviewers(), username)
+}
+
+// Panel is the composition of interviewers of a meeting, zero value means a single interviewer
+type Panel struct {
+	// Interviewers is the number of interviewers including the lead one, values below 1 mean 1.
+	// All of them must pass the vacancy restrictions.
+	Interviewers int `json:"interviewers" bson:"interviewers"`
+
+	// Shadows are interviewers who attend to learn, any interviewer may be a shadow
+	Shadows int `json:"shadows" bson:"shadows"`
+}
+
+func (p Panel) Validate() error {
+	if p.Interviewers < 0 || p.Shadows < 0 {
+		return errors.Error("panel sizes must not be negative")
+	}
+
+	if p.Size() > MaxPanelSize {
+		return errors.Error("panel must not have more than %d members", MaxPanelSize)
+	}
+
+	return nil
+}
+
+// Size returns the number of interviewers to book, including the lead one and shadows
+func (p Panel) Size() int {
+	return max(1, p.Interviewers) + p.Shadows
+}
+
+// PoolFilter returns the filter matching interviewers for every seat of the panel,
+// shadows are not restricted by the vacancy
+func (p Panel) PoolFilter(filter InterviewerFilter) InterviewerFilter {
+	if p.Shadows > 0 {
+		return InterviewerFilter{}
+	}
+	return filter
+}
+
+// CanSeat reports whether the pool has enough interviewers for the panel,
+// interviewers must pass the filter and the excluded user takes no seat
+func (p Panel) CanSeat(pool []User, filter InterviewerFilter, exclude string) bool {
+	var allowed, any int
+	for _, user := range pool {
+		switch {
+		case user.Username == exclude:
+		case filter.Allows(user):
+			allowed++
+			any++
+		case InterviewerFilter{}.Allows(user):
+			any++
+		}
+	}
+
+	return allowed >= max(1, p.Interviewers) && any >= p.Size()
+}
+
+// MaxPanelSize limits the number of interviewers of a meeting
+const MaxPanelSize = 5
+
+// Panelist is an interviewer booked for the meeting besides the lead one
+type Panelist struct {
+	Username string `json:"username" bson:"username"`
+	Telegram int64  `json:"telegram" bson:"telegram"`
+	Shadow   bool   `json:"shadow"   bson:"shadow"`
+}
+
+const (
+	PanelistFieldUsername = "username"
+)
+
+// InterviewsFilter selects interviews for List, nil fields match everything.
+// From and To bound the meeting start as [From, To), so they match only scheduled ones.
+type InterviewsFilter struct {
+	Status      *InterviewStatus
+	Outcome     *Outcome
+	Vacancy     *string
+	Candidate   *string
+	Interviewer *string
+	From        *int64
+	To          *int64
+}
+
 type NotificationLog struct {
 	UnixTime int64   `json:"unix_time" bson:"unix_time"`
 	Notified [2]bool `json:"notified" bson:"notified"`
@@ -106,3 +286,27 @@ const (
 	// InterviewStatusCancelled is set when it has been cancelled
 	InterviewStatusCancelled
 )
+
+var interviewStatusNames = [...]string{
+	InterviewStatusNew:       "new",
+	InterviewStatusScheduled: "scheduled",
+	InterviewStatusFinished:  "finished",
+	InterviewStatusCancelled: "cancelled",
+}
+
+func (s InterviewStatus) String() string {
+	if s < 0 || int(s) >= len(interviewStatusNames) {
+		return "unknown"
+	}
+	return interviewStatusNames[s]
+}
+
+// ParseInterviewStatus accepts status name, e.g. "scheduled"
+func ParseInterviewStatus(name string) (InterviewStatus, bool) {
+	for s, n := range interviewStatusNames {
+		if n == name {
+			return InterviewStatus(s), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPanel_Validate(t *testing.T) {
+	type testcase struct {
+		name    string
+		panel   Panel
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "default"},
+		{name: "interviewers and shadows", panel: Panel{Interviewers: 3, Shadows: 2}},
+		{name: "negative interviewers", panel: Panel{Interviewers: -1}, wantErr: true},
+		{name: "negative shadows", panel: Panel{Shadows: -1}, wantErr: true},
+		{name: "too large", panel: Panel{Interviewers: 4, Shadows: 2}, wantErr: true},
+		{name: "lead is counted", panel: Panel{Shadows: MaxPanelSize}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.panel.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPanel_CanSeat(t *testing.T) {
+	type testcase struct {
+		name  string
+		panel Panel
+		pool  []User
+		want  bool
+	}
+
+	filter := InterviewerFilter{MinGrade: 2}
+	senior := User{Username: "senior", IntGrade: 2}
+	junior := User{Username: "junior", IntGrade: 1}
+	cand := User{Username: "cand", IntGrade: 3}
+
+	tests := [...]testcase{
+		{name: "single interviewer", pool: []User{senior}, want: true},
+		{name: "nobody allowed", pool: []User{junior}},
+		{name: "junior shadow", panel: Panel{Shadows: 1}, pool: []User{senior, junior}, want: true},
+		{name: "not enough allowed", panel: Panel{Interviewers: 2}, pool: []User{senior, junior}},
+		{name: "candidate takes no seat", panel: Panel{Shadows: 1}, pool: []User{senior, cand}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.panel.CanSeat(tt.pool, filter, cand.Username))
+		})
+	}
+}
+
+func TestInterview_Interviewers(t *testing.T) {
+	require.Nil(t, Interview{Panelists: []Panelist{{Username: "bob"}}}.Interviewers())
+
+	i := Interview{InterviewerUN: "alice", Panelists: []Panelist{{Username: "bob", Shadow: true}}}
+	require.Equal(t, []string{"alice", "bob"}, i.Interviewers())
+	require.True(t, i.IsInterviewer("bob"))
+	require.False(t, i.IsInterviewer("carol"))
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// MaxBuffer limits the free time the interviewer may ask for around each meeting
+const MaxBuffer = 2 * time.Hour
+
+// Limits caps the number of meetings of the interviewer, zero means no limit.
+// Days and weeks (from Monday) are in the interviewer's time zone.
+// Buffers keep the time before and after every meeting free.
+type Limits struct {
+	PerDay       int           `json:"perDay"       bson:"perDay"`
+	PerWeek      int           `json:"perWeek"      bson:"perWeek"`
+	BufferBefore time.Duration `json:"bufferBefore" bson:"bufferBefore"`
+	BufferAfter  time.Duration `json:"bufferAfter"  bson:"bufferAfter"`
+}
+
+func (l Limits) Validate() error {
+	if l.PerDay < 0 || l.PerWeek < 0 {
+		return errors.Error("limits must not be negative")
+	}
+
+	for _, buffer := range [...]time.Duration{l.BufferBefore, l.BufferAfter} {
+		if buffer < 0 || buffer > MaxBuffer {
+			return errors.Error("buffers must be between 0 and %s", MaxBuffer)
+		}
+	}
+
+	return nil
+}
+
+// WithinLimits reports whether one more meeting fits into the user's limits
+func (u User) WithinLimits(meeting Meeting) bool {
+	if u.Limits.PerDay > 0 && u.CountMeetings(u.dayOf(meeting)) >= u.Limits.PerDay {
+		return false
+	}
+
+	return u.Limits.PerWeek == 0 || u.CountMeetings(u.WeekOf(meeting)) < u.Limits.PerWeek
+}
+
+// CountMeetings returns the number of assigned meetings starting inside the interval
+func (u User) CountMeetings(interval [2]int64) int {
+	var count int
+	for _, meet := range u.Assigned {
+		if interval[0] <= meet[0] && meet[0] < interval[1] {
+			count++
+		}
+	}
+	return count
+}
+
+// WeekOf returns bounds of the user's week containing the start of the meeting
+func (u User) WeekOf(meeting Meeting) [2]int64 {
+	start := time.UnixMilli(meeting[0]).In(u.Location())
+	fromMonday := (int(start.Weekday()) + 6) % 7
+
+	first := time.Date(start.Year(), start.Month(), start.Day()-fromMonday, 0, 0, 0, 0, start.Location())
+	return [2]int64{first.UnixMilli(), first.AddDate(0, 0, 7).UnixMilli()}
+}
+
+func (u User) dayOf(meeting Meeting) [2]int64 {
+	start := time.UnixMilli(meeting[0]).In(u.Location())
+
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	return [2]int64{first.UnixMilli(), first.AddDate(0, 0, 1).UnixMilli()}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_WithinLimits(t *testing.T) {
+	type testcase struct {
+		name string
+		user User
+		want bool
+	}
+
+	// sunday 23:00 in Yerevan, monday 03:00 is the next week there
+	start := time.Date(2026, 3, 8, 23, 0, 0, 0, time.FixedZone("+04", 4*60*60)).UnixMilli()
+	hour := time.Hour.Milliseconds()
+	meet := Meeting{start, start + hour}
+
+	// sunday 01:00 in Yerevan is saturday in UTC
+	sameDay := Meeting{start - 22*hour, start - 21*hour}
+	sameWeek := Meeting{start - 48*hour, start - 47*hour}
+	nextWeek := Meeting{start + 4*hour, start + 5*hour}
+
+	tests := [...]testcase{
+		{name: "no limits", user: User{Assigned: []Meeting{sameDay, sameWeek}}, want: true},
+		{
+			name: "day is full",
+			user: User{TimeZone: "Asia/Yerevan", Limits: Limits{PerDay: 1}, Assigned: []Meeting{sameDay}},
+		},
+		{
+			name: "day of other zone",
+			user: User{Limits: Limits{PerDay: 1}, Assigned: []Meeting{sameDay}},
+			want: true,
+		},
+		{
+			name: "week is full",
+			user: User{TimeZone: "Asia/Yerevan", Limits: Limits{PerWeek: 2}, Assigned: []Meeting{sameDay, sameWeek}},
+		},
+		{
+			name: "next week is not counted",
+			user: User{TimeZone: "Asia/Yerevan", Limits: Limits{PerWeek: 2}, Assigned: []Meeting{sameWeek, nextWeek}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.user.WithinLimits(meet))
+		})
+	}
+}
+
+func TestLimits_Validate(t *testing.T) {
+	require.NoError(t, Limits{}.Validate())
+	require.NoError(t, Limits{PerDay: 2, PerWeek: 8}.Validate())
+	require.Error(t, Limits{PerDay: -1}.Validate())
+	require.Error(t, Limits{PerWeek: -1}.Validate())
+	require.NoError(t, Limits{BufferBefore: 5 * time.Minute, BufferAfter: MaxBuffer}.Validate())
+	require.Error(t, Limits{BufferBefore: -time.Minute}.Validate())
+	require.Error(t, Limits{BufferAfter: MaxBuffer + time.Minute}.Validate())
+}
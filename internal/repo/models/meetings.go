@@ -5,15 +5,33 @@ import (
 	"sort"
 )
 
+// CanTake reports whether the interviewer works and is free during the slot,
+// UsersRepo.Match selects such users
+func (u User) CanTake(slot Meeting) bool {
+	if !u.IsAvailable(slot) {
+		return false
+	}
+
+	_, canAdd := u.AddMeeting(slot)
+	return canAdd
+}
+
+// AddMeeting returns the position to insert the meeting into assigned ones and whether it can be added:
+// it must not overlap other meetings together with the user's buffers and must fit into the user's limits.
+// This is the only conflict check, both matching and booking rely on it.
 func (u User) AddMeeting(meeting Meeting) (int, bool) {
 	scheduled := u.Assigned
 
 	n := len(scheduled)
 
 	if n == 0 {
-		return 0, true
+		return 0, u.WithinLimits(meeting)
 	}
 
+	// the meeting needs the buffer after it to be free before the next one and vice versa,
+	// so the gap between meetings must hold both buffers
+	gap := (u.Limits.BufferBefore + u.Limits.BufferAfter).Milliseconds()
+
 	// find position for beginning to insert
 	idx := sort.Search(n, func(i int) bool {
 		return meeting[0] <= scheduled[i][0]
@@ -22,21 +40,23 @@ func (u User) AddMeeting(meeting Meeting) (int, bool) {
 	if idx == n {
 		// all meetings start earlier, check
 		// overlap with last one's end
-		return idx, meeting[0] >= scheduled[n-1][1]
+		return idx, meeting[0]-gap >= scheduled[n-1][1] && u.WithinLimits(meeting)
 	}
 
-	if meeting[1] > scheduled[idx][0] {
+	if meeting[1]+gap > scheduled[idx][0] {
 		return idx, false
 	}
 
 	// check overlap with previous one
-	if idx > 0 && meeting[0] < scheduled[idx-1][1] {
+	if idx > 0 && meeting[0]-gap < scheduled[idx-1][1] {
 		return idx, false
 	}
 
-	return idx, true
+	return idx, u.WithinLimits(meeting)
 }
 
+// FindAndDeleteMeeting returns a copy of assigned meetings without the given one,
+// u.Assigned is left untouched, so it can be used as old value for UpdateMeetings.
 func (u User) FindAndDeleteMeeting(meeting Meeting) ([]Meeting, bool) {
 	idx := sort.Search(len(u.Assigned), func(i int) bool {
 		return u.Assigned[i][0] >= meeting[0]
@@ -51,5 +71,5 @@ func (u User) FindAndDeleteMeeting(meeting Meeting) ([]Meeting, bool) {
 		return u.Assigned, false
 	}
 
-	return slices.Delete(u.Assigned, idx, idx+1), true
+	return slices.Delete(slices.Clone(u.Assigned), idx, idx+1), true
 }
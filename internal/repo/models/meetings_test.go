@@ -3,6 +3,7 @@ package models
 import (
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -120,6 +121,65 @@ func TestUser_AddMeeting(t *testing.T) {
 	}
 }
 
+func TestUser_AddMeeting_limits(t *testing.T) {
+	type testcase struct {
+		name   string
+		limits Limits
+		meet   Meeting
+		wantOk bool
+	}
+
+	minute := time.Minute.Milliseconds()
+	hour := time.Hour.Milliseconds()
+	assigned := []Meeting{{10 * hour, 11 * hour}, {13 * hour, 14 * hour}}
+
+	tests := [...]testcase{
+		{name: "back to back without buffers", meet: Meeting{11 * hour, 12 * hour}, wantOk: true},
+		{
+			name:   "buffer after previous",
+			limits: Limits{BufferAfter: 15 * time.Minute},
+			meet:   Meeting{11 * hour, 12 * hour},
+		},
+		{
+			name:   "buffer after fits",
+			limits: Limits{BufferAfter: 15 * time.Minute},
+			meet:   Meeting{11*hour + 15*minute, 12 * hour},
+			wantOk: true,
+		},
+		{
+			name:   "buffer before next",
+			limits: Limits{BufferBefore: 30 * time.Minute},
+			meet:   Meeting{12 * hour, 12*hour + 45*minute},
+		},
+		{
+			name:   "both buffers fit",
+			limits: Limits{BufferBefore: 15 * time.Minute, BufferAfter: 15 * time.Minute},
+			meet:   Meeting{11*hour + 30*minute, 12*hour + 30*minute},
+			wantOk: true,
+		},
+		{
+			name:   "buffer after the last one",
+			limits: Limits{BufferBefore: 10 * time.Minute},
+			meet:   Meeting{14*hour + 5*minute, 15 * hour},
+		},
+		{
+			name:   "buffer before the first one",
+			limits: Limits{BufferAfter: 10 * time.Minute},
+			meet:   Meeting{9 * hour, 9*hour + 55*minute},
+		},
+		{name: "day is full", limits: Limits{PerDay: 2}, meet: Meeting{16 * hour, 17 * hour}},
+		{name: "next day", limits: Limits{PerDay: 2}, meet: Meeting{34 * hour, 35 * hour}, wantOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := User{Assigned: assigned, Limits: tt.limits}
+			_, gotOk := u.AddMeeting(tt.meet)
+			require.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
 func TestUser_FindAndDeleteMeeting(t *testing.T) {
 	type testcase struct {
 		name     string
@@ -160,10 +220,11 @@ func TestUser_FindAndDeleteMeeting(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			u := User{Assigned: tt.assigned}
+			u := User{Assigned: slices.Clone(tt.assigned)}
 			got, gotOk := u.FindAndDeleteMeeting(tt.arg)
 			require.ElementsMatch(t, tt.want, got)
 			require.Equal(t, tt.wantOk, gotOk)
+			require.Equal(t, tt.assigned, u.Assigned, "assigned meetings must not be modified")
 		})
 	}
 }
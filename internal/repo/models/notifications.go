@@ -0,0 +1,90 @@
+package models
+
+import (
+	"net/mail"
+	"slices"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// NotificationChannel is a way to deliver messages to user
+type NotificationChannel string
+
+const (
+	ChannelTelegram NotificationChannel = "telegram"
+	ChannelEmail    NotificationChannel = "email"
+
+	// ChannelWebhook posts messages to the configured URL with username of the
+	// recipient, e.g. to forward them to the corporate messenger
+	ChannelWebhook NotificationChannel = "webhook"
+)
+
+var notificationChannels = []NotificationChannel{ChannelTelegram, ChannelEmail, ChannelWebhook}
+
+// Contact is an address of the user in some channel
+type Contact struct {
+	Channel NotificationChannel `json:"channel" bson:"channel"`
+	Address string              `json:"address" bson:"address"`
+}
+
+// Notifications are user's preferences about delivery of messages
+type Notifications struct {
+	Email string `json:"email" bson:"email"`
+
+	// Channels are used all at once, empty means telegram only
+	Channels []NotificationChannel `json:"channels" bson:"channels"`
+}
+
+const (
+	NotificationsFieldEmail    = "email"
+	NotificationsFieldChannels = "channels"
+)
+
+func (n Notifications) Validate() error {
+	if n.Email != "" {
+		_, err := mail.ParseAddress(n.Email)
+		if err != nil {
+			return errors.Error("invalid email %q", n.Email)
+		}
+	}
+
+	for _, ch := range n.Channels {
+		if !slices.Contains(notificationChannels, ch) {
+			return errors.Error("unknown channel %q", ch)
+		}
+
+		if ch == ChannelEmail && n.Email == "" {
+			return errors.Error("email must be provided for email channel")
+		}
+	}
+
+	return nil
+}
+
+// Contacts returns addresses in preferred channels, skipping the ones where the
+// address is unknown (e.g. user has not started the bot yet)
+func (u User) Contacts() []Contact {
+	channels := u.Notifications.Channels
+	if len(channels) == 0 {
+		channels = []NotificationChannel{ChannelTelegram}
+	}
+
+	contacts := make([]Contact, 0, len(channels))
+	for _, ch := range channels {
+		var address string
+		switch ch {
+		case ChannelTelegram:
+			address = u.Recipient()
+		case ChannelEmail:
+			address = u.Notifications.Email
+		case ChannelWebhook:
+			address = u.Username
+		}
+
+		if address != "" && !slices.Contains(contacts, Contact{Channel: ch, Address: address}) {
+			contacts = append(contacts, Contact{Channel: ch, Address: address})
+		}
+	}
+
+	return contacts
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_Contacts(t *testing.T) {
+	type testcase struct {
+		name string
+		user User
+		want []Contact
+	}
+
+	tests := [...]testcase{
+		{
+			name: "telegram by default",
+			user: User{Username: "cat", Telegram: 42},
+			want: []Contact{{Channel: ChannelTelegram, Address: "42"}},
+		},
+		{
+			name: "not started bot",
+			user: User{Username: "cat"},
+			want: []Contact{},
+		},
+		{
+			name: "all channels",
+			user: User{
+				Username: "cat",
+				Telegram: 42,
+				Notifications: Notifications{
+					Email:    "cat@example.com",
+					Channels: []NotificationChannel{ChannelEmail, ChannelWebhook, ChannelTelegram, ChannelEmail},
+				},
+			},
+			want: []Contact{
+				{Channel: ChannelEmail, Address: "cat@example.com"},
+				{Channel: ChannelWebhook, Address: "cat"},
+				{Channel: ChannelTelegram, Address: "42"},
+			},
+		},
+		{
+			name: "email only without telegram",
+			user: User{
+				Username:      "cat",
+				Notifications: Notifications{Email: "cat@example.com", Channels: []NotificationChannel{ChannelEmail}},
+			},
+			want: []Contact{{Channel: ChannelEmail, Address: "cat@example.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.user.Contacts())
+		})
+	}
+}
+
+func TestNotifications_Validate(t *testing.T) {
+	type testcase struct {
+		name    string
+		n       Notifications
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "empty", n: Notifications{}},
+		{name: "email", n: Notifications{Email: "cat@example.com", Channels: []NotificationChannel{ChannelEmail}}},
+		{name: "invalid email", n: Notifications{Email: "cat"}, wantErr: true},
+		{name: "email channel without email", n: Notifications{Channels: []NotificationChannel{ChannelEmail}}, wantErr: true},
+		{name: "unknown channel", n: Notifications{Channels: []NotificationChannel{"pigeon"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.n.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
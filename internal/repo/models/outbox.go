@@ -0,0 +1,65 @@
+package models
+
+import "context"
+
+// OutboxRepo keeps messages for users. A message is added in the same txn
+// as the state change it tells about, so it is sent only if the change
+// has been committed, and it is delivered later by dispatcher.
+type OutboxRepo interface {
+	// Add enqueues message to the contact, it is due immediately
+	Add(ctx context.Context, to Contact, text string) (id string, err error)
+
+	// Pending returns at most limit pending messages due at now, oldest first
+	Pending(ctx context.Context, now int64, limit int) ([]OutboxMessage, error)
+
+	// Claim takes the pending message for delivery: it counts the attempt and postpones
+	// the next one until the claim expires. It returns false if the message has been
+	// claimed or finished by someone else since it was read due at.
+	Claim(ctx context.Context, id string, at int64, until int64) (claimed bool, err error)
+
+	// Sent marks the message delivered
+	Sent(ctx context.Context, id string, at int64) error
+
+	// Retry records failed attempt, the message is due again at next.
+	// Nil next marks it failed, then it is not sent anymore.
+	Retry(ctx context.Context, id string, reason string, next *int64) error
+}
+
+type OutboxMessage struct {
+	ID string `json:"id" bson:"_id"`
+
+	Contact `bson:",inline"`
+	Text    string `json:"text" bson:"text"`
+
+	Status      OutboxStatus `json:"status"       bson:"status"`
+	CreatedAt   int64        `json:"created_at"   bson:"created_at"`
+	NextAttempt int64        `json:"next_attempt" bson:"next_attempt"`
+	Attempts    int          `json:"attempts"     bson:"attempts"`
+	LastError   string       `json:"last_error"   bson:"last_error"`
+	SentAt      int64        `json:"sent_at"      bson:"sent_at"`
+}
+
+const (
+	OutboxFieldID          = "_id"
+	OutboxFieldChannel     = "channel"
+	OutboxFieldAddress     = "address"
+	OutboxFieldStatus      = "status"
+	OutboxFieldCreatedAt   = "created_at"
+	OutboxFieldNextAttempt = "next_attempt"
+	OutboxFieldAttempts    = "attempts"
+	OutboxFieldLastError   = "last_error"
+	OutboxFieldSentAt      = "sent_at"
+)
+
+type OutboxStatus int
+
+const (
+	// OutboxStatusPending is set until the message is delivered or given up
+	OutboxStatusPending = OutboxStatus(iota)
+
+	// OutboxStatusSent is set when the message has been delivered
+	OutboxStatusSent
+
+	// OutboxStatusFailed is set when all delivery attempts have failed
+	OutboxStatusFailed
+)
@@ -0,0 +1,89 @@
+package models
+
+// Attendance is what a participant has reported about the finished meeting
+type Attendance int
+
+const (
+	// AttendanceUnknown is set until the participant answers
+	AttendanceUnknown = Attendance(iota)
+
+	// AttendanceHappened means the meeting has taken place
+	AttendanceHappened
+
+	// AttendanceNoShow means the other participant has not come
+	AttendanceNoShow
+)
+
+// Outcome is the result of the finished interview resolved from reports of both participants
+type Outcome int
+
+const (
+	// OutcomeUnknown is set until somebody reports attendance
+	OutcomeUnknown = Outcome(iota)
+
+	// OutcomeDone is set when the meeting has taken place
+	OutcomeDone
+
+	// OutcomeCandidateNoShow is set when the candidate has not come
+	OutcomeCandidateNoShow
+
+	// OutcomeInterviewerNoShow is set when the interviewer has not come
+	OutcomeInterviewerNoShow
+
+	// OutcomeDisputed is set when reports of participants contradict each other
+	OutcomeDisputed
+)
+
+var outcomeNames = [...]string{
+	OutcomeUnknown:           "unknown",
+	OutcomeDone:              "done",
+	OutcomeCandidateNoShow:   "candidate_no_show",
+	OutcomeInterviewerNoShow: "interviewer_no_show",
+	OutcomeDisputed:          "disputed",
+}
+
+func (o Outcome) String() string {
+	if o < 0 || int(o) >= len(outcomeNames) {
+		return "unknown"
+	}
+	return outcomeNames[o]
+}
+
+// ParseOutcome accepts outcome name, e.g. "candidate_no_show"
+func ParseOutcome(name string) (Outcome, bool) {
+	for o, n := range outcomeNames {
+		if n == name {
+			return Outcome(o), true
+		}
+	}
+	return 0, false
+}
+
+// Outcomes lists all outcomes in order
+func Outcomes() []Outcome {
+	outcomes := make([]Outcome, 0, len(outcomeNames))
+	for o := range outcomeNames {
+		outcomes = append(outcomes, Outcome(o))
+	}
+	return outcomes
+}
+
+// ResolveOutcome decides what has happened by reports of participants indexed by Role.
+// A single report is trusted, reports that differ or blame each other are disputed.
+func ResolveOutcome(attendance [2]Attendance) Outcome {
+	byInterviewer, byCandidate := attendance[RoleInterviewer], attendance[RoleCandidate]
+
+	switch {
+	case byInterviewer != AttendanceUnknown && byCandidate != AttendanceUnknown &&
+		(byInterviewer != byCandidate || byInterviewer == AttendanceNoShow):
+		return OutcomeDisputed
+	case byInterviewer == AttendanceNoShow:
+		return OutcomeCandidateNoShow
+	case byCandidate == AttendanceNoShow:
+		return OutcomeInterviewerNoShow
+	case byInterviewer == AttendanceHappened || byCandidate == AttendanceHappened:
+		return OutcomeDone
+	}
+
+	return OutcomeUnknown
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOutcome(t *testing.T) {
+	type testcase struct {
+		name          string
+		byInterviewer Attendance
+		byCandidate   Attendance
+		want          Outcome
+	}
+
+	tests := [...]testcase{
+		{
+			name: "no reports",
+			want: OutcomeUnknown,
+		},
+		{
+			name:          "interviewer confirms",
+			byInterviewer: AttendanceHappened,
+			want:          OutcomeDone,
+		},
+		{
+			name:          "both confirm",
+			byInterviewer: AttendanceHappened,
+			byCandidate:   AttendanceHappened,
+			want:          OutcomeDone,
+		},
+		{
+			name:          "candidate has not come",
+			byInterviewer: AttendanceNoShow,
+			want:          OutcomeCandidateNoShow,
+		},
+		{
+			name:        "interviewer has not come",
+			byCandidate: AttendanceNoShow,
+			want:        OutcomeInterviewerNoShow,
+		},
+		{
+			name:          "blame each other",
+			byInterviewer: AttendanceNoShow,
+			byCandidate:   AttendanceNoShow,
+			want:          OutcomeDisputed,
+		},
+		{
+			name:          "contradicting reports",
+			byInterviewer: AttendanceHappened,
+			byCandidate:   AttendanceNoShow,
+			want:          OutcomeDisputed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attendance [2]Attendance
+			attendance[RoleInterviewer] = tt.byInterviewer
+			attendance[RoleCandidate] = tt.byCandidate
+
+			require.Equal(t, tt.want, ResolveOutcome(attendance))
+		})
+	}
+}
+
+func TestParseOutcome(t *testing.T) {
+	for _, o := range Outcomes() {
+		parsed, ok := ParseOutcome(o.String())
+		require.True(t, ok)
+		require.Equal(t, o, parsed)
+	}
+
+	_, ok := ParseOutcome("lost")
+	require.False(t, ok)
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// Scorecard is the interviewer's feedback on the finished interview
+type Scorecard struct {
+	Ratings     []Rating `json:"ratings"      bson:"ratings"`
+	Hire        bool     `json:"hire"         bson:"hire"`
+	Comment     string   `json:"comment"      bson:"comment"`
+	SubmittedAt int64    `json:"submitted_at" bson:"submitted_at"`
+}
+
+// Rating is a score of the candidate by one criterion, from 1 up to the max score
+type Rating struct {
+	Criterion string `json:"criterion" bson:"criterion"`
+	Score     int    `json:"score"     bson:"score"`
+}
+
+func (s Scorecard) Validate(maxScore int) error {
+	seen := make(map[string]struct{}, len(s.Ratings))
+
+	for _, r := range s.Ratings {
+		if r.Criterion == "" {
+			return errors.Error("empty criterion")
+		}
+
+		if _, dup := seen[r.Criterion]; dup {
+			return errors.Error("criterion \"%s\" is rated twice", r.Criterion)
+		}
+		seen[r.Criterion] = struct{}{}
+
+		if r.Score < 1 || r.Score > maxScore {
+			return errors.Error("score of \"%s\" must be from 1 to %d", r.Criterion, maxScore)
+		}
+	}
+
+	return nil
+}
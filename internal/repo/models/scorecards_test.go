@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScorecard_Validate(t *testing.T) {
+	type testcase struct {
+		name    string
+		ratings []Rating
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{
+			name: "no ratings",
+		},
+		{
+			name:    "valid",
+			ratings: []Rating{{"code", 1}, {"design", 5}},
+		},
+		{
+			name:    "score is too low",
+			ratings: []Rating{{"code", 0}},
+			wantErr: true,
+		},
+		{
+			name:    "score is too high",
+			ratings: []Rating{{"code", 6}},
+			wantErr: true,
+		},
+		{
+			name:    "empty criterion",
+			ratings: []Rating{{"", 3}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate criterion",
+			ratings: []Rating{{"code", 3}, {"code", 4}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Scorecard{Ratings: tt.ratings}.Validate(5)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
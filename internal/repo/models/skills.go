@@ -0,0 +1,63 @@
+package models
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// MaxSkills limits the number of skill tags of a user, a vacancy or an interview
+const MaxSkills = 16
+
+// NormalizeSkills lowercases tags like "#Go" to "go", drops empty ones and duplicates and sorts the rest,
+// returns nil if nothing is left
+func NormalizeSkills(skills []string) []string {
+	var normalized []string
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(skill), "#"))
+		if skill != "" {
+			normalized = append(normalized, skill)
+		}
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// ValidateSkills checks that there are not too many tags and each of them is a single word
+func ValidateSkills(skills []string) error {
+	if len(skills) > MaxSkills {
+		return errors.Error("there must be at most %d skills", MaxSkills)
+	}
+
+	for _, skill := range skills {
+		if skill == "" || strings.ContainsAny(skill, " \t\n,") {
+			return errors.Error("skill %q must be a single word", skill)
+		}
+	}
+
+	return nil
+}
+
+// SkillsOverlap returns the number of required skills the user has
+func (u User) SkillsOverlap(required []string) int {
+	var overlap int
+	for _, skill := range required {
+		if slices.Contains(u.Skills, skill) {
+			overlap++
+		}
+	}
+	return overlap
+}
+
+// RankBySkills stably orders users by the number of required skills they have, the best matching first
+func RankBySkills(users []User, required []string) {
+	if len(required) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(users, func(a, b User) int {
+		return b.SkillsOverlap(required) - a.SkillsOverlap(required)
+	})
+}
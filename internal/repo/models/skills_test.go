@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSkills(t *testing.T) {
+	require.Equal(t, []string{"frontend", "go"}, NormalizeSkills([]string{" #Go", "frontend", "", "go"}))
+	require.Nil(t, NormalizeSkills([]string{" ", "#"}))
+}
+
+func TestValidateSkills(t *testing.T) {
+	require.NoError(t, ValidateSkills(nil))
+	require.NoError(t, ValidateSkills([]string{"go", "ml"}))
+	require.Error(t, ValidateSkills([]string{""}))
+	require.Error(t, ValidateSkills([]string{"go,ml"}))
+	require.Error(t, ValidateSkills(make([]string, MaxSkills+1)))
+}
+
+func TestRankBySkills(t *testing.T) {
+	users := []User{
+		{Username: "dba", Skills: []string{"postgres"}},
+		{Username: "frontend", Skills: []string{"react"}},
+		{Username: "fullstack", Skills: []string{"go", "react"}},
+		{Username: "backend", Skills: []string{"go"}},
+	}
+
+	RankBySkills(users, []string{"go", "react"})
+
+	var usernames []string
+	for _, user := range users {
+		usernames = append(usernames, user.Username)
+	}
+	require.Equal(t, []string{"fullstack", "frontend", "backend", "dba"}, usernames, "ties keep order")
+}
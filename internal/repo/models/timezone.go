@@ -0,0 +1,58 @@
+package models
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// locations caches loaded time zones, IsAvailable is called
+// for every interviewer when slots are matched
+var locations sync.Map
+
+// defaultLocation is the zone of users who have not chosen one
+var defaultLocation atomic.Pointer[time.Location]
+
+// SetDefaultLocation sets the zone of users who have not chosen one, nil resets it to UTC
+func SetDefaultLocation(loc *time.Location) {
+	defaultLocation.Store(loc)
+}
+
+// DefaultLocation returns the zone of users who have not chosen one
+func DefaultLocation() *time.Location {
+	if loc := defaultLocation.Load(); loc != nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// LoadLocation returns IANA time zone by name like "Asia/Almaty", empty name is UTC
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Error("unknown time zone %q", name)
+	}
+
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// Location returns time zone of the user, working hours, limits and messages are in it.
+// It is the default one if the zone is not set.
+func (u User) Location() *time.Location {
+	if u.TimeZone == "" {
+		return DefaultLocation()
+	}
+
+	loc, err := LoadLocation(u.TimeZone)
+	if err != nil {
+		return DefaultLocation()
+	}
+	return loc
+}
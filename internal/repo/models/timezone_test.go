@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("Europe/Belgrade")
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Belgrade", loc.String())
+
+	cached, err := LoadLocation("Europe/Belgrade")
+	require.NoError(t, err)
+	require.Same(t, loc, cached)
+
+	loc, err = LoadLocation("")
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, loc)
+
+	_, err = LoadLocation("Mars/Olympus")
+	require.Error(t, err)
+
+	require.Equal(t, time.UTC, User{TimeZone: "Mars/Olympus"}.Location())
+	require.Equal(t, "Asia/Yerevan", User{TimeZone: "Asia/Yerevan"}.Location().String())
+}
+
+func TestUser_Location_default(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	SetDefaultLocation(msk)
+	t.Cleanup(func() { SetDefaultLocation(nil) })
+
+	require.Equal(t, msk, User{}.Location())
+	require.Equal(t, msk, User{TimeZone: "Mars/Olympus"}.Location())
+	require.Equal(t, "Asia/Yerevan", User{TimeZone: "Asia/Yerevan"}.Location().String())
+
+	// working hours of users without zone are checked in the default one
+	user := User{Availability: &Availability{Weekly: []WorkingHours{{Weekday: time.Monday, From: 9 * 60, To: 18 * 60}}}}
+	nine := time.Date(2025, time.March, 3, 9, 0, 0, 0, msk).UnixMilli()
+	require.True(t, user.IsAvailable(Meeting{nine, nine + time.Hour.Milliseconds()}))
+	require.False(t, user.IsAvailable(Meeting{nine - time.Hour.Milliseconds(), nine}))
+}
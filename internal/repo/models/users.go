@@ -1,17 +1,51 @@
 package models
 
 import (
+	"cmp"
 	"context"
 	"strconv"
+
+	"github.com/nikmy/meowbot/pkg/errors"
 )
 
 type UsersRepo interface {
 	Update(ctx context.Context, username string, telegramID *int64, category *UserCategory, intGrade *int) (*User, error)
 	Upsert(ctx context.Context, username string, telegramID *int64, category *UserCategory, intGrade *int) (*User, error)
+	// Get returns nil if user does not exist
 	Get(ctx context.Context, username string) (*User, error)
 
+	// List returns users matching the filter
+	List(ctx context.Context, filter UsersFilter) ([]User, error)
+
+	// SetAvailability replaces user's availability, nil removes all restrictions.
+	// Returns nil if user does not exist.
+	SetAvailability(ctx context.Context, username string, availability *Availability) (*User, error)
+
+	// SetNotifications replaces user's notification preferences.
+	// Returns nil if user does not exist.
+	SetNotifications(ctx context.Context, username string, notifications Notifications) (*User, error)
+
+	// SetLanguage replaces user's language settings.
+	// Returns nil if user does not exist.
+	SetLanguage(ctx context.Context, username string, language Language) (*User, error)
+
+	// SetTimeZone replaces user's IANA time zone, empty one means UTC.
+	// Returns nil if user does not exist.
+	SetTimeZone(ctx context.Context, username string, timeZone string) (*User, error)
+
+	// SetLimits replaces caps on interviews of the user.
+	// Returns nil if user does not exist.
+	SetLimits(ctx context.Context, username string, limits Limits) (*User, error)
+
+	// SetSkills replaces skill tags of the user, they are expected to be normalized.
+	// Returns nil if user does not exist.
+	SetSkills(ctx context.Context, username string, skills []string) (*User, error)
+
 	UpdateMeetings(ctx context.Context, username string, meets []Meeting, old []Meeting) (bool, error)
-	Match(ctx context.Context, targetInterval [2]int64) ([]User, error)
+
+	// Match returns interviewers passing the filter who are free at targetInterval,
+	// ones having more of the required skills go first
+	Match(ctx context.Context, targetInterval [2]int64, filter InterviewerFilter) ([]User, error)
 }
 
 type User struct {
@@ -20,6 +54,31 @@ type User struct {
 	Username string       `json:"username" bson:"username"`
 	Category UserCategory `json:"category" bson:"category"`
 	IntGrade int          `json:"intGrade" bson:"intGrade"`
+
+	Availability *Availability `json:"availability" bson:"availability"`
+
+	Notifications Notifications `json:"notifications" bson:"notifications"`
+
+	Language Language `json:"language" bson:"language"`
+
+	// TimeZone is IANA name like "Asia/Almaty", see Location
+	TimeZone string `json:"timeZone" bson:"timeZone"`
+
+	Limits Limits `json:"limits" bson:"limits"`
+
+	// Skills are tags of specialisation like "go" or "frontend", see NormalizeSkills
+	Skills []string `json:"skills" bson:"skills"`
+}
+
+// Language keeps locale chosen by the user and the one reported by Telegram
+type Language struct {
+	Chosen   string `json:"chosen"   bson:"chosen"`
+	Telegram string `json:"telegram" bson:"telegram"`
+}
+
+// Locale returns the chosen locale, or the Telegram one if nothing is chosen
+func (l Language) Locale() string {
+	return cmp.Or(l.Chosen, l.Telegram)
 }
 
 func (u User) Recipient() string {
@@ -30,10 +89,38 @@ func (u User) Recipient() string {
 	return strconv.FormatInt(u.Telegram, 10)
 }
 
+// UsersFilter selects users for List, zero fields match everything
+type UsersFilter struct {
+	Category         *UserCategory
+	InterviewersOnly bool
+}
+
+// Interviewer grades are ordered by seniority, vacancies and interviews may require the lowest one
 const (
 	GradeNotInterviewer int = 0
+
+	GradeJunior int = 1 // screenings
+	GradeMiddle int = 2
+	GradeSenior int = 3
+	GradeStaff  int = 4 // staff loops
 )
 
+// ValidateGrade checks that the grade is one of interviewer grades
+func ValidateGrade(grade int) error {
+	if grade < GradeJunior || grade > GradeStaff {
+		return errors.Error("grade must be between %d and %d", GradeJunior, GradeStaff)
+	}
+	return nil
+}
+
+// ValidateMinGrade checks the lowest grade required from interviewers, zero requires nothing
+func ValidateMinGrade(grade int) error {
+	if grade < GradeNotInterviewer || grade > GradeStaff {
+		return errors.Error("min grade must be between %d and %d", GradeNotInterviewer, GradeStaff)
+	}
+	return nil
+}
+
 type UserCategory int
 
 const (
@@ -50,4 +137,11 @@ const (
 	UserFieldAssigned = "assigned"
 	UserFieldCategory = "category"
 	UserFieldIntGrade = "intGrade"
+
+	UserFieldAvailability  = "availability"
+	UserFieldNotifications = "notifications"
+	UserFieldLanguage      = "language"
+	UserFieldTimeZone      = "timeZone"
+	UserFieldLimits        = "limits"
+	UserFieldSkills        = "skills"
 )
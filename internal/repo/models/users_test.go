@@ -0,0 +1,26 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGrade(t *testing.T) {
+	for grade := GradeJunior; grade <= GradeStaff; grade++ {
+		require.NoError(t, ValidateGrade(grade))
+	}
+
+	require.Error(t, ValidateGrade(GradeNotInterviewer))
+	require.Error(t, ValidateGrade(-1))
+	require.Error(t, ValidateGrade(GradeStaff+1))
+}
+
+func TestValidateMinGrade(t *testing.T) {
+	for grade := GradeNotInterviewer; grade <= GradeStaff; grade++ {
+		require.NoError(t, ValidateMinGrade(grade))
+	}
+
+	require.Error(t, ValidateMinGrade(-1))
+	require.Error(t, ValidateMinGrade(GradeStaff+1))
+}
@@ -0,0 +1,166 @@
+package models
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+type VacanciesRepo interface {
+	// Upsert creates the vacancy or replaces the one with the same ID
+	Upsert(ctx context.Context, vacancy Vacancy) error
+
+	// Get returns nil if vacancy does not exist
+	Get(ctx context.Context, id string) (*Vacancy, error)
+
+	// List returns all vacancies ordered by id
+	List(ctx context.Context) ([]Vacancy, error)
+
+	// Delete removes the vacancy, returns nil if it does not exist
+	Delete(ctx context.Context, id string) (*Vacancy, error)
+}
+
+// Vacancy keeps settings of interviews for it. Interview.Vacancy refers to
+// its ID, interviews of unknown vacancies are created with defaults.
+type Vacancy struct {
+	ID    string `json:"id"    bson:"_id"`
+	Title string `json:"title" bson:"title"`
+
+	// Interviewers are usernames allowed to interview, empty means anyone
+	Interviewers []string `json:"interviewers" bson:"interviewers"`
+
+	// MinGrade is the lowest IntGrade of allowed interviewers
+	MinGrade int `json:"min_grade" bson:"min_grade"`
+
+	// Skills are tags required from interviewers, any of them is enough; empty means any interviewer
+	Skills []string `json:"skills" bson:"skills"`
+
+	// Duration and Zoom are defaults for new interviews, zero values are not applied
+	Duration time.Duration `json:"duration" bson:"duration"`
+	Zoom     string        `json:"zoom"     bson:"zoom"`
+
+	// Stages are steps of the pipeline, each of them is a separate interview.
+	// Empty means a single stage.
+	Stages []Stage `json:"stages" bson:"stages"`
+}
+
+// Stage is a step of the vacancy pipeline
+type Stage struct {
+	Name string `json:"name" bson:"name"`
+
+	// Duration overrides vacancy default duration when positive
+	Duration time.Duration `json:"duration" bson:"duration"`
+}
+
+const (
+	VacancyFieldID           = "_id"
+	VacancyFieldTitle        = "title"
+	VacancyFieldInterviewers = "interviewers"
+	VacancyFieldMinGrade     = "min_grade"
+	VacancyFieldSkills       = "skills"
+	VacancyFieldDuration     = "duration"
+	VacancyFieldZoom         = "zoom"
+	VacancyFieldStages       = "stages"
+)
+
+func (v Vacancy) Validate() error {
+	if v.ID == "" || strings.ContainsAny(v.ID, " \t\n/") {
+		return errors.Error("vacancy id must be a non-empty word")
+	}
+
+	err := ValidateMinGrade(v.MinGrade)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateSkills(v.Skills)
+	if err != nil {
+		return err
+	}
+
+	if v.Duration != 0 {
+		err = ValidateDuration(v.Duration)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, stage := range v.Stages {
+		if strings.TrimSpace(stage.Name) == "" {
+			return errors.Error("stage %d has no name", i+1)
+		}
+		if stage.Duration != 0 && ValidateDuration(stage.Duration) != nil {
+			return errors.Error(
+				"stage %q duration must be between %s and %s",
+				stage.Name, MinInterviewDuration, MaxInterviewDuration,
+			)
+		}
+	}
+
+	return nil
+}
+
+// StagesCount returns number of pipeline stages, it is at least one
+func (v Vacancy) StagesCount() int {
+	return max(1, len(v.Stages))
+}
+
+// StageName returns the name of the stage with index i, empty for the implicit single one
+func (v Vacancy) StageName(i int) string {
+	if i < 0 || i >= len(v.Stages) {
+		return ""
+	}
+	return v.Stages[i].Name
+}
+
+// StageDuration returns default duration of interview at the stage with index i,
+// zero if it is not set neither for the stage nor for the vacancy
+func (v Vacancy) StageDuration(i int) time.Duration {
+	if i >= 0 && i < len(v.Stages) && v.Stages[i].Duration > 0 {
+		return v.Stages[i].Duration
+	}
+	return v.Duration
+}
+
+// InterviewerFilter returns restrictions of the vacancy on interviewers,
+// nil vacancy allows everyone
+func (v *Vacancy) InterviewerFilter() InterviewerFilter {
+	if v == nil {
+		return InterviewerFilter{}
+	}
+	return InterviewerFilter{Usernames: v.Interviewers, MinGrade: v.MinGrade, Skills: v.Skills}
+}
+
+// InterviewerFilter restricts interviewers for UsersRepo.Match, zero value allows any interviewer
+type InterviewerFilter struct {
+	// Usernames are allowed interviewers, empty means anyone
+	Usernames []string
+
+	// MinGrade is the lowest allowed IntGrade
+	MinGrade int
+
+	// Skills are required tags, interviewer must have at least one of them; empty means anyone
+	Skills []string
+}
+
+// Allows reports whether the user is an interviewer passing the filter
+func (f InterviewerFilter) Allows(user User) bool {
+	if user.IntGrade <= GradeNotInterviewer || user.IntGrade < f.MinGrade {
+		return false
+	}
+
+	if len(f.Skills) > 0 && user.SkillsOverlap(f.Skills) == 0 {
+		return false
+	}
+
+	return len(f.Usernames) == 0 || slices.Contains(f.Usernames, user.Username)
+}
+
+// Matches reports whether the user passes the filter and can take the slot,
+// UsersRepo.Match selects exactly such users
+func (f InterviewerFilter) Matches(user User, slot Meeting) bool {
+	return f.Allows(user) && user.CanTake(slot)
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVacancy_Validate(t *testing.T) {
+	type testcase struct {
+		name    string
+		vacancy Vacancy
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "minimal", vacancy: Vacancy{ID: "go"}},
+		{name: "no id", vacancy: Vacancy{Title: "Go"}, wantErr: true},
+		{name: "id with spaces", vacancy: Vacancy{ID: "go dev"}, wantErr: true},
+		{name: "id with slash", vacancy: Vacancy{ID: "go/dev"}, wantErr: true},
+		{name: "negative grade", vacancy: Vacancy{ID: "go", MinGrade: -1}, wantErr: true},
+		{name: "staff grade", vacancy: Vacancy{ID: "go", MinGrade: GradeStaff}},
+		{name: "grade above staff", vacancy: Vacancy{ID: "go", MinGrade: GradeStaff + 1}, wantErr: true},
+		{name: "skills", vacancy: Vacancy{ID: "go", Skills: []string{"go", "postgres"}}},
+		{name: "skill with spaces", vacancy: Vacancy{ID: "go", Skills: []string{"machine learning"}}, wantErr: true},
+		{name: "negative duration", vacancy: Vacancy{ID: "go", Duration: -time.Minute}, wantErr: true},
+		{name: "sub-minute duration", vacancy: Vacancy{ID: "go", Duration: time.Second}, wantErr: true},
+		{name: "duration over a day", vacancy: Vacancy{ID: "go", Duration: 25 * time.Hour}, wantErr: true},
+		{
+			name:    "sub-minute stage duration",
+			vacancy: Vacancy{ID: "go", Stages: []Stage{{Name: "tech", Duration: time.Nanosecond}}},
+			wantErr: true,
+		},
+		{name: "unnamed stage", vacancy: Vacancy{ID: "go", Stages: []Stage{{Name: " "}}}, wantErr: true},
+		{
+			name:    "stages",
+			vacancy: Vacancy{ID: "go", Stages: []Stage{{Name: "screening", Duration: time.Hour}, {Name: "tech"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.vacancy.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVacancy_stages(t *testing.T) {
+	single := Vacancy{ID: "go", Duration: time.Hour}
+	require.Equal(t, 1, single.StagesCount())
+	require.Equal(t, "", single.StageName(0))
+	require.Equal(t, time.Hour, single.StageDuration(0))
+
+	staged := Vacancy{
+		ID:       "go",
+		Duration: time.Hour,
+		Stages:   []Stage{{Name: "screening", Duration: 30 * time.Minute}, {Name: "tech"}},
+	}
+	require.Equal(t, 2, staged.StagesCount())
+	require.Equal(t, "tech", staged.StageName(1))
+	require.Equal(t, 30*time.Minute, staged.StageDuration(0))
+	require.Equal(t, time.Hour, staged.StageDuration(1), "vacancy default is used")
+}
+
+func TestInterviewerFilter_Allows(t *testing.T) {
+	type testcase struct {
+		name   string
+		filter InterviewerFilter
+		user   User
+		want   bool
+	}
+
+	tests := [...]testcase{
+		{name: "any interviewer", user: User{Username: "alice", IntGrade: 1}, want: true},
+		{name: "not interviewer", user: User{Username: "alice", IntGrade: GradeNotInterviewer}},
+		{
+			name:   "low grade",
+			filter: InterviewerFilter{MinGrade: 2},
+			user:   User{Username: "alice", IntGrade: 1},
+		},
+		{
+			name:   "enough grade",
+			filter: InterviewerFilter{MinGrade: 2},
+			user:   User{Username: "alice", IntGrade: 2},
+			want:   true,
+		},
+		{
+			name:   "listed",
+			filter: InterviewerFilter{Usernames: []string{"bob", "alice"}},
+			user:   User{Username: "alice", IntGrade: 1},
+			want:   true,
+		},
+		{
+			name:   "not listed",
+			filter: InterviewerFilter{Usernames: []string{"bob"}},
+			user:   User{Username: "alice", IntGrade: 3},
+		},
+		{
+			name:   "has one of skills",
+			filter: InterviewerFilter{Skills: []string{"go", "ml"}},
+			user:   User{Username: "alice", IntGrade: 1, Skills: []string{"ml"}},
+			want:   true,
+		},
+		{
+			name:   "no skills",
+			filter: InterviewerFilter{Skills: []string{"frontend"}},
+			user:   User{Username: "alice", IntGrade: 1, Skills: []string{"dba"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.filter.Allows(tt.user))
+		})
+	}
+}
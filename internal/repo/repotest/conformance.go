@@ -0,0 +1,1128 @@
+// Package repotest contains the conformance suite which every repo.Client backend must pass.
+package repotest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+// NewClient creates client over empty storage, it is called for each test
+type NewClient func(t *testing.T) repo.Client
+
+// Run checks that backend behaves the way the rest of the bot relies on
+func Run(t *testing.T, newClient NewClient) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c repo.Client)
+	}{
+		{"interviews/create find delete", testInterviewsCRUD},
+		{"interviews/update", testInterviewsUpdate},
+		{"interviews/schedule and cancel", testInterviewsSchedule},
+		{"interviews/list", testInterviewsList},
+		{"interviews/upcoming", testInterviewsUpcoming},
+		{"interviews/fix tg", testInterviewsFixTg},
+		{"interviews/score", testInterviewsScore},
+		{"interviews/attend", testInterviewsAttend},
+		{"interviews/panel", testInterviewsPanel},
+		{"users/upsert and update", testUsersUpsert},
+		{"users/concurrent upsert", testUsersConcurrentUpsert},
+		{"users/list", testUsersList},
+		{"users/update meetings", testUsersUpdateMeetings},
+		{"users/match", testUsersMatch},
+		{"users/availability", testUsersAvailability},
+		{"users/notifications", testUsersNotifications},
+		{"users/language", testUsersLanguage},
+		{"users/timeZone", testUsersTimeZone},
+		{"users/limits", testUsersLimits},
+		{"users/skills", testUsersSkills},
+		{"vacancies", testVacancies},
+		{"applications", testApplications},
+		{"applications/txn", testApplicationsTxn},
+		{"dialogs", testDialogs},
+		{"outbox/delivery", testOutboxDelivery},
+		{"outbox/txn", testOutboxTxn},
+		{"txn/commit and abort", testTxnCommitAbort},
+		{"txn/write conflict", testTxnConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t)
+			t.Cleanup(func() { _ = c.Close(context.Background()) })
+			tt.run(t, c)
+		})
+	}
+}
+
+func testInterviewsCRUD(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", time.Hour)
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, id, found.ID)
+	require.Equal(t, "go", found.Vacancy)
+	require.Equal(t, "cand", found.CandidateUN)
+	require.Equal(t, time.Hour, found.Duration)
+	require.Equal(t, models.InterviewStatusNew, found.Status)
+	require.Nil(t, found.Meet)
+
+	missing, err := c.Interviews().Find(ctx, "missing")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	deleted, err := c.Interviews().Delete(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, found, deleted)
+
+	deleted, err = c.Interviews().Delete(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
+
+func testInterviewsUpdate(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Interviews().FixTg(ctx, "cand", 42))
+
+	vacancy, candidate, zoom, duration := "java", "other", "https://zoom.us/j/1", 90*time.Minute
+	data, minGrade, skills := []byte("secret"), models.GradeSenior, []string{"go", "postgres"}
+	require.NoError(t, c.Interviews().Update(
+		ctx, id, &vacancy, &candidate, &data, &zoom, &duration, &minGrade, &skills,
+	))
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, vacancy, found.Vacancy)
+	require.Equal(t, candidate, found.CandidateUN)
+	require.Zero(t, found.CandidateTg, "changing candidate resets telegram id")
+	require.Equal(t, data, found.Data)
+	require.Equal(t, zoom, found.Zoom)
+	require.Equal(t, duration, found.Duration)
+	require.Equal(t, minGrade, found.MinGrade)
+	require.Equal(t, skills, found.Skills)
+
+	require.NoError(t, c.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, nil, nil))
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, minGrade, found.MinGrade, "nil fields are left untouched")
+	require.Equal(t, skills, found.Skills, "nil fields are left untouched")
+
+	noSkills := []string{}
+	require.NoError(t, c.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, nil, &noSkills))
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Empty(t, found.Skills)
+
+	found.Vacancy = "changed by caller"
+	again, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, vacancy, again.Vacancy, "returned interview must not alias storage")
+
+	require.NoError(t, c.Interviews().Update(ctx, "missing", &vacancy, nil, nil, nil, nil, nil, nil))
+}
+
+func testInterviewsSchedule(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	meet := models.Meeting{100, 200}
+	candidate := models.User{Username: "cand", Telegram: 1}
+	interviewer := models.User{Username: "int", Telegram: 2}
+
+	require.NoError(t, c.Interviews().Notify(ctx, id, 50, [2]bool{true, true}))
+	require.NoError(t, c.Interviews().Schedule(ctx, id, candidate, interviewer, nil, meet))
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, models.InterviewStatusScheduled, found.Status)
+	require.Equal(t, [2]int64(meet), *found.Meet)
+	require.Equal(t, "int", found.InterviewerUN)
+	require.Equal(t, int64(2), found.InterviewerTg)
+	require.Equal(t, int64(1), found.CandidateTg)
+	require.Nil(t, found.LastNotification, "schedule resets notification log")
+
+	byUser, err := c.Interviews().FindByUser(ctx, "int")
+	require.NoError(t, err)
+	require.Len(t, byUser, 1)
+
+	require.NoError(t, c.Interviews().Cancel(ctx, id, models.RoleCandidate))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, models.InterviewStatusCancelled, found.Status)
+	require.Equal(t, models.RoleCandidate, found.CancelledBy)
+	require.Nil(t, found.Meet)
+	require.Empty(t, found.InterviewerUN)
+
+	require.Error(t, c.Interviews().Cancel(ctx, id, models.RoleCandidate), "nothing to cancel")
+	require.Error(t, c.Interviews().Cancel(ctx, "missing", models.RoleHR))
+
+	require.NoError(t, c.Interviews().Done(ctx, id))
+	require.Error(t, c.Interviews().Done(ctx, id), "already done")
+	require.Error(t, c.Interviews().Done(ctx, "missing"))
+}
+
+func testInterviewsPanel(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id, err := c.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	panel := models.Panel{Interviewers: 2, Shadows: 1}
+	require.NoError(t, c.Interviews().SetPanel(ctx, id, panel))
+	require.NoError(t, c.Interviews().SetPanel(ctx, id, panel), "the same panel is not an error")
+	require.Error(t, c.Interviews().SetPanel(ctx, "missing", panel))
+
+	panelists := []models.Panelist{
+		{Username: "second", Telegram: 3},
+		{Username: "shadow", Telegram: 4, Shadow: true},
+	}
+	require.NoError(t, c.Interviews().Schedule(
+		ctx, id,
+		models.User{Username: "cand"},
+		models.User{Username: "lead"},
+		panelists,
+		models.Meeting{100, 200},
+	))
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, panel, found.Panel)
+	require.Equal(t, panelists, found.Panelists)
+	require.Equal(t, []string{"lead", "second", "shadow"}, found.Interviewers())
+
+	for _, username := range found.Interviewers() {
+		byUser, err := c.Interviews().FindByUser(ctx, username)
+		require.NoError(t, err)
+		require.Len(t, byUser, 1, username)
+	}
+
+	require.NoError(t, c.Interviews().RemovePanelist(ctx, id, "shadow"))
+	require.NoError(t, c.Interviews().RemovePanelist(ctx, id, "shadow"), "removed panelist is not an error")
+	require.Error(t, c.Interviews().RemovePanelist(ctx, "missing", "shadow"))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, panelists[:1], found.Panelists)
+	require.Equal(t, panel, found.Panel, "composition is kept")
+
+	require.NoError(t, c.Interviews().Cancel(ctx, id, models.RoleHR))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Empty(t, found.Panelists)
+	require.Equal(t, panel, found.Panel, "composition is kept for the next scheduling")
+
+	byUser, err := c.Interviews().FindByUser(ctx, "shadow")
+	require.NoError(t, err)
+	require.Empty(t, byUser)
+}
+
+func testInterviewsList(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	goID := schedule(t, c, "go", "cand1", "int", models.Meeting{100, 200})
+	javaID := schedule(t, c, "java", "cand2", "int", models.Meeting{300, 400})
+	newID, err := c.Interviews().Create(ctx, "go", "cand3", 0)
+	require.NoError(t, err)
+
+	ids := func(filter models.InterviewsFilter) []string {
+		found, err := c.Interviews().List(ctx, filter)
+		require.NoError(t, err)
+
+		ids := make([]string, 0, len(found))
+		for _, i := range found {
+			ids = append(ids, i.ID)
+		}
+		return ids
+	}
+
+	status := models.InterviewStatusScheduled
+	vacancy, candidate, interviewer := "go", "cand2", "int"
+	from, to := int64(100), int64(300)
+
+	require.ElementsMatch(t, []string{goID, javaID, newID}, ids(models.InterviewsFilter{}))
+	require.ElementsMatch(t, []string{goID, javaID}, ids(models.InterviewsFilter{Status: &status}))
+	require.ElementsMatch(t, []string{goID, newID}, ids(models.InterviewsFilter{Vacancy: &vacancy}))
+	require.ElementsMatch(t, []string{javaID}, ids(models.InterviewsFilter{Candidate: &candidate}))
+	require.ElementsMatch(t, []string{goID, javaID}, ids(models.InterviewsFilter{Interviewer: &interviewer}))
+	require.ElementsMatch(t, []string{goID}, ids(models.InterviewsFilter{From: &from, To: &to}))
+	require.ElementsMatch(t, []string{javaID}, ids(models.InterviewsFilter{From: &to}))
+}
+
+func testInterviewsUpcoming(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	soon := schedule(t, c, "go", "cand1", "int", models.Meeting{100, 200})
+	notified := schedule(t, c, "go", "cand2", "int", models.Meeting{150, 250})
+	halfNotified := schedule(t, c, "go", "cand3", "int", models.Meeting{160, 260})
+	schedule(t, c, "go", "cand4", "int", models.Meeting{1000, 1100})
+	_, err := c.Interviews().Create(ctx, "go", "cand5", 0)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Interviews().Notify(ctx, notified, 90, [2]bool{true, true}))
+	require.NoError(t, c.Interviews().Notify(ctx, halfNotified, 90, [2]bool{true, false}))
+
+	upcoming, err := c.Interviews().GetUpcoming(ctx, 80, 500)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(upcoming))
+	for _, i := range upcoming {
+		ids = append(ids, i.ID)
+	}
+	require.ElementsMatch(t, []string{soon, halfNotified}, ids)
+}
+
+func testInterviewsFixTg(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	asCandidate, err := c.Interviews().Create(ctx, "go", "cat", 0)
+	require.NoError(t, err)
+	asInterviewer := schedule(t, c, "go", "cand", "cat", models.Meeting{100, 200})
+
+	require.NoError(t, c.Interviews().FixTg(ctx, "cat", 42))
+
+	found, err := c.Interviews().Find(ctx, asCandidate)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), found.CandidateTg)
+
+	found, err = c.Interviews().Find(ctx, asInterviewer)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), found.InterviewerTg)
+	require.Zero(t, found.CandidateTg)
+}
+
+func testInterviewsScore(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id := schedule(t, c, "go", "cand", "int", models.Meeting{100, 200})
+	require.NoError(t, c.Interviews().Done(ctx, id))
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, found.Scorecard)
+
+	scorecard := models.Scorecard{
+		Ratings:     []models.Rating{{Criterion: "code", Score: 4}, {Criterion: "design", Score: 2}},
+		Hire:        true,
+		Comment:     "good",
+		SubmittedAt: 300,
+	}
+	require.NoError(t, c.Interviews().Score(ctx, id, scorecard))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, &scorecard, found.Scorecard)
+	require.Equal(t, models.InterviewStatusFinished, found.Status)
+
+	scorecard.Hire = false
+	scorecard.Ratings = nil
+	require.NoError(t, c.Interviews().Score(ctx, id, scorecard))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.False(t, found.Scorecard.Hire)
+	require.Empty(t, found.Scorecard.Ratings)
+
+	require.Error(t, c.Interviews().Score(ctx, "missing", scorecard))
+}
+
+func testInterviewsAttend(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id := schedule(t, c, "go", "cand", "int", models.Meeting{100, 200})
+	other := schedule(t, c, "go", "cand", "int", models.Meeting{300, 400})
+	require.NoError(t, c.Interviews().Done(ctx, id))
+
+	var attendance [2]models.Attendance
+	attendance[models.RoleInterviewer] = models.AttendanceNoShow
+	require.NoError(t, c.Interviews().Attend(ctx, id, attendance))
+
+	found, err := c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, attendance, found.Attendance)
+	require.Equal(t, models.OutcomeCandidateNoShow, found.Outcome)
+
+	noShow := models.OutcomeCandidateNoShow
+	listed, err := c.Interviews().List(ctx, models.InterviewsFilter{Outcome: &noShow})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, id, listed[0].ID)
+
+	unknown := models.OutcomeUnknown
+	listed, err = c.Interviews().List(ctx, models.InterviewsFilter{Outcome: &unknown})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, other, listed[0].ID)
+
+	attendance[models.RoleCandidate] = models.AttendanceHappened
+	require.NoError(t, c.Interviews().Attend(ctx, id, attendance))
+
+	found, err = c.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, models.OutcomeDisputed, found.Outcome)
+
+	require.Error(t, c.Interviews().Attend(ctx, "missing", attendance))
+}
+
+func testUsersUpsert(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	tg, category, grade := int64(42), models.EmployeeUser, 1
+
+	old, err := c.Users().Update(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, old)
+
+	missing, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Nil(t, missing, "update does not create users")
+
+	old, err = c.Users().Upsert(ctx, "cat", &tg, &category, nil)
+	require.NoError(t, err)
+	require.Nil(t, old, "upsert returns state before update")
+
+	old, err = c.Users().Upsert(ctx, "cat", nil, nil, &grade)
+	require.NoError(t, err)
+	require.Equal(t, models.GradeNotInterviewer, old.IntGrade)
+
+	old, err = c.Users().Update(ctx, "cat", nil, nil, &grade)
+	require.NoError(t, err)
+	require.Equal(t, grade, old.IntGrade)
+
+	user, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, "cat", user.Username)
+	require.Equal(t, tg, user.Telegram)
+	require.Equal(t, category, user.Category)
+	require.Equal(t, grade, user.IntGrade)
+}
+
+func testUsersConcurrentUpsert(t *testing.T, c repo.Client) {
+	var wg sync.WaitGroup
+	for tg := range int64(8) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Users().Upsert(context.Background(), "cat", &tg, nil, nil)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	users, err := c.Users().List(context.Background(), models.UsersFilter{})
+	require.NoError(t, err)
+	require.Len(t, users, 1, "username is unique")
+}
+
+func testUsersList(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	employee, hr, grade := models.EmployeeUser, models.HRUser, 1
+	upsertUser(t, c, "bob", &employee, &grade)
+	upsertUser(t, c, "alice", &employee, nil)
+	upsertUser(t, c, "carol", &hr, nil)
+
+	names := func(filter models.UsersFilter) []string {
+		users, err := c.Users().List(ctx, filter)
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(users))
+		for _, u := range users {
+			names = append(names, u.Username)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"alice", "bob", "carol"}, names(models.UsersFilter{}), "sorted by username")
+	require.Equal(t, []string{"alice", "bob"}, names(models.UsersFilter{Category: &employee}))
+	require.Equal(t, []string{"bob"}, names(models.UsersFilter{InterviewersOnly: true}))
+}
+
+func testUsersUpdateMeetings(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	user, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+
+	first := []models.Meeting{{100, 200}}
+	ok, err := c.Users().UpdateMeetings(ctx, "cat", first, user.Assigned)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = c.Users().UpdateMeetings(ctx, "cat", []models.Meeting{{300, 400}}, user.Assigned)
+	require.NoError(t, err)
+	require.False(t, ok, "meetings have been changed concurrently")
+
+	second := []models.Meeting{{100, 200}, {300, 400}}
+	ok, err = c.Users().UpdateMeetings(ctx, "cat", second, first)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = c.Users().UpdateMeetings(ctx, "missing", first, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	user, err = c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, second, user.Assigned)
+}
+
+func testUsersMatch(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	grade := 1
+	for _, name := range []string{"free", "busy", "away"} {
+		upsertUser(t, c, name, nil, &grade)
+	}
+	upsertUser(t, c, "candidate", nil, nil)
+
+	ok, err := c.Users().UpdateMeetings(ctx, "busy", []models.Meeting{{150, 250}}, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = c.Users().SetAvailability(ctx, "away", &models.Availability{Exceptions: []models.Meeting{{0, 1000}}})
+	require.NoError(t, err)
+
+	matched, err := c.Users().Match(ctx, [2]int64{100, 200}, models.InterviewerFilter{})
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+	require.Equal(t, "free", matched[0].Username)
+
+	senior := 3
+	upsertUser(t, c, "senior", nil, &senior)
+
+	names := func(filter models.InterviewerFilter) []string {
+		matched, err := c.Users().Match(ctx, [2]int64{100, 200}, filter)
+		require.NoError(t, err)
+
+		var names []string
+		for _, user := range matched {
+			names = append(names, user.Username)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"free", "senior"}, names(models.InterviewerFilter{}))
+	require.Equal(t, []string{"senior"}, names(models.InterviewerFilter{MinGrade: 2}))
+	require.Equal(t, []string{"free"}, names(models.InterviewerFilter{Usernames: []string{"free", "busy", "candidate"}}))
+	require.Empty(t, names(models.InterviewerFilter{Usernames: []string{"free"}, MinGrade: 2}))
+
+	_, err = c.Users().SetSkills(ctx, "free", []string{"go"})
+	require.NoError(t, err)
+	_, err = c.Users().SetSkills(ctx, "senior", []string{"go", "postgres"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"senior", "free"}, names(models.InterviewerFilter{Skills: []string{"go", "postgres"}}),
+		"ranked by skills overlap")
+	require.Equal(t, []string{"senior"}, names(models.InterviewerFilter{Skills: []string{"postgres"}}))
+	require.Empty(t, names(models.InterviewerFilter{Skills: []string{"frontend"}}))
+
+	ok, err = c.Users().UpdateMeetings(ctx, "free", []models.Meeting{{0, 100}}, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{"free", "senior"}, names(models.InterviewerFilter{}), "back to back meetings")
+
+	_, err = c.Users().SetLimits(ctx, "free", models.Limits{BufferAfter: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, []string{"senior"}, names(models.InterviewerFilter{}), "the buffer after the previous meeting")
+
+	_, err = c.Users().SetLimits(ctx, "senior", models.Limits{PerDay: 1})
+	require.NoError(t, err)
+	ok, err = c.Users().UpdateMeetings(ctx, "senior", []models.Meeting{{500, 600}}, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, names(models.InterviewerFilter{}), "the daily limit is reached")
+}
+
+func testVacancies(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Vacancies().Get(ctx, "go")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	golang := models.Vacancy{
+		ID:           "go",
+		Title:        "Go developer",
+		Interviewers: []string{"cat", "dog"},
+		MinGrade:     2,
+		Skills:       []string{"go", "postgres"},
+		Duration:     90 * time.Minute,
+		Zoom:         "https://zoom.us/j/1",
+		Stages:       []models.Stage{{Name: "screening", Duration: 30 * time.Minute}, {Name: "system design"}},
+	}
+	require.NoError(t, c.Vacancies().Upsert(ctx, golang))
+	require.NoError(t, c.Vacancies().Upsert(ctx, models.Vacancy{ID: "java", Title: "Java developer"}))
+
+	found, err := c.Vacancies().Get(ctx, "go")
+	require.NoError(t, err)
+	require.Equal(t, &golang, found)
+
+	golang.Interviewers = nil
+	golang.Title = "Senior Go developer"
+	require.NoError(t, c.Vacancies().Upsert(ctx, golang))
+
+	found, err = c.Vacancies().Get(ctx, "go")
+	require.NoError(t, err)
+	require.Equal(t, "Senior Go developer", found.Title)
+	require.Empty(t, found.Interviewers)
+
+	all, err := c.Vacancies().List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "go", all[0].ID)
+	require.Equal(t, "java", all[1].ID)
+
+	deleted, err := c.Vacancies().Delete(ctx, "java")
+	require.NoError(t, err)
+	require.Equal(t, "Java developer", deleted.Title)
+
+	deleted, err = c.Vacancies().Delete(ctx, "java")
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+
+	all, err = c.Vacancies().List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+}
+
+func testApplications(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	id, err := c.Applications().Create(ctx, "go", "cand", "i1")
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	other, err := c.Applications().Create(ctx, "java", "cand", "i2")
+	require.NoError(t, err)
+
+	found, err := c.Applications().Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "go", found.Vacancy)
+	require.Equal(t, "cand", found.Candidate)
+	require.Equal(t, []string{"i1"}, found.Interviews)
+	require.Equal(t, "i1", found.Interview())
+	require.Equal(t, models.ApplicationStatusActive, found.Status)
+	require.Positive(t, found.CreatedAt)
+
+	require.NoError(t, c.Applications().Advance(ctx, id, "i3"))
+
+	found, err = c.Applications().FindByInterview(ctx, "i3")
+	require.NoError(t, err)
+	require.Equal(t, id, found.ID)
+	require.Equal(t, 1, found.Stage)
+	require.Equal(t, []string{"i1", "i3"}, found.Interviews)
+
+	found, err = c.Applications().FindByInterview(ctx, "i1")
+	require.NoError(t, err)
+	require.Equal(t, id, found.ID, "previous stages are found too")
+
+	found, err = c.Applications().FindByInterview(ctx, "missing")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	require.NoError(t, c.Applications().Close(ctx, other, models.ApplicationStatusRejected))
+	require.Error(t, c.Applications().Close(ctx, other, models.ApplicationStatusPassed), "closed one is not changed")
+	require.Error(t, c.Applications().Advance(ctx, other, "i4"))
+	require.Error(t, c.Applications().Close(ctx, "404", models.ApplicationStatusPassed))
+
+	ids := func(filter models.ApplicationsFilter) []string {
+		found, err := c.Applications().List(ctx, filter)
+		require.NoError(t, err)
+
+		var ids []string
+		for _, a := range found {
+			ids = append(ids, a.ID)
+		}
+		return ids
+	}
+
+	vacancy, candidate := "java", "cand"
+	active, rejected := models.ApplicationStatusActive, models.ApplicationStatusRejected
+
+	require.ElementsMatch(t, []string{id, other}, ids(models.ApplicationsFilter{Candidate: &candidate}))
+	require.Equal(t, []string{other}, ids(models.ApplicationsFilter{Vacancy: &vacancy}))
+	require.Equal(t, []string{id}, ids(models.ApplicationsFilter{Status: &active}))
+	require.Equal(t, []string{other}, ids(models.ApplicationsFilter{Status: &rejected, Candidate: &candidate}))
+
+	missing, err := c.Applications().Get(ctx, "404")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
+
+func testApplicationsTxn(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+	txm := txn.NewManager(c)
+
+	id, err := c.Applications().Create(ctx, "go", "cand", "i1")
+	require.NoError(t, err)
+
+	sessCtx, cancel, err := txm.NewSessionContext(ctx, time.Second*5)
+	require.NoError(t, err)
+	defer cancel()
+
+	tx, err := txn.New(sessCtx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(sessCtx)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Applications().Advance(sessCtx, id, "i2"))
+	require.NoError(t, tx.Abort(sessCtx))
+	require.NoError(t, tx.Close(sessCtx))
+
+	found, err := c.Applications().Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"i1"}, found.Interviews, "aborted stage is rolled back")
+	require.Equal(t, 0, found.Stage)
+}
+
+func testUsersAvailability(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	availability := &models.Availability{
+		Weekly: []models.WorkingHours{{Weekday: time.Monday, From: 600, To: 1080}},
+	}
+
+	missing, err := c.Users().SetAvailability(ctx, "cat", availability)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	updated, err := c.Users().SetAvailability(ctx, "cat", availability)
+	require.NoError(t, err)
+	require.Equal(t, availability, updated.Availability)
+
+	updated, err = c.Users().SetAvailability(ctx, "cat", nil)
+	require.NoError(t, err)
+	require.Nil(t, updated.Availability)
+}
+
+func testUsersNotifications(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Users().SetNotifications(ctx, "ghost", models.Notifications{Email: "ghost@example.com"})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	want := models.Notifications{
+		Email:    "cat@example.com",
+		Channels: []models.NotificationChannel{models.ChannelEmail, models.ChannelTelegram},
+	}
+
+	updated, err := c.Users().SetNotifications(ctx, "cat", want)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, want, updated.Notifications)
+
+	found, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, want, found.Notifications)
+
+	tg := int64(42)
+	_, err = c.Users().Update(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+
+	found, err = c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, want, found.Notifications, "other updates keep preferences")
+}
+
+func testUsersLanguage(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Users().SetLanguage(ctx, "ghost", models.Language{Chosen: "en"})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	found, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, models.Language{}, found.Language)
+
+	want := models.Language{Chosen: "en", Telegram: "ru"}
+
+	updated, err := c.Users().SetLanguage(ctx, "cat", want)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, want, updated.Language)
+
+	tg := int64(42)
+	_, err = c.Users().Upsert(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+
+	found, err = c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, want, found.Language, "other updates keep language")
+}
+
+func testUsersTimeZone(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Users().SetTimeZone(ctx, "ghost", "Asia/Almaty")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	updated, err := c.Users().SetTimeZone(ctx, "cat", "Asia/Almaty")
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, "Asia/Almaty", updated.TimeZone)
+
+	tg := int64(42)
+	_, err = c.Users().Upsert(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+
+	found, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Almaty", found.TimeZone, "other updates keep time zone")
+
+	updated, err = c.Users().SetTimeZone(ctx, "cat", "")
+	require.NoError(t, err)
+	require.Empty(t, updated.TimeZone)
+}
+
+func testUsersLimits(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Users().SetLimits(ctx, "ghost", models.Limits{PerDay: 1})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	want := models.Limits{PerDay: 2, PerWeek: 5, BufferBefore: 10 * time.Minute, BufferAfter: 5 * time.Minute}
+	updated, err := c.Users().SetLimits(ctx, "cat", want)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, want, updated.Limits)
+
+	tg := int64(42)
+	_, err = c.Users().Upsert(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+
+	found, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, want, found.Limits, "other updates keep limits")
+
+	updated, err = c.Users().SetLimits(ctx, "cat", models.Limits{})
+	require.NoError(t, err)
+	require.Zero(t, updated.Limits)
+}
+
+func testUsersSkills(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+
+	missing, err := c.Users().SetSkills(ctx, "ghost", []string{"go"})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	upsertUser(t, c, "cat", nil, nil)
+
+	want := []string{"frontend", "go"}
+	updated, err := c.Users().SetSkills(ctx, "cat", want)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, want, updated.Skills)
+
+	grade := 1
+	_, err = c.Users().Upsert(ctx, "cat", nil, nil, &grade)
+	require.NoError(t, err)
+
+	found, err := c.Users().Get(ctx, "cat")
+	require.NoError(t, err)
+	require.Equal(t, want, found.Skills, "other updates keep skills")
+
+	updated, err = c.Users().SetSkills(ctx, "cat", nil)
+	require.NoError(t, err)
+	require.Empty(t, updated.Skills)
+}
+
+func testDialogs(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+	d := c.Dialogs()
+
+	state, err := d.GetState(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Empty(t, state)
+
+	require.NoError(t, d.SetState(ctx, 1, 2, "menu"))
+	require.NoError(t, d.UpdateData(ctx, 1, 2, "iid", "42"))
+	require.NoError(t, d.UpdateData(ctx, 1, 2, "meet", models.Meeting{100, 200}))
+
+	state, err = d.GetState(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, "menu", state)
+
+	other, err := d.GetState(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Empty(t, other, "dialogs are per user")
+
+	var iid string
+	found, err := d.GetData(ctx, 1, 2, "iid", &iid)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "42", iid)
+
+	var meet models.Meeting
+	found, err = d.GetData(ctx, 1, 2, "meet", &meet)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, models.Meeting{100, 200}, meet)
+
+	require.NoError(t, d.UpdateData(ctx, 1, 2, "iid", nil))
+	found, err = d.GetData(ctx, 1, 2, "iid", &iid)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, d.ResetState(ctx, 1, 2, false))
+	state, err = d.GetState(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Empty(t, state)
+
+	found, err = d.GetData(ctx, 1, 2, "meet", &meet)
+	require.NoError(t, err)
+	require.True(t, found, "data is kept without withData")
+
+	require.NoError(t, d.ResetState(ctx, 1, 2, true))
+	found, err = d.GetData(ctx, 1, 2, "meet", &meet)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func testOutboxDelivery(t *testing.T, c repo.Client) {
+	ctx := context.Background()
+	o := c.Outbox()
+
+	telegram := models.Contact{Channel: models.ChannelTelegram, Address: "1"}
+	email := models.Contact{Channel: models.ChannelEmail, Address: "cat@example.com"}
+
+	first, err := o.Add(ctx, telegram, "first")
+	require.NoError(t, err)
+	second, err := o.Add(ctx, email, "second")
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	now := time.Now().Add(time.Second).UnixMilli()
+
+	pending, err := o.Pending(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, first, pending[0].ID, "oldest first")
+	require.Equal(t, telegram, pending[0].Contact)
+	require.Equal(t, email, pending[1].Contact)
+	require.Equal(t, "first", pending[0].Text)
+	require.Equal(t, models.OutboxStatusPending, pending[0].Status)
+
+	limited, err := o.Pending(ctx, now, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+
+	at := pending[0].NextAttempt
+	claimed, err := o.Claim(ctx, first, at, now+1000)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = o.Claim(ctx, first, at, now+1000)
+	require.NoError(t, err)
+	require.False(t, claimed, "message is claimed only once")
+
+	pending, err = o.Pending(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "claimed message is not due")
+	require.Equal(t, second, pending[0].ID)
+
+	next := now + 500
+	require.NoError(t, o.Retry(ctx, first, "timeout", &next))
+
+	pending, err = o.Pending(ctx, next, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, first, pending[0].ID)
+	require.Equal(t, 1, pending[0].Attempts)
+	require.Equal(t, "timeout", pending[0].LastError)
+
+	claimed, err = o.Claim(ctx, first, next, next+1000)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, o.Sent(ctx, first, next))
+
+	require.NoError(t, o.Retry(ctx, second, "blocked", nil))
+
+	pending, err = o.Pending(ctx, next+10000, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending, "sent and failed messages are not pending")
+}
+
+func testOutboxTxn(t *testing.T, c repo.Client) {
+	txm := txn.NewManager(c)
+
+	for _, commit := range []bool{false, true} {
+		ctx, cancel, err := txm.NewSessionContext(context.Background(), 5*time.Second)
+		require.NoError(t, err)
+
+		tx, err := txn.New(ctx).SetIsolation(txn.SnapshotIsolation).Start(ctx)
+		require.NoError(t, err)
+
+		_, err = c.Outbox().Add(ctx, models.Contact{Channel: models.ChannelTelegram, Address: "1"}, "hello")
+		require.NoError(t, err)
+
+		outside, err := c.Outbox().Pending(context.Background(), time.Now().Add(time.Second).UnixMilli(), 10)
+		require.NoError(t, err)
+		require.Empty(t, outside, "uncommitted messages are not visible")
+
+		if commit {
+			require.NoError(t, tx.Commit(ctx))
+		} else {
+			require.NoError(t, tx.Abort(ctx))
+		}
+		require.NoError(t, tx.Close(ctx))
+		cancel()
+	}
+
+	pending, err := c.Outbox().Pending(context.Background(), time.Now().Add(time.Second).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "only committed message is delivered")
+}
+
+func testTxnCommitAbort(t *testing.T, c repo.Client) {
+	txm := txn.NewManager(c)
+	grade := 1
+
+	for _, commit := range []bool{true, false} {
+		username := "aborted"
+		if commit {
+			username = "committed"
+		}
+
+		ctx, cancel, err := txm.NewSessionContext(context.Background(), 5*time.Second)
+		require.NoError(t, err)
+
+		tx, err := txn.New(ctx).SetIsolation(txn.SnapshotIsolation).Start(ctx)
+		require.NoError(t, err)
+
+		_, err = c.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+
+		inside, err := c.Users().Get(ctx, username)
+		require.NoError(t, err)
+		require.NotNil(t, inside, "txn sees own writes")
+
+		outside, err := c.Users().Get(context.Background(), username)
+		require.NoError(t, err)
+		require.Nil(t, outside, "uncommitted writes are not visible")
+
+		if commit {
+			require.NoError(t, tx.Commit(ctx))
+		} else {
+			require.NoError(t, tx.Abort(ctx))
+		}
+		require.NoError(t, tx.Close(ctx))
+		cancel()
+
+		after, err := c.Users().Get(context.Background(), username)
+		require.NoError(t, err)
+		require.Equal(t, commit, after != nil)
+	}
+}
+
+func testTxnConflict(t *testing.T, c repo.Client) {
+	txm := txn.NewManager(c)
+	upsertUser(t, c, "cat", nil, nil)
+
+	start := func() (context.Context, txn.ActiveTxn, error) {
+		ctx, cancel, err := txm.NewSessionContext(context.Background(), 5*time.Second)
+		require.NoError(t, err)
+		t.Cleanup(cancel)
+
+		tx, err := txn.New(ctx).SetIsolation(txn.SnapshotIsolation).Start(ctx)
+		if err != nil {
+			return ctx, nil, err
+		}
+		t.Cleanup(func() { _ = tx.Close(ctx) })
+
+		return ctx, tx, nil
+	}
+
+	ctx1, tx1, err := start()
+	require.NoError(t, err)
+
+	// backends locking the whole database refuse to start the second writer
+	ctx2, tx2, startErr := start()
+
+	first := []models.Meeting{{100, 200}}
+	ok, err := c.Users().UpdateMeetings(ctx1, "cat", first, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// otherwise the conflict is detected either on write
+	// or on commit, but only one txn must succeed
+	secondWritten := false
+	if startErr == nil {
+		ok, err = c.Users().UpdateMeetings(ctx2, "cat", []models.Meeting{{300, 400}}, nil)
+		secondWritten = err == nil && ok
+	}
+
+	require.NoError(t, tx1.Commit(ctx1))
+	if secondWritten {
+		require.Error(t, tx2.Commit(ctx2))
+	}
+
+	user, err := c.Users().Get(context.Background(), "cat")
+	require.NoError(t, err)
+	require.Equal(t, first, user.Assigned)
+}
+
+func schedule(t *testing.T, c repo.Client, vacancy, candidate, interviewer string, meet models.Meeting) string {
+	ctx := context.Background()
+
+	id, err := c.Interviews().Create(ctx, vacancy, candidate, 0)
+	require.NoError(t, err)
+
+	err = c.Interviews().Schedule(
+		ctx, id,
+		models.User{Username: candidate},
+		models.User{Username: interviewer},
+		nil,
+		meet,
+	)
+	require.NoError(t, err)
+
+	return id
+}
+
+func upsertUser(t *testing.T, c repo.Client, username string, category *models.UserCategory, grade *int) {
+	tg := int64(len(username))
+	_, err := c.Users().Upsert(context.Background(), username, &tg, category, grade)
+	require.NoError(t, err)
+}
@@ -0,0 +1,3 @@
+package scheduling
+
+//go:generate mockgen -source=interfaces_test.go -destination=interfaces_mocks_test.go -package=$GOPACKAGE
@@ -0,0 +1,18 @@
+package scheduling
+
+import (
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+type repoClient interface {
+	repo.Client
+}
+
+type interviewsApi interface {
+	models.InterviewsRepo
+}
+
+type usersApi interface {
+	models.UsersRepo
+}
@@ -0,0 +1,118 @@
+package scheduling
+
+import (
+	"context"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+var ErrAlreadyApplied = errors.Error("candidate already has an active application to the vacancy")
+
+// Apply starts the pipeline of the candidate for the vacancy with the interview of its first stage.
+// The call must be wrapped into a txn to be atomic.
+func (s Scheduler) Apply(ctx context.Context, vacancy string, candidate string) (*models.Application, error) {
+	found, err := s.repo.Vacancies().Get(ctx, vacancy)
+	if err != nil {
+		return nil, errors.WrapFail(err, "find vacancy")
+	}
+	if found == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	active := models.ApplicationStatusActive
+	applied, err := s.repo.Applications().List(ctx, models.ApplicationsFilter{
+		Vacancy:   &vacancy,
+		Candidate: &candidate,
+		Status:    &active,
+	})
+	if err != nil {
+		return nil, errors.WrapFail(err, "find active applications")
+	}
+	if len(applied) > 0 {
+		return nil, ErrAlreadyApplied
+	}
+
+	iid, err := s.createStageInterview(ctx, *found, 0, candidate, 0)
+	if err != nil {
+		return nil, errors.WrapFail(err, "create first stage interview")
+	}
+
+	id, err := s.repo.Applications().Create(ctx, vacancy, candidate, iid)
+	if err != nil {
+		return nil, errors.WrapFail(err, "do Applications.Create request")
+	}
+
+	return s.getApplication(ctx, id)
+}
+
+// Advance moves the pipeline having the scored interview as the current stage: the next stage
+// interview is created if the candidate is to be hired, the pipeline is passed after the last stage
+// and rejected otherwise. Returns the updated application, nil if the interview is not the current
+// stage of an active one. The call must be wrapped into a txn to be atomic.
+func (s Scheduler) Advance(ctx context.Context, interview string, hire bool) (*models.Application, error) {
+	application, err := s.repo.Applications().FindByInterview(ctx, interview)
+	if err != nil {
+		return nil, errors.WrapFail(err, "find application by interview")
+	}
+
+	if application == nil ||
+		application.Status != models.ApplicationStatusActive ||
+		application.Interview() != interview {
+		return nil, nil
+	}
+
+	vacancy, err := s.repo.Vacancies().Get(ctx, application.Vacancy)
+	if err != nil {
+		return nil, errors.WrapFail(err, "find vacancy")
+	}
+	if vacancy == nil {
+		// the vacancy has been deleted, the pipeline ends at the current stage
+		vacancy = &models.Vacancy{ID: application.Vacancy}
+	}
+
+	switch next := application.Stage + 1; {
+	case !hire:
+		err = s.repo.Applications().Close(ctx, application.ID, models.ApplicationStatusRejected)
+	case next >= vacancy.StagesCount():
+		err = s.repo.Applications().Close(ctx, application.ID, models.ApplicationStatusPassed)
+	default:
+		var iid string
+		iid, err = s.createStageInterview(ctx, *vacancy, next, application.Candidate, 0)
+		if err != nil {
+			return nil, errors.WrapFail(err, "create next stage interview")
+		}
+
+		err = s.repo.Applications().Advance(ctx, application.ID, iid)
+	}
+	if err != nil {
+		return nil, errors.WrapFail(err, "update application")
+	}
+
+	return s.getApplication(ctx, application.ID)
+}
+
+// Withdraw closes the active application on behalf of HR, returns false if it is not active
+func (s Scheduler) Withdraw(ctx context.Context, id string) (*models.Application, bool, error) {
+	application, err := s.getApplication(ctx, id)
+	if err != nil || application == nil {
+		return nil, false, err
+	}
+
+	if application.Status != models.ApplicationStatusActive {
+		return application, false, nil
+	}
+
+	err = s.repo.Applications().Close(ctx, id, models.ApplicationStatusWithdrawn)
+	if err != nil {
+		return nil, false, errors.WrapFail(err, "do Applications.Close request")
+	}
+
+	application.Status = models.ApplicationStatusWithdrawn
+	return application, true, nil
+}
+
+func (s Scheduler) getApplication(ctx context.Context, id string) (*models.Application, error) {
+	application, err := s.repo.Applications().Get(ctx, id)
+	return application, errors.WrapFail(err, "do Applications.Get request")
+}
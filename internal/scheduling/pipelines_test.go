@@ -0,0 +1,116 @@
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func TestScheduler_pipeline(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	_, err := sched.Apply(ctx, "go", "cand")
+	require.ErrorIs(t, err, ErrVacancyNotFound)
+
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{
+		ID:       "go",
+		Duration: time.Hour,
+		Zoom:     "https://zoom.us/j/1",
+		Stages:   []models.Stage{{Name: "screening", Duration: 30 * time.Minute}, {Name: "tech"}},
+	}))
+
+	application, err := sched.Apply(ctx, "go", "cand")
+	require.NoError(t, err)
+	require.Equal(t, 0, application.Stage)
+	require.Equal(t, models.ApplicationStatusActive, application.Status)
+
+	_, err = sched.Apply(ctx, "go", "cand")
+	require.ErrorIs(t, err, ErrAlreadyApplied)
+
+	first, err := client.Interviews().Find(ctx, application.Interview())
+	require.NoError(t, err)
+	require.Equal(t, "go", first.Vacancy)
+	require.Equal(t, 30*time.Minute, first.Duration)
+	require.Equal(t, "https://zoom.us/j/1", first.Zoom)
+
+	unrelated, err := sched.Advance(ctx, "unknown", true)
+	require.NoError(t, err)
+	require.Nil(t, unrelated)
+
+	application, err = sched.Advance(ctx, first.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, application.Stage)
+	require.Equal(t, models.ApplicationStatusActive, application.Status)
+
+	second, err := client.Interviews().Find(ctx, application.Interview())
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, second.Duration, "vacancy default is used")
+
+	stale, err := sched.Advance(ctx, first.ID, true)
+	require.NoError(t, err)
+	require.Nil(t, stale, "previous stage is not scored twice")
+
+	application, err = sched.Advance(ctx, second.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, models.ApplicationStatusPassed, application.Status)
+	require.Len(t, application.Interviews, 2)
+
+	again, err := sched.Apply(ctx, "go", "cand")
+	require.NoError(t, err)
+
+	again, err = sched.Advance(ctx, again.Interview(), false)
+	require.NoError(t, err)
+	require.Equal(t, models.ApplicationStatusRejected, again.Status)
+
+	_, withdrawn, err := sched.Withdraw(ctx, again.ID)
+	require.NoError(t, err)
+	require.False(t, withdrawn, "closed application is not withdrawn")
+}
+
+func TestScheduler_CreateInterview(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched, err := NewWithConfig(client, Config{Interviews: InterviewsConfig{
+		DefaultDuration: 45 * time.Minute,
+		Durations:       map[string]time.Duration{"go": 2 * time.Hour, "python": 30 * time.Minute},
+	}})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{ID: "go", Duration: 90 * time.Minute}))
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{ID: "java"}))
+
+	tests := []struct {
+		vacancy  string
+		duration time.Duration
+		want     time.Duration
+	}{
+		{vacancy: "go", want: 90 * time.Minute},
+		{vacancy: "go", duration: time.Hour, want: time.Hour},
+		{vacancy: "java", want: 45 * time.Minute},
+		{vacancy: "python", want: 30 * time.Minute},
+		{vacancy: "free-form", want: 45 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		d, err := sched.DefaultDuration(ctx, tt.vacancy)
+		require.NoError(t, err)
+		if tt.duration == 0 {
+			require.Equal(t, tt.want, d, "bot suggests the duration HR API applies")
+		}
+
+		id, err := sched.CreateInterview(ctx, tt.vacancy, "cand", tt.duration)
+		require.NoError(t, err)
+
+		found, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, tt.vacancy, found.Vacancy)
+		require.Equal(t, tt.want, found.Duration)
+	}
+}
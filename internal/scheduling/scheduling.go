@@ -0,0 +1,433 @@
+package scheduling
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+var (
+	ErrNotScheduled  = errors.Error("interview is not scheduled")
+	ErrCandidateBusy = errors.Error("candidate is busy")
+	ErrNoInterviewer = errors.Error("no free interviewer")
+	ErrTooSoon       = errors.Error("meeting starts too soon")
+)
+
+// Scheduler keeps interviews and users' meetings consistent.
+// It does not manage transactions, callers should wrap calls
+// into a txn themselves when needed.
+type Scheduler struct {
+	repo       repo.Client
+	strategy   Strategy
+	minNotice  time.Duration
+	interviews InterviewsConfig
+	now        func() time.Time
+}
+
+// New returns the scheduler choosing the least loaded interviewers without a notice period
+func New(repoClient repo.Client) Scheduler {
+	return Scheduler{repo: repoClient, strategy: leastLoaded{}, now: time.Now}
+}
+
+// NewWithConfig returns the scheduler with the strategy and the notice period from the config
+func NewWithConfig(repoClient repo.Client, cfg Config) (Scheduler, error) {
+	strategy, err := NewStrategy(cfg.Strategy)
+	if err != nil {
+		return Scheduler{}, err
+	}
+
+	if cfg.MinNotice < 0 {
+		return Scheduler{}, errors.Error("min notice must not be negative")
+	}
+
+	return Scheduler{
+		repo:       repoClient,
+		strategy:   strategy,
+		minNotice:  cmp.Or(cfg.MinNotice, DefaultMinNotice),
+		interviews: cfg.Interviews,
+		now:        time.Now,
+	}, nil
+}
+
+// MinNotice returns how long before the start a meeting can be booked at the latest
+func (s Scheduler) MinNotice() time.Duration {
+	return s.minNotice
+}
+
+// checkNotice returns ErrTooSoon if the meeting starts earlier than the notice period allows,
+// nothing is checked without a notice period
+func (s Scheduler) checkNotice(meet models.Meeting) error {
+	if s.minNotice > 0 && meet[0] < s.now().Add(s.minNotice).UnixMilli() {
+		return ErrTooSoon
+	}
+	return nil
+}
+
+// AddMeeting books the meeting for user, returns false if user
+// does not exist or is busy at that time.
+func (s Scheduler) AddMeeting(ctx context.Context, username string, meet models.Meeting) (bool, error) {
+	user, err := s.repo.Users().Get(ctx, username)
+	if err != nil {
+		return false, errors.WrapFail(err, "find user")
+	}
+
+	if user == nil {
+		return false, nil
+	}
+
+	insertIdx, can := user.AddMeeting(meet)
+	if !can {
+		return false, nil
+	}
+	meets := slices.Insert(slices.Clone(user.Assigned), insertIdx, meet)
+
+	assigned, err := s.repo.Users().UpdateMeetings(ctx, username, meets, user.Assigned)
+	if err != nil {
+		return false, errors.WrapFail(err, "update meetings")
+	}
+
+	return assigned, nil
+}
+
+// CancelMeeting releases the meeting of user, returns false if there was nothing to release.
+func (s Scheduler) CancelMeeting(ctx context.Context, username string, meet models.Meeting) (bool, error) {
+	user, err := s.repo.Users().Get(ctx, username)
+	if err != nil {
+		return false, errors.WrapFail(err, "find user")
+	}
+
+	if user == nil {
+		return false, nil
+	}
+
+	meets, found := user.FindAndDeleteMeeting(meet)
+	if !found {
+		return false, nil
+	}
+
+	updated, err := s.repo.Users().UpdateMeetings(ctx, username, meets, user.Assigned)
+	if err != nil {
+		return false, errors.WrapFail(err, "update meetings")
+	}
+
+	return updated, nil
+}
+
+// CancelInterview cancels scheduled interview and releases meetings of the candidate and the whole panel,
+// returns false if the interview has not been scheduled.
+func (s Scheduler) CancelInterview(ctx context.Context, interview *models.Interview, side models.Role) (bool, error) {
+	if interview.Status != models.InterviewStatusScheduled || interview.Meet == nil {
+		return false, nil
+	}
+
+	err := s.repo.Interviews().Cancel(ctx, interview.ID, side)
+	if err != nil {
+		return false, errors.WrapFail(err, "do Interviews.Cancel request")
+	}
+
+	// participants may have been revoked or released already,
+	// so every meeting is released if it is still there
+	usernames := []string{interview.CandidateUN, interview.InterviewerUN}
+	for _, panelist := range interview.Panelists {
+		usernames = append(usernames, panelist.Username)
+	}
+
+	for _, username := range usernames {
+		_, err = s.CancelMeeting(ctx, username, *interview.Meet)
+		if err != nil {
+			return false, errors.WrapFail(err, "cancel meeting of %s", username)
+		}
+	}
+
+	return true, nil
+}
+
+// DeleteInterview cancels the interview on behalf of HR and removes it.
+// Returns nil if the interview does not exist and whether it has been cancelled.
+func (s Scheduler) DeleteInterview(ctx context.Context, id string) (*models.Interview, bool, error) {
+	found, err := s.repo.Interviews().Find(ctx, id)
+	if err != nil {
+		return nil, false, errors.WrapFail(err, "find interview")
+	}
+
+	if found == nil {
+		return nil, false, nil
+	}
+
+	cancelled, err := s.CancelInterview(ctx, found, models.RoleHR)
+	if err != nil {
+		return nil, false, errors.WrapFail(err, "cancel interview")
+	}
+
+	_, err = s.repo.Interviews().Delete(ctx, id)
+	if err != nil {
+		return nil, false, errors.WrapFail(err, "delete interview")
+	}
+
+	return found, cancelled, nil
+}
+
+// RevokeInterviewer resets interviewer grade and cancels all interviews the user leads.
+// Panelists and shadows are removed from the panel instead, the rest of it keeps the meeting.
+// Returns user state before revoking (nil if user does not exist) and cancelled interviews.
+func (s Scheduler) RevokeInterviewer(ctx context.Context, username string) (*models.User, []*models.Interview, error) {
+	gradeDown := models.GradeNotInterviewer
+
+	old, err := s.repo.Users().Update(ctx, username, nil, nil, &gradeDown)
+	if err != nil {
+		return nil, nil, errors.WrapFail(err, "do Users.Update request")
+	}
+
+	if old == nil || old.IntGrade == models.GradeNotInterviewer {
+		return old, nil, nil
+	}
+
+	assigned, err := s.repo.Interviews().FindByUser(ctx, username)
+	if err != nil {
+		return nil, nil, errors.WrapFail(err, "find interviews assigned to interviewer")
+	}
+
+	var cancelled []*models.Interview
+	for _, interview := range assigned {
+		if !interview.IsInterviewer(username) {
+			continue
+		}
+
+		if interview.InterviewerUN != username {
+			err = s.removePanelist(ctx, interview, username)
+			if err != nil {
+				return nil, nil, errors.WrapFail(err, "remove panelist from interview")
+			}
+			continue
+		}
+
+		ok, err := s.CancelInterview(ctx, interview, models.RoleInterviewer)
+		if err != nil {
+			return nil, nil, errors.WrapFail(err, "cancel interview assigned to interviewer")
+		}
+
+		if ok {
+			cancelled = append(cancelled, interview)
+		}
+	}
+
+	return old, cancelled, nil
+}
+
+// removePanelist releases the meeting of the panelist of scheduled interview and removes the user from it
+func (s Scheduler) removePanelist(ctx context.Context, interview *models.Interview, username string) error {
+	if interview.Status != models.InterviewStatusScheduled || interview.Meet == nil {
+		return nil
+	}
+
+	err := s.repo.Interviews().RemovePanelist(ctx, interview.ID, username)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.RemovePanelist request")
+	}
+
+	_, err = s.CancelMeeting(ctx, username, *interview.Meet)
+	return errors.WrapFail(err, "cancel meeting of %s", username)
+}
+
+// Book schedules a new interview at the meeting for the candidate and the whole panel.
+// The call must be wrapped into a txn to be atomic, nothing is booked on error.
+// Returns the lead interviewer and the rest of the panel.
+func (s Scheduler) Book(
+	ctx context.Context,
+	interview *models.Interview,
+	meet models.Meeting,
+) (models.User, []models.Panelist, error) {
+	err := s.checkNotice(meet)
+	if err != nil {
+		return models.User{}, nil, err
+	}
+
+	ok, err := s.AddMeeting(ctx, interview.CandidateUN, meet)
+	if err != nil {
+		return models.User{}, nil, errors.WrapFail(err, "add meeting for candidate")
+	}
+	if !ok {
+		return models.User{}, nil, ErrCandidateBusy
+	}
+
+	return s.schedule(ctx, interview, meet)
+}
+
+// Reschedule moves scheduled interview to the new meeting, preferring the same interviewers.
+// Meetings of all participants are swapped, so the call must be wrapped into a txn to be atomic.
+// Returns the lead interviewer and the rest of the panel of the moved interview.
+func (s Scheduler) Reschedule(
+	ctx context.Context,
+	interview *models.Interview,
+	meet models.Meeting,
+) (models.User, []models.Panelist, error) {
+	if interview.Status != models.InterviewStatusScheduled || interview.Meet == nil {
+		return models.User{}, nil, ErrNotScheduled
+	}
+
+	err := s.checkNotice(meet)
+	if err != nil {
+		return models.User{}, nil, err
+	}
+
+	// release the current meeting first, the new one may overlap it
+	for _, username := range append([]string{interview.CandidateUN}, interview.Interviewers()...) {
+		_, err = s.CancelMeeting(ctx, username, *interview.Meet)
+		if err != nil {
+			return models.User{}, nil, errors.WrapFail(err, "release meeting of %s", username)
+		}
+	}
+
+	ok, err := s.AddMeeting(ctx, interview.CandidateUN, meet)
+	if err != nil {
+		return models.User{}, nil, errors.WrapFail(err, "add meeting for candidate")
+	}
+	if !ok {
+		return models.User{}, nil, ErrCandidateBusy
+	}
+
+	return s.schedule(ctx, interview, meet)
+}
+
+// schedule books the panel for the meeting already booked for the candidate and saves it
+func (s Scheduler) schedule(
+	ctx context.Context,
+	interview *models.Interview,
+	meet models.Meeting,
+) (models.User, []models.Panelist, error) {
+	lead, panelists, err := s.assignPanel(ctx, interview, meet)
+	if err != nil {
+		return models.User{}, nil, err
+	}
+
+	candidate, err := s.repo.Users().Get(ctx, interview.CandidateUN)
+	if err != nil {
+		return models.User{}, nil, errors.WrapFail(err, "find candidate")
+	}
+	if candidate == nil {
+		candidate = &models.User{Username: interview.CandidateUN}
+	}
+
+	err = s.repo.Interviews().Schedule(ctx, interview.ID, *candidate, lead, panelists, meet)
+	if err != nil {
+		return models.User{}, nil, errors.WrapFail(err, "do Interviews.Schedule request")
+	}
+
+	return lead, panelists, nil
+}
+
+// assignPanel books the meeting for interviewers of the panel: the current ones keep their seats
+// if possible, the rest are taken from matched ones having more of the required skills first and
+// in order of the strategy among equal ones. Interviewers, but not shadows, are restricted by the
+// vacancy, nobody is booked beyond their limits or buffers. The first booked interviewer leads the meeting.
+func (s Scheduler) assignPanel(
+	ctx context.Context,
+	interview *models.Interview,
+	meet models.Meeting,
+) (models.User, []models.Panelist, error) {
+	filter, err := s.InterviewerFilter(ctx, interview)
+	if err != nil {
+		return models.User{}, nil, err
+	}
+
+	var (
+		seats   = [...]int{max(1, interview.Panel.Interviewers), interview.Panel.Shadows}
+		booked  [2][]models.User
+		tried   = map[string]bool{interview.CandidateUN: true}
+		matched []models.User
+	)
+
+	// book takes a seat for the user if it is free and the user can take it, every user is tried once
+	book := func(user models.User, shadow bool) (bool, error) {
+		seat := 0
+		allowed := filter.Allows(user)
+		if shadow {
+			seat = 1
+			allowed = models.InterviewerFilter{}.Allows(user)
+		}
+
+		// limits and buffers are checked by AddMeeting on the fresh state of the user
+		if tried[user.Username] || len(booked[seat]) >= seats[seat] || !allowed || !user.IsAvailable(meet) {
+			return false, nil
+		}
+		tried[user.Username] = true
+
+		ok, err := s.AddMeeting(ctx, user.Username, meet)
+		if err != nil {
+			return false, errors.WrapFail(err, "add meeting for interviewer %s", user.Username)
+		}
+
+		if ok {
+			booked[seat] = append(booked[seat], user)
+		}
+		return ok, nil
+	}
+
+	current := []models.Panelist{{Username: interview.InterviewerUN}}
+	if interview.InterviewerUN == "" {
+		current = nil
+	}
+	for _, panelist := range append(current, interview.Panelists...) {
+		user, err := s.repo.Users().Get(ctx, panelist.Username)
+		if err != nil {
+			return models.User{}, nil, errors.WrapFail(err, "find interviewer")
+		}
+
+		if user != nil {
+			_, err = book(*user, panelist.Shadow)
+			if err != nil {
+				return models.User{}, nil, err
+			}
+		}
+	}
+
+	if len(booked[0]) < seats[0] || len(booked[1]) < seats[1] {
+		pool, err := s.repo.Users().Match(ctx, meet, interview.Panel.PoolFilter(filter))
+		if err != nil {
+			return models.User{}, nil, errors.WrapFail(err, "do Users.Match request")
+		}
+		pool = s.strategy.Rank(pool, meet)
+		// interviewers with more of the required skills go first, the strategy decides among equal ones
+		models.RankBySkills(pool, filter.Skills)
+
+		// interviewers' seats are taken first, so that shadows do not occupy suitable ones
+		for _, shadow := range [...]bool{false, true} {
+			for _, user := range pool {
+				ok, err := book(user, shadow)
+				if err != nil {
+					return models.User{}, nil, err
+				}
+				if ok {
+					matched = append(matched, user)
+				}
+			}
+		}
+	}
+
+	if len(booked[0]) < seats[0] || len(booked[1]) < seats[1] {
+		return models.User{}, nil, ErrNoInterviewer
+	}
+
+	// aborted bookings must not move the strategy
+	txn.OnCommit(ctx, func() {
+		for _, user := range matched {
+			s.strategy.Booked(user)
+		}
+	})
+
+	var panelists []models.Panelist
+	for _, user := range booked[0][1:] {
+		panelists = append(panelists, models.Panelist{Username: user.Username, Telegram: user.Telegram})
+	}
+	for _, user := range booked[1] {
+		panelists = append(panelists, models.Panelist{Username: user.Username, Telegram: user.Telegram, Shadow: true})
+	}
+
+	return booked[0][0], panelists, nil
+}
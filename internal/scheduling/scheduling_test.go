@@ -0,0 +1,763 @@
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+func TestScheduler_DeleteInterview(t *testing.T) {
+	meet := models.Meeting{100, 200}
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		rMock := NewMockrepoClient(ctrl)
+		iMock := NewMockinterviewsApi(ctrl)
+		rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+
+		iMock.EXPECT().Find(gomock.Any(), "1").Return(nil, nil)
+
+		found, cancelled, err := New(rMock).DeleteInterview(context.Background(), "1")
+		require.NoError(t, err)
+		require.Nil(t, found)
+		require.False(t, cancelled)
+	})
+
+	t.Run("not scheduled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		rMock := NewMockrepoClient(ctrl)
+		iMock := NewMockinterviewsApi(ctrl)
+		rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+
+		interview := &models.Interview{ID: "1", CandidateUN: "cand"}
+		iMock.EXPECT().Find(gomock.Any(), "1").Return(interview, nil)
+		iMock.EXPECT().Delete(gomock.Any(), "1").Return(interview, nil)
+
+		found, cancelled, err := New(rMock).DeleteInterview(context.Background(), "1")
+		require.NoError(t, err)
+		require.Equal(t, interview, found)
+		require.False(t, cancelled)
+	})
+
+	t.Run("scheduled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		rMock := NewMockrepoClient(ctrl)
+		iMock := NewMockinterviewsApi(ctrl)
+		uMock := NewMockusersApi(ctrl)
+		rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+		rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+		interview := &models.Interview{
+			ID:            "1",
+			CandidateUN:   "cand",
+			InterviewerUN: "int",
+			Status:        models.InterviewStatusScheduled,
+			Meet:          (*[2]int64)(&meet),
+		}
+
+		gomock.InOrder(
+			iMock.EXPECT().Find(gomock.Any(), "1").Return(interview, nil),
+			iMock.EXPECT().Cancel(gomock.Any(), "1", models.RoleHR).Return(nil),
+			iMock.EXPECT().Delete(gomock.Any(), "1").Return(interview, nil),
+		)
+
+		for _, username := range []string{"cand", "int"} {
+			assigned := []models.Meeting{meet}
+			uMock.EXPECT().Get(gomock.Any(), username).
+				Return(&models.User{Username: username, Assigned: assigned}, nil)
+			uMock.EXPECT().UpdateMeetings(gomock.Any(), username, []models.Meeting{}, assigned).
+				Return(true, nil)
+		}
+
+		found, cancelled, err := New(rMock).DeleteInterview(context.Background(), "1")
+		require.NoError(t, err)
+		require.Equal(t, interview, found)
+		require.True(t, cancelled)
+	})
+}
+
+func TestScheduler_RevokeInterviewer(t *testing.T) {
+	meet := models.Meeting{100, 200}
+
+	t.Run("unknown user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		rMock := NewMockrepoClient(ctrl)
+		uMock := NewMockusersApi(ctrl)
+		rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+		uMock.EXPECT().Update(gomock.Any(), "int", nil, nil, gomock.Any()).Return(nil, nil)
+
+		old, cancelled, err := New(rMock).RevokeInterviewer(context.Background(), "int")
+		require.NoError(t, err)
+		require.Nil(t, old)
+		require.Empty(t, cancelled)
+	})
+
+	t.Run("cancels only interviews where user is interviewer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		rMock := NewMockrepoClient(ctrl)
+		iMock := NewMockinterviewsApi(ctrl)
+		uMock := NewMockusersApi(ctrl)
+		rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+		rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+		asInterviewer := &models.Interview{
+			ID:            "1",
+			CandidateUN:   "cand",
+			InterviewerUN: "int",
+			Status:        models.InterviewStatusScheduled,
+			Meet:          (*[2]int64)(&meet),
+		}
+		asCandidate := &models.Interview{ID: "2", CandidateUN: "int"}
+
+		uMock.EXPECT().Update(gomock.Any(), "int", nil, nil, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *int64, _ *models.UserCategory, grade *int) (*models.User, error) {
+				require.Equal(t, models.GradeNotInterviewer, *grade)
+				return &models.User{Username: "int", IntGrade: 1}, nil
+			})
+		iMock.EXPECT().FindByUser(gomock.Any(), "int").
+			Return([]*models.Interview{asInterviewer, asCandidate}, nil)
+		iMock.EXPECT().Cancel(gomock.Any(), "1", models.RoleInterviewer).Return(nil)
+
+		for _, username := range []string{"cand", "int"} {
+			assigned := []models.Meeting{meet}
+			uMock.EXPECT().Get(gomock.Any(), username).
+				Return(&models.User{Username: username, Assigned: assigned}, nil)
+			uMock.EXPECT().UpdateMeetings(gomock.Any(), username, []models.Meeting{}, assigned).
+				Return(true, nil)
+		}
+
+		old, cancelled, err := New(rMock).RevokeInterviewer(context.Background(), "int")
+		require.NoError(t, err)
+		require.Equal(t, 1, old.IntGrade)
+		require.Equal(t, []*models.Interview{asInterviewer}, cancelled)
+	})
+}
+
+func TestScheduler_RevokeInterviewer_panel(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	grade := 1
+	for _, username := range []string{"cand", "lead", "panelist", "shadow"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "", "cand", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.Interviews().SetPanel(ctx, id, models.Panel{Interviewers: 2, Shadows: 1}))
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	meet := models.Meeting{0, models.DefaultInterviewDuration.Milliseconds()}
+	lead, _, err := sched.Book(ctx, interview, meet)
+	require.NoError(t, err)
+
+	assigned := func(username string) []models.Meeting {
+		user, err := client.Users().Get(ctx, username)
+		require.NoError(t, err)
+		return user.Assigned
+	}
+
+	// revoked panelists and shadows leave the panel, the meeting is kept for the rest
+	for _, username := range []string{"lead", "panelist", "shadow"} {
+		if username == lead.Username {
+			continue
+		}
+
+		_, cancelled, err := sched.RevokeInterviewer(ctx, username)
+		require.NoError(t, err)
+		require.Empty(t, cancelled, username)
+		require.Empty(t, assigned(username), username)
+
+		interview, err = client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, models.InterviewStatusScheduled, interview.Status)
+		require.False(t, interview.IsInterviewer(username), username)
+	}
+	require.Empty(t, interview.Panelists)
+	require.Equal(t, []models.Meeting{meet}, assigned("cand"))
+	require.Equal(t, []models.Meeting{meet}, assigned(lead.Username))
+
+	// the interview cannot take place without the lead
+	_, cancelled, err := sched.RevokeInterviewer(ctx, lead.Username)
+	require.NoError(t, err)
+	require.Len(t, cancelled, 1)
+	require.Empty(t, assigned("cand"))
+	require.Empty(t, assigned(lead.Username))
+}
+
+func TestScheduler_Reschedule(t *testing.T) {
+	oldMeet := models.Meeting{100, 200}
+	newMeet := models.Meeting{150, 250}
+
+	scheduled := func() *models.Interview {
+		return &models.Interview{
+			ID:            "1",
+			CandidateUN:   "cand",
+			InterviewerUN: "int",
+			Status:        models.InterviewStatusScheduled,
+			Meet:          (*[2]int64)(&oldMeet),
+		}
+	}
+
+	type testcase struct {
+		name      string
+		interview *models.Interview
+		prepare   func(u *MockusersApi, i *MockinterviewsApi)
+
+		wantInterviewer string
+		wantErr         error
+	}
+
+	// users keeps assigned meetings in memory, so that release
+	// of the old meeting is visible to the following Get calls
+	users := func(u *MockusersApi, all ...models.User) {
+		byName := make(map[string]*models.User)
+		for idx := range all {
+			byName[all[idx].Username] = &all[idx]
+		}
+
+		u.EXPECT().Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, username string) (*models.User, error) {
+				user, ok := byName[username]
+				if !ok {
+					return nil, nil
+				}
+				copied := *user
+				copied.Assigned = append([]models.Meeting{}, user.Assigned...)
+				return &copied, nil
+			}).AnyTimes()
+
+		u.EXPECT().UpdateMeetings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, username string, meets, _ []models.Meeting) (bool, error) {
+				byName[username].Assigned = meets
+				return true, nil
+			}).AnyTimes()
+	}
+
+	tests := [...]testcase{
+		{
+			name:      "not scheduled",
+			interview: &models.Interview{ID: "1", CandidateUN: "cand"},
+			wantErr:   ErrNotScheduled,
+		},
+		{
+			name:      "same interviewer, overlapping the old meeting",
+			interview: scheduled(),
+			prepare: func(u *MockusersApi, i *MockinterviewsApi) {
+				users(u,
+					models.User{Username: "cand", Assigned: []models.Meeting{oldMeet}},
+					models.User{Username: "int", IntGrade: 1, Assigned: []models.Meeting{oldMeet}},
+				)
+				i.EXPECT().Schedule(gomock.Any(), "1", gomock.Any(), gomock.Any(), nil, newMeet).
+					DoAndReturn(func(_ context.Context, _ string, cand, interviewer models.User, _ []models.Panelist, _ models.Meeting) error {
+						require.Equal(t, "cand", cand.Username)
+						require.Equal(t, "int", interviewer.Username)
+						return nil
+					})
+			},
+			wantInterviewer: "int",
+		},
+		{
+			name:      "interviewer is busy, another one is matched",
+			interview: scheduled(),
+			prepare: func(u *MockusersApi, i *MockinterviewsApi) {
+				users(u,
+					models.User{Username: "cand", Assigned: []models.Meeting{oldMeet}},
+					models.User{Username: "int", IntGrade: 1, Assigned: []models.Meeting{oldMeet, {240, 300}}},
+					models.User{Username: "other", IntGrade: 1},
+				)
+				u.EXPECT().Match(gomock.Any(), [2]int64(newMeet), models.InterviewerFilter{}).
+					Return([]models.User{{Username: "cand"}, {Username: "other", IntGrade: 1}}, nil)
+				i.EXPECT().Schedule(gomock.Any(), "1", gomock.Any(), gomock.Any(), nil, newMeet).Return(nil)
+			},
+			wantInterviewer: "other",
+		},
+		{
+			name:      "candidate is busy",
+			interview: scheduled(),
+			prepare: func(u *MockusersApi, _ *MockinterviewsApi) {
+				users(u,
+					models.User{Username: "cand", Assigned: []models.Meeting{oldMeet, {200, 220}}},
+					models.User{Username: "int", IntGrade: 1, Assigned: []models.Meeting{oldMeet}},
+				)
+			},
+			wantErr: ErrCandidateBusy,
+		},
+		{
+			name:      "no interviewer",
+			interview: scheduled(),
+			prepare: func(u *MockusersApi, _ *MockinterviewsApi) {
+				users(u,
+					models.User{Username: "cand", Assigned: []models.Meeting{oldMeet}},
+					models.User{Username: "int"},
+				)
+				u.EXPECT().Match(gomock.Any(), [2]int64(newMeet), models.InterviewerFilter{}).Return(nil, nil)
+			},
+			wantErr: ErrNoInterviewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rMock := NewMockrepoClient(ctrl)
+			iMock := NewMockinterviewsApi(ctrl)
+			uMock := NewMockusersApi(ctrl)
+			rMock.EXPECT().Interviews().Return(iMock).AnyTimes()
+			rMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+			if tt.prepare != nil {
+				tt.prepare(uMock, iMock)
+			}
+
+			interviewer, _, err := New(rMock).Reschedule(context.Background(), tt.interview, newMeet)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantInterviewer, interviewer.Username)
+		})
+	}
+}
+
+func TestScheduler_Reschedule_memoryRepo(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	grade := 1
+	for _, username := range []string{"cand", "int"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	oldMeet := models.Meeting{0, models.DefaultInterviewDuration.Milliseconds()}
+	for _, username := range []string{"cand", "int"} {
+		ok, err := sched.AddMeeting(ctx, username, oldMeet)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	require.NoError(t, client.Interviews().Schedule(
+		ctx, id, models.User{Username: "cand"}, models.User{Username: "int"}, nil, oldMeet,
+	))
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	newMeet := models.Meeting{oldMeet[1] / 2, oldMeet[1] * 3 / 2}
+	interviewer, _, err := sched.Reschedule(ctx, interview, newMeet)
+	require.NoError(t, err)
+	require.Equal(t, "int", interviewer.Username)
+
+	for _, username := range []string{"cand", "int"} {
+		user, err := client.Users().Get(ctx, username)
+		require.NoError(t, err)
+		require.Equal(t, []models.Meeting{newMeet}, user.Assigned)
+	}
+
+	interview, err = client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, [2]int64(newMeet), *interview.Meet)
+}
+
+func TestScheduler_Book_panel(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{ID: "go", MinGrade: 2}))
+
+	grades := map[string]int{"cand": 0, "senior": 2, "lead": 3, "junior": 1}
+	for username, grade := range grades {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.Interviews().SetPanel(ctx, id, models.Panel{Interviewers: 2, Shadows: 1}))
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	meet := models.Meeting{0, models.DefaultInterviewDuration.Milliseconds()}
+	interviewer, panelists, err := sched.Book(ctx, interview, meet)
+	require.NoError(t, err)
+
+	require.Contains(t, []string{"senior", "lead"}, interviewer.Username)
+	require.Len(t, panelists, 2)
+	require.Contains(t, []string{"senior", "lead"}, panelists[0].Username)
+	require.NotEqual(t, interviewer.Username, panelists[0].Username)
+	require.False(t, panelists[0].Shadow)
+	require.Equal(t, models.Panelist{Username: "junior", Shadow: true}, panelists[1])
+
+	_, _, err = sched.Book(ctx, interview, meet)
+	require.ErrorIs(t, err, ErrCandidateBusy)
+
+	interview, err = client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, panelists, interview.Panelists)
+
+	scheduled, err := sched.CancelInterview(ctx, interview, models.RoleHR)
+	require.NoError(t, err)
+	require.True(t, scheduled)
+
+	for username := range grades {
+		user, err := client.Users().Get(ctx, username)
+		require.NoError(t, err)
+		require.Empty(t, user.Assigned, username)
+	}
+}
+
+func TestScheduler_CancelInterview_released(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	grade := 1
+	for _, username := range []string{"cand", "lead", "panelist"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "", "cand", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.Interviews().SetPanel(ctx, id, models.Panel{Interviewers: 2}))
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	meet := models.Meeting{0, models.DefaultInterviewDuration.Milliseconds()}
+	lead, _, err := sched.Book(ctx, interview, meet)
+	require.NoError(t, err)
+
+	interview, err = client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	// the lead has released the meeting, e.g. being revoked
+	released, err := sched.CancelMeeting(ctx, lead.Username, meet)
+	require.NoError(t, err)
+	require.True(t, released)
+
+	scheduled, err := sched.CancelInterview(ctx, interview, models.RoleHR)
+	require.NoError(t, err)
+	require.True(t, scheduled)
+
+	for _, username := range []string{"cand", "lead", "panelist"} {
+		user, err := client.Users().Get(ctx, username)
+		require.NoError(t, err)
+		require.Empty(t, user.Assigned, username)
+	}
+
+	cancelled, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, models.InterviewStatusCancelled, cancelled.Status)
+
+	scheduled, err = sched.CancelInterview(ctx, cancelled, models.RoleHR)
+	require.NoError(t, err)
+	require.False(t, scheduled)
+}
+
+func TestScheduler_Book_panelNotSeated(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	grade := 1
+	for _, username := range []string{"cand", "int"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "", "cand", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.Interviews().SetPanel(ctx, id, models.Panel{Interviewers: 2}))
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	_, _, err = sched.Book(ctx, interview, models.Meeting{0, 1000})
+	require.ErrorIs(t, err, ErrNoInterviewer)
+}
+
+func TestScheduler_Book_limits(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	sched, err := NewWithConfig(client, Config{Strategy: StrategyRoundRobin})
+	require.NoError(t, err)
+	sched.now = func() time.Time { return time.UnixMilli(0) }
+
+	grade := 1
+	for _, username := range []string{"alice", "bob"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+	_, err = client.Users().SetLimits(ctx, "alice", models.Limits{PerDay: 1})
+	require.NoError(t, err)
+
+	hour := time.Hour.Milliseconds()
+	book := func(candidate string, start int64) (string, error) {
+		_, err := client.Users().Upsert(ctx, candidate, nil, nil, nil)
+		require.NoError(t, err)
+
+		id, err := client.Interviews().Create(ctx, "", candidate, 0)
+		require.NoError(t, err)
+
+		interview, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+
+		interviewer, _, err := sched.Book(ctx, interview, models.Meeting{start, start + hour})
+		return interviewer.Username, err
+	}
+
+	interviewer, err := book("cand1", 10*hour)
+	require.NoError(t, err)
+	require.Equal(t, "alice", interviewer)
+
+	interviewer, err = book("cand2", 12*hour)
+	require.NoError(t, err)
+	require.Equal(t, "bob", interviewer, "round robin")
+
+	interviewer, err = book("cand3", 14*hour)
+	require.NoError(t, err)
+	require.Equal(t, "bob", interviewer, "alice has reached the daily limit")
+
+	interviewer, err = book("cand4", 34*hour)
+	require.NoError(t, err)
+	require.Equal(t, "alice", interviewer, "the next day")
+}
+
+func TestScheduler_Book_aborted(t *testing.T) {
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	sched, err := NewWithConfig(client, Config{Strategy: StrategyRoundRobin})
+	require.NoError(t, err)
+	sched.now = func() time.Time { return time.UnixMilli(0) }
+
+	ctx, cancel, err := txn.NewManager(client).NewSessionContext(context.Background(), time.Minute)
+	require.NoError(t, err)
+	defer cancel()
+
+	grade := 1
+	for _, username := range []string{"alice", "bob"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	hour := time.Hour.Milliseconds()
+	book := func(candidate string, start int64, commit bool) string {
+		_, err := client.Users().Upsert(ctx, candidate, nil, nil, nil)
+		require.NoError(t, err)
+
+		id, err := client.Interviews().Create(ctx, "", candidate, 0)
+		require.NoError(t, err)
+
+		interview, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+
+		tx, err := txn.Start(ctx)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, tx.Close(ctx)) }()
+
+		interviewer, _, err := sched.Book(ctx, interview, models.Meeting{start, start + hour})
+		require.NoError(t, err)
+
+		if commit {
+			require.NoError(t, tx.Commit(ctx))
+		} else {
+			require.NoError(t, tx.Abort(ctx))
+		}
+		return interviewer.Username
+	}
+
+	require.Equal(t, "alice", book("cand1", 10*hour, false))
+	require.Equal(t, "alice", book("cand2", 10*hour, true), "aborted booking does not move the rotation")
+	require.Equal(t, "bob", book("cand3", 12*hour, true))
+}
+
+func TestScheduler_Book_minGrade(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{ID: "go", MinGrade: models.GradeMiddle}))
+
+	grades := map[string]int{"cand": 0, "middle": models.GradeMiddle, "staff": models.GradeStaff}
+	for username, grade := range grades {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+
+	id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+	require.NoError(t, err)
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	filter, err := sched.InterviewerFilter(ctx, interview)
+	require.NoError(t, err)
+	require.Equal(t, models.GradeMiddle, filter.MinGrade, "vacancy grade applies")
+
+	minGrade := models.GradeJunior
+	require.NoError(t, client.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, &minGrade, nil))
+	interview, err = client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	filter, err = sched.InterviewerFilter(ctx, interview)
+	require.NoError(t, err)
+	require.Equal(t, models.GradeMiddle, filter.MinGrade, "interview can not lower vacancy grade")
+
+	minGrade = models.GradeStaff
+	require.NoError(t, client.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, &minGrade, nil))
+	interview, err = client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	interviewer, _, err := sched.Book(ctx, interview, models.Meeting{0, models.DefaultInterviewDuration.Milliseconds()})
+	require.NoError(t, err)
+	require.Equal(t, "staff", interviewer.Username)
+}
+
+func TestScheduler_Book_skills(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	require.NoError(t, client.Vacancies().Upsert(ctx, models.Vacancy{ID: "web", Skills: []string{"frontend", "go"}}))
+
+	skills := map[string][]string{"dba": {"postgres"}, "backend": {"go"}, "fullstack": {"frontend", "go"}}
+	grade := models.GradeJunior
+	for username, tags := range skills {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+		_, err = client.Users().SetSkills(ctx, username, tags)
+		require.NoError(t, err)
+	}
+
+	hour := time.Hour.Milliseconds()
+	book := func(candidate string, required []string) (string, error) {
+		_, err := client.Users().Upsert(ctx, candidate, nil, nil, nil)
+		require.NoError(t, err)
+
+		id, err := client.Interviews().Create(ctx, "web", candidate, 0)
+		require.NoError(t, err)
+		if required != nil {
+			require.NoError(t, client.Interviews().Update(ctx, id, nil, nil, nil, nil, nil, nil, &required))
+		}
+
+		interview, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+
+		interviewer, _, err := sched.Book(ctx, interview, models.Meeting{0, hour})
+		return interviewer.Username, err
+	}
+
+	interviewer, err := book("cand1", nil)
+	require.NoError(t, err)
+	require.Equal(t, "fullstack", interviewer, "the best overlap goes first")
+
+	interviewer, err = book("cand2", nil)
+	require.NoError(t, err)
+	require.Equal(t, "backend", interviewer, "any of the vacancy skills is enough")
+
+	interviewer, err = book("cand3", []string{"postgres"})
+	require.NoError(t, err)
+	require.Equal(t, "dba", interviewer, "interview skills replace the vacancy ones")
+
+	_, err = book("cand4", nil)
+	require.ErrorIs(t, err, ErrNoInterviewer)
+}
+
+func TestScheduler_Book_buffers(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	sched := New(client)
+
+	grade := models.GradeJunior
+	for _, username := range []string{"alice", "bob"} {
+		_, err := client.Users().Upsert(ctx, username, nil, nil, &grade)
+		require.NoError(t, err)
+	}
+	_, err := client.Users().SetLimits(ctx, "alice", models.Limits{BufferAfter: 15 * time.Minute})
+	require.NoError(t, err)
+
+	hour := time.Hour.Milliseconds()
+	book := func(candidate string, start int64) (string, error) {
+		_, err := client.Users().Upsert(ctx, candidate, nil, nil, nil)
+		require.NoError(t, err)
+
+		id, err := client.Interviews().Create(ctx, "", candidate, 0)
+		require.NoError(t, err)
+
+		interview, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+
+		interviewer, _, err := sched.Book(ctx, interview, models.Meeting{start, start + hour})
+		return interviewer.Username, err
+	}
+
+	interviewer, err := book("cand1", 10*hour)
+	require.NoError(t, err)
+	require.Equal(t, "alice", interviewer)
+
+	interviewer, err = book("cand2", 11*hour)
+	require.NoError(t, err)
+	require.Equal(t, "bob", interviewer, "alice needs a break after the meeting")
+
+	interviewer, err = book("cand3", 13*hour)
+	require.NoError(t, err)
+	require.Equal(t, "alice", interviewer, "alice is rested")
+}
+
+func TestScheduler_Book_notice(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	sched, err := NewWithConfig(client, Config{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultMinNotice, sched.MinNotice())
+
+	now := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+	sched.now = func() time.Time { return now }
+
+	grade := models.GradeJunior
+	_, err = client.Users().Upsert(ctx, "alice", nil, nil, &grade)
+	require.NoError(t, err)
+	_, err = client.Users().Upsert(ctx, "cand", nil, nil, nil)
+	require.NoError(t, err)
+
+	id, err := client.Interviews().Create(ctx, "", "cand", 0)
+	require.NoError(t, err)
+
+	interview, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+
+	soon := now.Add(DefaultMinNotice - time.Minute).UnixMilli()
+	_, _, err = sched.Book(ctx, interview, models.Meeting{soon, soon + time.Hour.Milliseconds()})
+	require.ErrorIs(t, err, ErrTooSoon)
+
+	later := now.Add(DefaultMinNotice).UnixMilli()
+	interviewer, _, err := sched.Book(ctx, interview, models.Meeting{later, later + time.Hour.Milliseconds()})
+	require.NoError(t, err)
+	require.Equal(t, "alice", interviewer.Username)
+
+	_, err = NewWithConfig(client, Config{MinNotice: -time.Minute})
+	require.Error(t, err)
+}
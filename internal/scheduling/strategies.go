@@ -0,0 +1,132 @@
+package scheduling
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// Strategy decides which of the matched interviewers are booked first
+type Strategy interface {
+	// Rank orders the pool by preference, it may reorder the given slice
+	Rank(pool []models.User, meet models.Meeting) []models.User
+
+	// Booked is called for every interviewer booked from the ranked pool, once the booking is committed
+	Booked(user models.User)
+}
+
+const (
+	StrategyLeastLoaded   = "least_loaded"
+	StrategyRoundRobin    = "round_robin"
+	StrategyGradeWeighted = "grade_weighted"
+)
+
+// DefaultMinNotice is used when the notice period is not configured
+const DefaultMinNotice = time.Hour
+
+type Config struct {
+	// Strategy is one of StrategyLeastLoaded (default), StrategyRoundRobin or StrategyGradeWeighted
+	Strategy string `yaml:"strategy"`
+
+	// MinNotice is how long before the start a meeting can be booked at the latest, DefaultMinNotice if zero
+	MinNotice time.Duration `yaml:"minNotice"`
+
+	// Interviews are durations of interviews created without explicit one
+	Interviews InterviewsConfig `yaml:"interviews"`
+}
+
+// InterviewsConfig sets durations of interviews whose vacancy has none, per vacancy
+// and overall, models.DefaultInterviewDuration is used if neither is set
+type InterviewsConfig struct {
+	DefaultDuration time.Duration            `yaml:"defaultDuration"`
+	Durations       map[string]time.Duration `yaml:"durations"`
+}
+
+// NewStrategy returns the strategy by name, empty name means least loaded
+func NewStrategy(name string) (Strategy, error) {
+	switch name {
+	case "", StrategyLeastLoaded:
+		return leastLoaded{}, nil
+	case StrategyRoundRobin:
+		return &roundRobin{}, nil
+	case StrategyGradeWeighted:
+		return gradeWeighted{random: rand.Float64}, nil
+	default:
+		return nil, errors.Error("unknown interviewer selection strategy %q", name)
+	}
+}
+
+// leastLoaded prefers interviewers with fewer meetings in the week of the meeting
+type leastLoaded struct{}
+
+func (leastLoaded) Rank(pool []models.User, meet models.Meeting) []models.User {
+	slices.SortStableFunc(pool, func(a, b models.User) int {
+		return cmp.Or(
+			cmp.Compare(a.CountMeetings(a.WeekOf(meet)), b.CountMeetings(b.WeekOf(meet))),
+			cmp.Compare(len(a.Assigned), len(b.Assigned)),
+			strings.Compare(a.Username, b.Username),
+		)
+	})
+	return pool
+}
+
+func (leastLoaded) Booked(models.User) {}
+
+// roundRobin takes interviewers in order of usernames starting after the last booked one.
+// The position is kept in memory, so every process rotates on its own.
+type roundRobin struct {
+	mu   sync.Mutex
+	last string
+}
+
+func (r *roundRobin) Rank(pool []models.User, _ models.Meeting) []models.User {
+	slices.SortFunc(pool, func(a, b models.User) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	r.mu.Lock()
+	last := r.last
+	r.mu.Unlock()
+
+	next, _ := slices.BinarySearchFunc(pool, last, func(u models.User, last string) int {
+		if u.Username <= last {
+			return -1
+		}
+		return 1
+	})
+
+	return append(pool[next:len(pool):len(pool)], pool[:next]...)
+}
+
+func (r *roundRobin) Booked(user models.User) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = user.Username
+}
+
+// gradeWeighted picks interviewers at random, the chance to be first is proportional to the grade
+type gradeWeighted struct {
+	random func() float64
+}
+
+func (g gradeWeighted) Rank(pool []models.User, _ models.Meeting) []models.User {
+	// exponential keys give weighted sampling without replacement
+	keys := make(map[string]float64, len(pool))
+	for _, user := range pool {
+		keys[user.Username] = -math.Log(1-g.random()) / float64(max(1, user.IntGrade))
+	}
+
+	slices.SortFunc(pool, func(a, b models.User) int {
+		return cmp.Compare(keys[a.Username], keys[b.Username])
+	})
+	return pool
+}
+
+func (gradeWeighted) Booked(models.User) {}
@@ -0,0 +1,85 @@
+package scheduling
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func usernames(pool []models.User) []string {
+	names := make([]string, 0, len(pool))
+	for _, user := range pool {
+		names = append(names, user.Username)
+	}
+	return names
+}
+
+func TestNewStrategy(t *testing.T) {
+	for _, name := range []string{"", StrategyLeastLoaded, StrategyRoundRobin, StrategyGradeWeighted} {
+		strategy, err := NewStrategy(name)
+		require.NoError(t, err, name)
+		require.NotNil(t, strategy, name)
+	}
+
+	_, err := NewStrategy("first_come")
+	require.Error(t, err)
+}
+
+func Test_leastLoaded(t *testing.T) {
+	day := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC).UnixMilli() // wednesday
+	hour := time.Hour.Milliseconds()
+	week := 7 * 24 * hour
+
+	meet := models.Meeting{day, day + hour}
+	pool := []models.User{
+		{Username: "busy", Assigned: []models.Meeting{{day - 2*hour, day - hour}, {day + 2*hour, day + 3*hour}}},
+		{Username: "old", Assigned: []models.Meeting{{day - week, day - week + hour}, {day + week, day + week + hour}}},
+		{Username: "once", Assigned: []models.Meeting{{day - 2*hour, day - hour}}},
+		{Username: "free"},
+	}
+
+	ranked := leastLoaded{}.Rank(pool, meet)
+	require.Equal(t, []string{"free", "old", "once", "busy"}, usernames(ranked))
+}
+
+func Test_roundRobin(t *testing.T) {
+	r := &roundRobin{}
+	pool := func() []models.User {
+		return []models.User{{Username: "carol"}, {Username: "alice"}, {Username: "bob"}}
+	}
+
+	require.Equal(t, []string{"alice", "bob", "carol"}, usernames(r.Rank(pool(), models.Meeting{})))
+
+	r.Booked(models.User{Username: "alice"})
+	require.Equal(t, []string{"bob", "carol", "alice"}, usernames(r.Rank(pool(), models.Meeting{})))
+
+	r.Booked(models.User{Username: "carol"})
+	require.Equal(t, []string{"alice", "bob", "carol"}, usernames(r.Rank(pool(), models.Meeting{})))
+
+	// the last one may have left the pool
+	r.Booked(models.User{Username: "bill"})
+	require.Equal(t, []string{"bob", "carol", "alice"}, usernames(r.Rank(pool(), models.Meeting{})))
+}
+
+func Test_gradeWeighted(t *testing.T) {
+	pool := func() []models.User {
+		return []models.User{{Username: "junior", IntGrade: 1}, {Username: "staff", IntGrade: 4}}
+	}
+
+	// the same random value gives a staff interviewer a smaller key
+	same := gradeWeighted{random: func() float64 { return 0.5 }}
+	require.Equal(t, []string{"staff", "junior"}, usernames(same.Rank(pool(), models.Meeting{})))
+
+	first := 0
+	g := gradeWeighted{random: rand.Float64}
+	for range 1000 {
+		if g.Rank(pool(), models.Meeting{})[0].Username == "staff" {
+			first++
+		}
+	}
+	require.InDelta(t, 800, first, 80, "staff is first with probability 4/5")
+}
@@ -0,0 +1,114 @@
+package scheduling
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+var ErrVacancyNotFound = errors.Error("vacancy not found")
+
+// InterviewerFilter returns restrictions on interviewers of the interview: ones of its vacancy
+// and the minimum grade of the interview itself, whichever is higher. Skills of the interview,
+// if set, replace the vacancy ones. Free-form vacancies without settings allow any interviewer.
+func (s Scheduler) InterviewerFilter(ctx context.Context, interview *models.Interview) (models.InterviewerFilter, error) {
+	var filter models.InterviewerFilter
+	if interview.Vacancy != "" {
+		found, err := s.repo.Vacancies().Get(ctx, interview.Vacancy)
+		if err != nil {
+			return models.InterviewerFilter{}, errors.WrapFail(err, "find vacancy")
+		}
+		filter = found.InterviewerFilter()
+	}
+
+	filter.MinGrade = max(filter.MinGrade, interview.MinGrade)
+	if len(interview.Skills) > 0 {
+		filter.Skills = interview.Skills
+	}
+	return filter, nil
+}
+
+// DefaultDuration returns duration of the interview for the vacancy created without explicit one
+func (s Scheduler) DefaultDuration(ctx context.Context, vacancy string) (time.Duration, error) {
+	found, err := s.repo.Vacancies().Get(ctx, vacancy)
+	if err != nil {
+		return 0, errors.WrapFail(err, "find vacancy")
+	}
+
+	return s.stageDuration(vacancy, found, 0), nil
+}
+
+// stageDuration returns default duration of the stage with index i, settings of a known vacancy
+// override the configured ones
+func (s Scheduler) stageDuration(id string, vacancy *models.Vacancy, i int) time.Duration {
+	if vacancy != nil {
+		if d := vacancy.StageDuration(i); d > 0 {
+			return d
+		}
+	}
+
+	if d := s.interviews.Durations[id]; d > 0 {
+		return d
+	}
+
+	if s.interviews.DefaultDuration > 0 {
+		return s.interviews.DefaultDuration
+	}
+
+	return models.DefaultInterviewDuration
+}
+
+// CreateInterview registers an interview for the vacancy, zero duration is replaced with
+// DefaultDuration. If the vacancy is known, its meeting link is set.
+func (s Scheduler) CreateInterview(
+	ctx context.Context,
+	vacancy string,
+	candidate string,
+	duration time.Duration,
+) (string, error) {
+	found, err := s.repo.Vacancies().Get(ctx, vacancy)
+	if err != nil {
+		return "", errors.WrapFail(err, "find vacancy")
+	}
+
+	if found == nil {
+		if duration <= 0 {
+			duration = s.stageDuration(vacancy, nil, 0)
+		}
+
+		id, err := s.repo.Interviews().Create(ctx, vacancy, candidate, duration)
+		return id, errors.WrapFail(err, "do Interviews.Create request")
+	}
+
+	return s.createStageInterview(ctx, *found, 0, candidate, duration)
+}
+
+// createStageInterview creates interview for the stage with index i of the vacancy,
+// zero duration is taken from the stage settings or the configured defaults
+func (s Scheduler) createStageInterview(
+	ctx context.Context,
+	vacancy models.Vacancy,
+	i int,
+	candidate string,
+	duration time.Duration,
+) (string, error) {
+	if duration <= 0 {
+		duration = s.stageDuration(vacancy.ID, &vacancy, i)
+	}
+
+	id, err := s.repo.Interviews().Create(ctx, vacancy.ID, candidate, duration)
+	if err != nil {
+		return "", errors.WrapFail(err, "do Interviews.Create request")
+	}
+
+	if vacancy.Zoom != "" {
+		err = s.repo.Interviews().Update(ctx, id, nil, nil, nil, &vacancy.Zoom, nil, nil, nil)
+		if err != nil {
+			return "", errors.WrapFail(err, "set default zoom link")
+		}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,355 @@
+package telegram
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// parseWorkingHours reads lines like "пн 10:00-13:00 14:00-18:00",
+// weekdays are names of days starting from sunday.
+func parseWorkingHours(text string, weekdays []string) ([]models.WorkingHours, error) {
+	var parsed []models.WorkingHours
+
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(strings.ToLower(line))
+		if len(fields) == 0 {
+			continue
+		}
+
+		day := slices.IndexFunc(weekdays, func(name string) bool {
+			return strings.EqualFold(name, fields[0])
+		})
+		if day == -1 {
+			return nil, errors.Error("unknown weekday \"%s\"", fields[0])
+		}
+
+		if len(fields) == 1 {
+			return nil, errors.Error("no intervals for \"%s\"", fields[0])
+		}
+
+		for _, interval := range fields[1:] {
+			first, last, ok := strings.Cut(interval, "-")
+			if !ok {
+				return nil, errors.Error("bad interval \"%s\"", interval)
+			}
+
+			from, err := parseClock(first)
+			if err != nil {
+				return nil, errors.WrapFail(err, "parse interval start")
+			}
+
+			to, err := parseClock(last)
+			if err != nil {
+				return nil, errors.WrapFail(err, "parse interval end")
+			}
+
+			parsed = append(parsed, models.WorkingHours{
+				Weekday: time.Weekday(day),
+				From:    from,
+				To:      to,
+			})
+		}
+	}
+
+	err := models.Availability{Weekly: parsed}.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// parseClock reads "ЧЧ:ММ" as minutes since midnight, "24:00" is allowed.
+func parseClock(s string) (int, error) {
+	var h, m int
+	_, err := fmt.Sscanf(s, "%d:%d", &h, &m)
+	if err != nil {
+		return 0, errors.WrapFail(err, "scan clock")
+	}
+
+	if h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, errors.Error("bad clock \"%s\"", s)
+	}
+
+	return h*60 + m, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// availabilityVars returns data of "availability" message, working hours are shown
+// in the zone they are checked in and vacations in the zone of the sender
+func (b *Bot) availabilityVars(c telebot.Context, user *models.User) vars {
+	var weekly, vacations []vars
+
+	if a := user.Availability; a != nil {
+		sorted := slices.Clone(a.Weekly)
+		slices.SortFunc(sorted, func(x, y models.WorkingHours) int {
+			// monday goes first
+			dx, dy := (x.Weekday+6)%7, (y.Weekday+6)%7
+			if dx != dy {
+				return int(dx - dy)
+			}
+			return x.From - y.From
+		})
+
+		weekdays := b.words(b.locale(c), "weekdays", 7)
+		for _, w := range sorted {
+			weekly = append(weekly, vars{
+				"Day":  weekdays[w.Weekday],
+				"From": formatClock(w.From),
+				"To":   formatClock(w.To),
+			})
+		}
+
+		for _, e := range a.Exceptions {
+			vacations = append(vacations, vars{
+				"First": toUserTime(e[0], b.zone(c)).Format("02.01.2006"),
+				"Last":  toUserTime(e[1]-1, b.zone(c)).Format("02.01.2006"),
+			})
+		}
+	}
+
+	return vars{
+		"Zone":      user.Location().String(),
+		"Weekly":    weekly,
+		"Vacations": vacations,
+		"PerDay":    user.Limits.PerDay,
+		"PerWeek":   user.Limits.PerWeek,
+		"Before":    int(user.Limits.BufferBefore.Minutes()),
+		"After":     int(user.Limits.BufferAfter.Minutes()),
+	}
+}
+
+func (b *Bot) denyNotInterviewer(c telebot.Context, s fsm.Context) error {
+	return b.final(c, s, b.text(c, "deny.not_interviewer", nil))
+}
+
+func (b *Bot) getInterviewer(c telebot.Context) (*models.User, error) {
+	sender := c.Sender()
+	if sender == nil {
+		return nil, errors.Fail("get sender")
+	}
+
+	user, err := b.repo.Users().Get(b.ctx, sender.Username)
+	if err != nil {
+		return nil, errors.WrapFail(err, "get user")
+	}
+
+	if user == nil || user.IntGrade == models.GradeNotInterviewer {
+		return nil, nil
+	}
+
+	return user, nil
+}
+
+func (b *Bot) showAvailability(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	return b.final(c, s, b.text(c, "availability", b.availabilityVars(c, user)))
+}
+
+func (b *Bot) runSetWorkingHours(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	b.setState(s, setHoursReadState)
+	return c.Send(b.text(c, "hours.ask", nil))
+}
+
+func (b *Bot) setWorkingHours(c telebot.Context, s fsm.Context) error {
+	var weekly []models.WorkingHours
+	if strings.TrimSpace(c.Text()) != "-" {
+		var err error
+		weekly, err = parseWorkingHours(c.Text(), b.words(b.locale(c), "weekdays", 7))
+		if err != nil {
+			b.log.Debug(err)
+			return c.Send(b.text(c, "bad_format", nil))
+		}
+	}
+
+	return b.updateAvailability(c, s, func(a *models.Availability) {
+		a.Weekly = weekly
+	})
+}
+
+func (b *Bot) runSetLimits(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	b.setState(s, setLimitsReadState)
+	return c.Send(b.text(c, "limits.ask", nil))
+}
+
+func (b *Bot) setLimits(c telebot.Context, s fsm.Context) error {
+	var limits models.Limits
+	if strings.TrimSpace(c.Text()) != "-" {
+		var ok bool
+		limits, ok = parseLimits(c.Text())
+		if !ok {
+			return c.Send(b.text(c, "bad_format", nil))
+		}
+	}
+
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	updated, err := b.repo.Users().SetLimits(b.ctx, sender.Username, limits)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Users.SetLimits request"))
+	}
+	if updated == nil {
+		return b.final(c, s, b.text(c, "user.unknown", nil))
+	}
+
+	return b.final(c, s, b.text(c, "availability.saved", b.availabilityVars(c, updated)))
+}
+
+// parseLimits reads "per_day per_week" optionally followed by "before after" buffers in minutes,
+// zero means no limit
+func parseLimits(text string) (models.Limits, bool) {
+	fields := strings.Fields(text)
+	if len(fields) != 2 && len(fields) != 4 {
+		return models.Limits{}, false
+	}
+
+	numbers := make([]int, 4)
+	for i, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return models.Limits{}, false
+		}
+		numbers[i] = number
+	}
+
+	limits := models.Limits{
+		PerDay:       numbers[0],
+		PerWeek:      numbers[1],
+		BufferBefore: time.Duration(numbers[2]) * time.Minute,
+		BufferAfter:  time.Duration(numbers[3]) * time.Minute,
+	}
+	return limits, limits.Validate() == nil
+}
+
+func (b *Bot) runAddVacation(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	b.setState(s, addVacationReadState)
+	return c.Send(
+		b.text(c, "vacation.ask_first", nil),
+		b.calendarMarkup(c, b.userToday(c), b.userToday(c)),
+	)
+}
+
+func (b *Bot) addVacationPickFirst(c telebot.Context, s fsm.Context, day time.Time) error {
+	err := s.Update("vacFrom", day)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with vacation start"))
+	}
+
+	b.setState(s, addVacationReadLastState)
+	return c.Send(b.text(c, "vacation.ask_last", nil), b.calendarMarkup(c, day, day))
+}
+
+func (b *Bot) addVacationPickLast(c telebot.Context, s fsm.Context, day time.Time) error {
+	var first time.Time
+	err := s.Get("vacFrom", &first)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get vacation start from state"))
+	}
+
+	return b.saveVacation(c, s, first, day.AddDate(0, 0, 1))
+}
+
+func (b *Bot) addVacation(c telebot.Context, s fsm.Context) error {
+	first, last, err := parseDateRange(c.Text())
+	if err != nil {
+		b.log.Debug(err)
+		return c.Send(b.text(c, "bad_date", nil))
+	}
+
+	return b.saveVacation(c, s, first, last)
+}
+
+func (b *Bot) saveVacation(c telebot.Context, s fsm.Context, first, last time.Time) error {
+	zone := b.zone(c)
+	vacation := models.Meeting{fromUserTime(first, zone).UnixMilli(), fromUserTime(last, zone).UnixMilli()}
+
+	return b.updateAvailability(c, s, func(a *models.Availability) {
+		a.Exceptions = append(a.Exceptions, vacation)
+	})
+}
+
+func (b *Bot) clearVacations(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	return b.updateAvailability(c, s, func(a *models.Availability) {
+		a.Exceptions = nil
+	})
+}
+
+func (b *Bot) updateAvailability(c telebot.Context, s fsm.Context, patch func(a *models.Availability)) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	var availability models.Availability
+	if user.Availability != nil {
+		availability = *user.Availability
+	}
+	patch(&availability)
+
+	updated, err := b.repo.Users().SetAvailability(b.ctx, user.Username, &availability)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Users.SetAvailability request"))
+	}
+	if updated == nil {
+		return b.final(c, s, b.text(c, "user.not_found", nil))
+	}
+
+	return b.final(c, s, b.text(c, "availability.saved", b.availabilityVars(c, updated)))
+}
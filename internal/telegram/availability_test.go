@@ -0,0 +1,99 @@
+package telegram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func Test_parseWorkingHours(t *testing.T) {
+	type testcase struct {
+		name    string
+		locale  string
+		text    string
+		want    []models.WorkingHours
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{
+			name: "one day",
+			text: "пн 10:00-18:00",
+			want: []models.WorkingHours{{Weekday: time.Monday, From: 600, To: 1080}},
+		},
+		{
+			name: "many days and intervals",
+			text: "Пн 10:00-13:00 14:00-18:30\n\nвс 0:00-24:00",
+			want: []models.WorkingHours{
+				{Weekday: time.Monday, From: 600, To: 780},
+				{Weekday: time.Monday, From: 840, To: 1110},
+				{Weekday: time.Sunday, From: 0, To: 1440},
+			},
+		},
+		{
+			name:   "english weekdays",
+			locale: "en",
+			text:   "Tue 9:00-17:00",
+			want:   []models.WorkingHours{{Weekday: time.Tuesday, From: 540, To: 1020}},
+		},
+		{name: "unknown day", text: "mo 10:00-18:00", wantErr: true},
+		{name: "weekday of another locale", locale: "en", text: "пн 10:00-18:00", wantErr: true},
+		{name: "no intervals", text: "пн", wantErr: true},
+		{name: "bad interval", text: "пн 10:00", wantErr: true},
+		{name: "bad clock", text: "пн 10:00-18:60", wantErr: true},
+		{name: "reversed interval", text: "пн 18:00-10:00", wantErr: true},
+	}
+
+	messages := newTestMessages(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWorkingHours(tt.text, messages.Words(tt.locale, "weekdays"))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseLimits(t *testing.T) {
+	type testcase struct {
+		name   string
+		text   string
+		want   models.Limits
+		wantOk bool
+	}
+
+	tests := [...]testcase{
+		{name: "both", text: "2 8", want: models.Limits{PerDay: 2, PerWeek: 8}, wantOk: true},
+		{name: "week only", text: " 0  5 ", want: models.Limits{PerWeek: 5}, wantOk: true},
+		{
+			name:   "with buffers",
+			text:   "2 8 10 15",
+			want:   models.Limits{PerDay: 2, PerWeek: 8, BufferBefore: 10 * time.Minute, BufferAfter: 15 * time.Minute},
+			wantOk: true,
+		},
+		{name: "single number", text: "2"},
+		{name: "one buffer", text: "2 8 10"},
+		{name: "too long buffer", text: "2 8 0 180"},
+		{name: "negative", text: "-1 5"},
+		{name: "garbage", text: "два восемь"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseLimits(tt.text)
+			require.Equal(t, tt.wantOk, ok)
+			if ok {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
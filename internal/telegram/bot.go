@@ -7,61 +7,119 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/telebot.v3"
 
+	"github.com/nikmy/meowbot/internal/i18n"
+	"github.com/nikmy/meowbot/internal/notify"
 	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/errors"
 	"github.com/nikmy/meowbot/pkg/txn"
 )
 
-func New(log *zap.SugaredLogger, cfg Config, repoClient repo.Client) (*Bot, error) {
+func New(log *zap.SugaredLogger, cfg Config, repoClient repo.Client, sched scheduling.Scheduler) (*Bot, error) {
+	var (
+		poller  telebot.Poller = &telebot.LongPoller{Timeout: cfg.PollInterval}
+		webhook *webhookPoller
+	)
+
+	if cfg.Webhook.Enabled {
+		var err error
+		webhook, err = newWebhookPoller(cfg.Webhook)
+		if err != nil {
+			return nil, errors.WrapFail(err, "init webhook")
+		}
+		poller = webhook
+	}
+
 	b, err := telebot.NewBot(telebot.Settings{
+		URL:     cfg.APIURL,
 		Token:   cfg.Token,
 		Updates: 256,
-		Poller: &telebot.LongPoller{
-			Timeout: cfg.PollInterval,
-		},
+		Poller:  poller,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	bot := &Bot{
-		bot:  b,
-		log:  log.Named("bot"),
-		repo: repoClient,
-		time: stdTime{
-			zoneName: cfg.TimeZoneConfig.Name,
-			utcDiff:  cfg.UTCDiff,
-		},
-		txm: txn.NewManager(repoClient),
+		bot:     b,
+		webhook: webhook,
+		log:     log.Named("bot"),
+		repo:    repoClient,
+		time:    stdTime{},
+		txm:     txn.NewManager(repoClient),
+		sched:   sched,
+	}
+
+	bot.messages, err = i18n.New(cfg.Messages)
+	if err != nil {
+		return nil, errors.WrapFail(err, "init messages")
 	}
 
+	bot.channels, err = newChannels(b, cfg.Channels)
+	if err != nil {
+		return nil, errors.WrapFail(err, "init notification channels")
+	}
+	bot.outbox = newDispatcher(bot.log.Named("outbox"), cfg.OutboxConfig, repoClient.Outbox(), bot.channels)
+
 	bot.applyNotifications(cfg)
+	bot.applySlots(cfg)
+	bot.applyScorecards(cfg)
 
 	return bot, nil
 }
 
 type Bot struct {
-	bot *telebot.Bot
+	bot     *telebot.Bot
+	webhook *webhookPoller
 
 	ctx context.Context
 	log *zap.SugaredLogger
 
-	txm  txn.Manager
-	repo repo.Client
+	txm   txn.Manager
+	repo  repo.Client
+	sched scheduling.Scheduler
+
+	messages *i18n.Catalog
+
+	outbox   *dispatcher
+	channels map[models.NotificationChannel]notify.Channel
 
 	notifyBefore []int64
 	notifyPeriod time.Duration
 
+	slots      SlotsConfig
+	scorecards ScorecardsConfig
+
 	time timeProvider
 }
 
 func (b *Bot) Run(ctx context.Context) error {
 	b.ctx = ctx
 	b.setupHandlers()
+
+	err := b.registerUpdates()
+	if err != nil {
+		return err
+	}
+
 	go b.bot.Start()
+	go b.outbox.run(ctx)
 	b.runNotifier()
+	go b.watchEnded()
 	return nil
 }
 
+// registerUpdates sets webhook in Telegram or removes it,
+// because getUpdates does not work while webhook is set.
+func (b *Bot) registerUpdates() error {
+	if b.webhook != nil {
+		return b.webhook.register(b.bot)
+	}
+
+	return errors.WrapFail(b.bot.RemoveWebhook(), "remove webhook")
+}
+
 func (b *Bot) Stop() {
 	b.bot.Stop()
 }
@@ -0,0 +1,207 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// Inline keyboards for picking dates and times. Keyboards are bound to the
+// same fsm states as text input, so user can either press a button or type.
+
+var (
+	calendarBtn = &telebot.Btn{Unique: "cal"}
+	timeGridBtn = &telebot.Btn{Unique: "tgrid"}
+)
+
+const (
+	calendarNavigate = "nav"
+	calendarPick     = "day"
+	calendarIgnore   = "-"
+
+	calendarMonthLayout = "2006-01"
+
+	timeGridColumns = 4
+)
+
+// calendarNames are localized names of months and weekdays starting from monday
+type calendarNames struct {
+	months   []string
+	weekdays []string
+}
+
+// calendarMarkup renders month grid in the locale of the sender
+func (b *Bot) calendarMarkup(c telebot.Context, month time.Time, notBefore time.Time) *telebot.ReplyMarkup {
+	locale := b.locale(c)
+	return calendarMarkup(month, notBefore, calendarNames{
+		months:   b.words(locale, "calendar.months", 12),
+		weekdays: b.words(locale, "calendar.weekdays", 7),
+	})
+}
+
+// calendarMarkup renders month grid, days before notBefore can't be picked.
+// Both dates are in user's wall clock.
+func calendarMarkup(month time.Time, notBefore time.Time, names calendarNames) *telebot.ReplyMarkup {
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	notBefore = time.Date(notBefore.Year(), notBefore.Month(), notBefore.Day(), 0, 0, 0, 0, time.UTC)
+
+	ignore := func(text string) telebot.InlineButton {
+		return button(calendarBtn, text, calendarIgnore)
+	}
+
+	prev := ignore(" ")
+	if first.After(notBefore) {
+		prev = button(calendarBtn, "«", calendarNavigate+"|"+first.AddDate(0, -1, 0).Format(calendarMonthLayout))
+	}
+	next := button(calendarBtn, "»", calendarNavigate+"|"+first.AddDate(0, 1, 0).Format(calendarMonthLayout))
+
+	rows := [][]telebot.InlineButton{
+		{prev, ignore(names.months[first.Month()-1] + " " + strconv.Itoa(first.Year())), next},
+	}
+
+	header := make([]telebot.InlineButton, 0, len(names.weekdays))
+	for _, d := range names.weekdays {
+		header = append(header, ignore(d))
+	}
+	rows = append(rows, header)
+
+	// monday-based offset of the first day
+	offset := (int(first.Weekday()) + 6) % 7
+
+	week := make([]telebot.InlineButton, 0, 7)
+	for i := 0; i < offset; i++ {
+		week = append(week, ignore(" "))
+	}
+
+	for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+		if day.Before(notBefore) {
+			week = append(week, ignore("·"))
+		} else {
+			week = append(week, button(
+				calendarBtn,
+				strconv.Itoa(day.Day()),
+				calendarPick+"|"+day.Format(time.DateOnly),
+			))
+		}
+
+		if len(week) == 7 {
+			rows = append(rows, week)
+			week = make([]telebot.InlineButton, 0, 7)
+		}
+	}
+
+	if len(week) > 0 {
+		for len(week) < 7 {
+			week = append(week, ignore(" "))
+		}
+		rows = append(rows, week)
+	}
+
+	return &telebot.ReplyMarkup{InlineKeyboard: rows}
+}
+
+// timeGridOption is a button of time grid, Value is stored unix millis.
+type timeGridOption struct {
+	Text  string
+	Value int64
+}
+
+func timeGridMarkup(options []timeGridOption) *telebot.ReplyMarkup {
+	var rows [][]telebot.InlineButton
+
+	row := make([]telebot.InlineButton, 0, timeGridColumns)
+	for _, o := range options {
+		row = append(row, button(timeGridBtn, o.Text, strconv.FormatInt(o.Value, 10)))
+		if len(row) == timeGridColumns {
+			rows = append(rows, row)
+			row = make([]telebot.InlineButton, 0, timeGridColumns)
+		}
+	}
+
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	return &telebot.ReplyMarkup{InlineKeyboard: rows}
+}
+
+func button(kind *telebot.Btn, text string, data string) telebot.InlineButton {
+	return telebot.InlineButton{Unique: kind.Unique, Text: text, Data: data}
+}
+
+// userToday returns current date in wall clock of the sender.
+func (b *Bot) userToday(c telebot.Context) time.Time {
+	return b.time.Now().In(b.zone(c))
+}
+
+// onCalendar handles calendar callbacks and calls onPick with chosen date.
+func (b *Bot) onCalendar(onPick func(c telebot.Context, s fsm.Context, day time.Time) error) fsm.Handler {
+	return func(c telebot.Context, s fsm.Context) error {
+		cb := c.Callback()
+		if cb == nil {
+			return b.fail(c, s, errors.Fail("get callback"))
+		}
+
+		action, payload, _ := strings.Cut(cb.Data, "|")
+		switch action {
+		case calendarNavigate:
+			month, err := time.Parse(calendarMonthLayout, payload)
+			if err != nil {
+				return b.fail(c, s, errors.WrapFail(err, "parse calendar month"))
+			}
+
+			_, err = c.Bot().EditReplyMarkup(c.Message(), b.calendarMarkup(c, month, b.userToday(c)))
+			if err != nil {
+				b.log.Warn(errors.WrapFail(err, "edit calendar"))
+			}
+			return c.Respond()
+		case calendarPick:
+			day, err := time.Parse(time.DateOnly, payload)
+			if err != nil {
+				return b.fail(c, s, errors.WrapFail(err, "parse calendar day"))
+			}
+
+			b.closeKeyboard(c)
+			return onPick(c, s, day)
+		default:
+			return c.Respond()
+		}
+	}
+}
+
+// onTimeGrid handles time grid callbacks and calls onPick with chosen value.
+func (b *Bot) onTimeGrid(onPick func(c telebot.Context, s fsm.Context, value int64) error) fsm.Handler {
+	return func(c telebot.Context, s fsm.Context) error {
+		cb := c.Callback()
+		if cb == nil {
+			return b.fail(c, s, errors.Fail("get callback"))
+		}
+
+		value, err := strconv.ParseInt(cb.Data, 10, 64)
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "parse time grid value"))
+		}
+
+		b.closeKeyboard(c)
+		return onPick(c, s, value)
+	}
+}
+
+// closeKeyboard answers the callback and removes inline keyboard,
+// so the same button can't be pressed twice.
+func (b *Bot) closeKeyboard(c telebot.Context) {
+	err := c.Respond()
+	if err != nil {
+		b.log.Warn(errors.WrapFail(err, "respond to callback"))
+	}
+
+	_, err = c.Bot().EditReplyMarkup(c.Message(), nil)
+	if err != nil {
+		b.log.Warn(errors.WrapFail(err, "remove inline keyboard"))
+	}
+}
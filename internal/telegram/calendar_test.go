@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_calendarMarkup(t *testing.T) {
+	may := time.Date(2024, time.May, 20, 12, 0, 0, 0, time.UTC)
+
+	messages := newTestMessages(t)
+	names := calendarNames{
+		months:   messages.Words("ru", "calendar.months"),
+		weekdays: messages.Words("ru", "calendar.weekdays"),
+	}
+
+	t.Run("current month", func(t *testing.T) {
+		markup := calendarMarkup(may, time.Date(2024, time.May, 15, 23, 0, 0, 0, time.UTC), names)
+		rows := markup.InlineKeyboard
+
+		// navigation, weekdays and 5 weeks
+		require.Len(t, rows, 7)
+		require.Equal(t, "Май 2024", rows[0][1].Text)
+		require.Equal(t, "Пн", rows[1][0].Text)
+		require.Equal(t, calendarIgnore, rows[0][0].Data, "can't go to the past")
+		require.Equal(t, calendarNavigate+"|2024-06", rows[0][2].Data)
+
+		for _, week := range rows[2:] {
+			require.Len(t, week, 7)
+		}
+
+		// may 1 is wednesday
+		first := rows[2][2]
+		require.Equal(t, "·", first.Text)
+		require.Equal(t, calendarIgnore, first.Data)
+
+		// may 15 is wednesday too
+		available := rows[4][2]
+		require.Equal(t, "15", available.Text)
+		require.Equal(t, calendarPick+"|2024-05-15", available.Data)
+		require.Equal(t, calendarBtn.Unique, available.Unique)
+
+		last := rows[6][4]
+		require.Equal(t, "31", last.Text)
+		require.Equal(t, " ", rows[6][5].Text)
+	})
+
+	t.Run("future month", func(t *testing.T) {
+		markup := calendarMarkup(may.AddDate(0, 1, 0), may, names)
+		rows := markup.InlineKeyboard
+
+		require.Equal(t, calendarNavigate+"|2024-05", rows[0][0].Data)
+		require.Equal(t, calendarPick+"|2024-06-01", rows[2][5].Data)
+	})
+}
+
+func Test_timeGridMarkup(t *testing.T) {
+	options := make([]timeGridOption, 0, timeGridColumns+1)
+	for i := 0; i <= timeGridColumns; i++ {
+		options = append(options, timeGridOption{Text: "10:00", Value: int64(i)})
+	}
+
+	rows := timeGridMarkup(options).InlineKeyboard
+	require.Len(t, rows, 2)
+	require.Len(t, rows[0], timeGridColumns)
+	require.Len(t, rows[1], 1)
+	require.Equal(t, "4", rows[1][0].Data)
+	require.Equal(t, timeGridBtn.Unique, rows[1][0].Unique)
+}
@@ -0,0 +1,59 @@
+package telegram
+
+import (
+	"context"
+	"strconv"
+
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/notify"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// newChannels creates telegram channel and the configured additional ones
+func newChannels(bot *telebot.Bot, cfg ChannelsConfig) (map[models.NotificationChannel]notify.Channel, error) {
+	channels := map[models.NotificationChannel]notify.Channel{
+		models.ChannelTelegram: telegramChannel{bot: bot},
+	}
+
+	if cfg.Email.Addr != "" {
+		email, err := notify.NewSMTP(cfg.Email)
+		if err != nil {
+			return nil, errors.WrapFail(err, "init email channel")
+		}
+		channels[models.ChannelEmail] = email
+	}
+
+	if cfg.Webhook.URL != "" {
+		channels[models.ChannelWebhook] = notify.NewWebhook(cfg.Webhook)
+	}
+
+	return channels, nil
+}
+
+// telegramChannel sends messages to private chats by user ID
+type telegramChannel struct {
+	bot *telebot.Bot
+}
+
+func (t telegramChannel) Send(_ context.Context, address string, text string) error {
+	userID, err := strconv.ParseInt(address, 10, 64)
+	if err != nil || userID == 0 {
+		return notify.Permanent(errors.Error("invalid telegram user id %q", address))
+	}
+
+	_, err = t.bot.Send(models.User{Telegram: userID}, text, &telebot.SendOptions{ParseMode: telebot.ModeMarkdown})
+	for _, permanent := range [...]error{
+		telebot.ErrBlockedByUser,
+		telebot.ErrUserIsDeactivated,
+		telebot.ErrNotStartedByUser,
+		telebot.ErrChatNotFound,
+	} {
+		if errors.Is(err, permanent) {
+			return notify.Permanent(err)
+		}
+	}
+
+	return err
+}
@@ -1,16 +1,60 @@
 package telegram
 
-import "time"
+import (
+	"time"
+
+	"github.com/nikmy/meowbot/internal/i18n"
+	"github.com/nikmy/meowbot/internal/notify"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+)
 
 type Config struct {
 	BotConfig           `yaml:"bot"`
 	NotificationsConfig `yaml:"notifications"`
 	TimeZoneConfig      `yaml:"timeZone"`
+	SlotsConfig         `yaml:"slots"`
+	InterviewsConfig    `yaml:"interviews"`
+	OutboxConfig        `yaml:"outbox"`
+	ScorecardsConfig    `yaml:"scorecards"`
+	Channels            ChannelsConfig `yaml:"channels"`
+	Messages            i18n.Config    `yaml:"messages"`
 }
 
 type BotConfig struct {
 	Token        string        `yaml:"token"`
 	PollInterval time.Duration `yaml:"pollInterval"`
+
+	// APIURL overrides Telegram Bot API server, e.g. for a local one
+	APIURL string `yaml:"apiURL"`
+
+	Webhook WebhookConfig `yaml:"webhook"`
+}
+
+// WebhookConfig switches the bot from long polling to receiving updates via webhook
+type WebhookConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// PublicURL is registered in Telegram, ingress must route it to Path
+	PublicURL string `yaml:"publicURL"`
+	Path      string `yaml:"path"`
+
+	// SecretToken is sent by Telegram in every request, 1-256 characters A-Z, a-z, 0-9, _ and -
+	SecretToken string `yaml:"secretToken"`
+
+	// Listen is the address of dedicated listener,
+	// empty means the handler is mounted to the HR HTTP server
+	Listen string `yaml:"listen"`
+
+	// TLSCert and TLSKey make dedicated listener serve HTTPS, leave them empty behind a reverse proxy
+	TLSCert string `yaml:"tlsCert"`
+	TLSKey  string `yaml:"tlsKey"`
+
+	// Certificate is uploaded to Telegram if PublicURL uses a self-signed one
+	Certificate string `yaml:"certificate"`
+
+	MaxConnections     int  `yaml:"maxConnections"`
+	DropPendingUpdates bool `yaml:"dropPendingUpdates"`
 }
 
 type NotificationsConfig struct {
@@ -18,7 +62,72 @@ type NotificationsConfig struct {
 	NotifyPeriod time.Duration   `yaml:"notifyPeriod"`
 }
 
+// TimeZoneConfig sets time zone of users who have not chosen one
 type TimeZoneConfig struct {
+	// Default is IANA name like "Europe/Moscow", UTC if nothing is set
+	Default string `yaml:"default"`
+
+	// Name and UTCDiff describe a fixed zone used if Default is empty, they are left from
+	// older configs, where all users were in the same zone
 	Name    string        `yaml:"name"`
 	UTCDiff time.Duration `yaml:"utcDiff"`
 }
+
+// Location loads the default zone, it is set process-wide with models.SetDefaultLocation
+// before the repo, scheduling, the bot and HR API start
+func (cfg TimeZoneConfig) Location() (*time.Location, error) {
+	if cfg.Default != "" || cfg.Name == "" && cfg.UTCDiff == 0 {
+		return models.LoadLocation(cfg.Default)
+	}
+
+	return time.FixedZone(cfg.Name, int(cfg.UTCDiff.Seconds())), nil
+}
+
+type SlotsConfig struct {
+	Count    int           `yaml:"count"`
+	Step     time.Duration `yaml:"step"`
+	MaxRange time.Duration `yaml:"maxRange"`
+}
+
+// InterviewsConfig is read for configs written before it moved to the Scheduling section
+type InterviewsConfig = scheduling.InterviewsConfig
+
+// OutboxConfig controls delivery of messages enqueued by the bot, zero values are replaced with defaults
+type OutboxConfig struct {
+	// Period is how often pending messages are polled, 1s by default
+	Period time.Duration `yaml:"period"`
+
+	// BatchSize limits messages taken per poll, 32 by default
+	BatchSize int `yaml:"batchSize"`
+
+	// MaxAttempts is how many times delivery is tried before giving up, 10 by default
+	MaxAttempts int `yaml:"maxAttempts"`
+
+	// Backoff is delay after the first failed attempt, it doubles after each next one
+	// up to MaxBackoff, 5s and 10m by default
+	Backoff    time.Duration `yaml:"backoff"`
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+
+	// ClaimTimeout is how long the message is reserved by the replica sending it,
+	// it is sent again if the replica dies before marking it, 1m by default
+	ClaimTimeout time.Duration `yaml:"claimTimeout"`
+}
+
+// ScorecardsConfig describes feedback asked from interviewers when interviews end
+type ScorecardsConfig struct {
+	// Criteria are rated from 1 to MaxScore, 5 by default
+	Criteria []string `yaml:"criteria"`
+	MaxScore int      `yaml:"maxScore"`
+
+	// Period is how often ended interviews are looked for, 1m by default
+	Period time.Duration `yaml:"period"`
+
+	// OutcomeWindow is how long after the meeting participants may report its outcome, 24h by default
+	OutcomeWindow time.Duration `yaml:"outcomeWindow"`
+}
+
+// ChannelsConfig enables notification channels besides telegram, users choose them in preferences
+type ChannelsConfig struct {
+	Email   notify.SMTPConfig    `yaml:"email"`
+	Webhook notify.WebhookConfig `yaml:"webhook"`
+}
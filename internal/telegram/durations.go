@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// parseDuration reads either number of minutes or Go duration like "1h30m".
+func parseDuration(text string) (time.Duration, error) {
+	text = strings.TrimSpace(text)
+
+	minutes, err := strconv.Atoi(text)
+	if err != nil {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return 0, errors.WrapFail(err, "parse duration")
+		}
+		return validDuration(d)
+	}
+
+	return validDuration(time.Duration(minutes) * time.Minute)
+}
+
+func validDuration(d time.Duration) (time.Duration, error) {
+	err := models.ValidateDuration(d)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Truncate(time.Minute), nil
+}
@@ -0,0 +1,39 @@
+package telegram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseDuration(t *testing.T) {
+	type testcase struct {
+		name    string
+		text    string
+		want    time.Duration
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "minutes", text: "90", want: 90 * time.Minute},
+		{name: "go duration", text: " 1h30m ", want: 90 * time.Minute},
+		{name: "truncated to minutes", text: "30m15s", want: 30 * time.Minute},
+		{name: "zero", text: "0", wantErr: true},
+		{name: "too long", text: "25h", wantErr: true},
+		{name: "garbage", text: "полтора часа", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDuration(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package telegram
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const fsmStorageTimeout = 5 * time.Second
+
+// fsmStorage adapts models.DialogsRepo to fsm.Storage.
+type fsmStorage struct {
+	ctx     context.Context
+	dialogs models.DialogsRepo
+}
+
+func (f fsmStorage) GetState(chatId, userId int64) (fsm.State, error) {
+	ctx, cancel := context.WithTimeout(f.ctx, fsmStorageTimeout)
+	defer cancel()
+
+	state, err := f.dialogs.GetState(ctx, chatId, userId)
+	if err != nil {
+		return "", errors.WrapFail(err, "get dialog state")
+	}
+
+	return fsm.State(state), nil
+}
+
+func (f fsmStorage) SetState(chatId, userId int64, state fsm.State) error {
+	ctx, cancel := context.WithTimeout(f.ctx, fsmStorageTimeout)
+	defer cancel()
+
+	err := f.dialogs.SetState(ctx, chatId, userId, string(state))
+	return errors.WrapFail(err, "set dialog state")
+}
+
+func (f fsmStorage) ResetState(chatId, userId int64, withData bool) error {
+	ctx, cancel := context.WithTimeout(f.ctx, fsmStorageTimeout)
+	defer cancel()
+
+	err := f.dialogs.ResetState(ctx, chatId, userId, withData)
+	return errors.WrapFail(err, "reset dialog state")
+}
+
+func (f fsmStorage) UpdateData(chatId, userId int64, key string, data any) error {
+	ctx, cancel := context.WithTimeout(f.ctx, fsmStorageTimeout)
+	defer cancel()
+
+	err := f.dialogs.UpdateData(ctx, chatId, userId, key, data)
+	return errors.WrapFail(err, "update dialog data")
+}
+
+func (f fsmStorage) GetData(chatId, userId int64, key string, to any) error {
+	ctx, cancel := context.WithTimeout(f.ctx, fsmStorageTimeout)
+	defer cancel()
+
+	found, err := f.dialogs.GetData(ctx, chatId, userId, key, to)
+	if err != nil {
+		return errors.WrapFail(err, "get dialog data")
+	}
+
+	if !found {
+		return fsm.ErrNotFound
+	}
+
+	return nil
+}
+
+// Close does nothing, because repo client is closed by its owner
+func (f fsmStorage) Close() error {
+	return nil
+}
@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_fsmStorage(t *testing.T) {
+	const chat, user = int64(1), int64(2)
+
+	t.Run("get state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dMock := NewMockdialogsApi(ctrl)
+		dMock.EXPECT().GetState(gomock.Any(), chat, user).Return(string(matchReadIIDState), nil)
+
+		s := fsmStorage{ctx: context.Background(), dialogs: dMock}
+		state, err := s.GetState(chat, user)
+		require.NoError(t, err)
+		require.Equal(t, matchReadIIDState, state)
+	})
+
+	t.Run("get missing data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dMock := NewMockdialogsApi(ctrl)
+		dMock.EXPECT().GetData(gomock.Any(), chat, user, "iid", gomock.Any()).Return(false, nil)
+
+		s := fsmStorage{ctx: context.Background(), dialogs: dMock}
+
+		var iid string
+		err := s.GetData(chat, user, "iid", &iid)
+		require.ErrorIs(t, err, fsm.ErrNotFound)
+	})
+
+	t.Run("get data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dMock := NewMockdialogsApi(ctrl)
+		dMock.EXPECT().
+			GetData(gomock.Any(), chat, user, "iid", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ int64, _ string, to any) (bool, error) {
+				*to.(*string) = "42"
+				return true, nil
+			})
+
+		s := fsmStorage{ctx: context.Background(), dialogs: dMock}
+
+		var iid string
+		err := s.GetData(chat, user, "iid", &iid)
+		require.NoError(t, err)
+		require.Equal(t, "42", iid)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dMock := NewMockdialogsApi(ctrl)
+		dMock.EXPECT().ResetState(gomock.Any(), chat, user, true).Return(errors.New("mock"))
+
+		s := fsmStorage{ctx: context.Background(), dialogs: dMock}
+		require.Error(t, s.ResetState(chat, user, true))
+	})
+}
@@ -0,0 +1,77 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+func (b *Bot) runSetMinGrade(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, setMinGradeReadIIDState)
+	return c.Send(b.text(c, "interview.ask_id", nil))
+}
+
+func (b *Bot) setMinGradeReadIID(c telebot.Context, s fsm.Context) error {
+	iid := c.Text()
+
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview by id"))
+	}
+
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	b.setState(s, setMinGradeReadGradeState)
+	return c.Send(b.text(c, "min_grade.ask", vars{"Current": i.MinGrade, "Max": models.GradeStaff}))
+}
+
+func (b *Bot) setMinGrade(c telebot.Context, s fsm.Context) error {
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get iid from state"))
+	}
+
+	grade, ok := parseGrade(c.Text())
+	if !ok || models.ValidateMinGrade(grade) != nil {
+		return c.Send(b.text(c, "min_grade.bad", vars{"Max": models.GradeStaff}))
+	}
+
+	err = b.repo.Interviews().Update(b.ctx, iid, nil, nil, nil, nil, nil, &grade, nil)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update interview"))
+	}
+
+	return b.final(
+		c, s,
+		b.text(c, "min_grade.saved", vars{"ID": iid, "Grade": grade}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
+}
+
+// parseGrade reads a single number, callers check whether the grade is in range
+func parseGrade(text string) (int, bool) {
+	grade, err := strconv.Atoi(strings.TrimSpace(text))
+	return grade, err == nil
+}
@@ -0,0 +1,34 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGrade(t *testing.T) {
+	type testcase struct {
+		name   string
+		text   string
+		want   int
+		wantOk bool
+	}
+
+	tests := [...]testcase{
+		{name: "grade", text: " 3 ", want: 3, wantOk: true},
+		{name: "zero", text: "0", want: 0, wantOk: true},
+		{name: "two numbers", text: "1 2"},
+		{name: "empty", text: " "},
+		{name: "garbage", text: "senior"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseGrade(tt.text)
+			require.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
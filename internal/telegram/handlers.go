@@ -4,7 +4,6 @@ import (
 	"runtime/debug"
 
 	"github.com/vitaliy-ukiru/fsm-telebot"
-	"github.com/vitaliy-ukiru/fsm-telebot/storages/memory"
 	"gopkg.in/telebot.v3"
 
 	"github.com/nikmy/meowbot/internal/repo/models"
@@ -16,45 +15,68 @@ const (
 
 	matchReadIIDState      fsm.State = "matchReadIId"
 	matchReadIntervalState fsm.State = "matchReadInt"
+	matchReadSlotState     fsm.State = "matchReadSlot"
 
-	createReadInfoState fsm.State = "crReadInfo"
-	createReadCTgState  fsm.State = "crReadTg"
+	createReadInfoState     fsm.State = "crReadInfo"
+	createReadDurationState fsm.State = "crReadDur"
+	createReadCTgState      fsm.State = "crReadTg"
 
 	deleteReadIIDState fsm.State = "delReadIID"
 
 	cancelReadIIDState fsm.State = "cReadIID"
 
-	addIntReadTgState fsm.State = "addIReadTg"
-	delIntReadTgState fsm.State = "delIReadTg"
+	rescheduleReadIIDState fsm.State = "reschReadIID"
+
+	addIntReadTgState    fsm.State = "addIReadTg"
+	addIntReadGradeState fsm.State = "addIReadGrade"
+	delIntReadTgState    fsm.State = "delIReadTg"
 
 	addZoomReadIIDState  fsm.State = "addZoomReadIId"
 	addZoomReadLinkState fsm.State = "addZoomReadLink"
-)
 
-func usage(hr bool) string {
-	const common = "" +
-		"Доступные команды:\n" +
-		"/show_interviews — показать все мои собеседования\n" +
-		"/match — подобрать время для собеседования, где я - кандидат\n" +
-		"/cancel — отменить запланированное собеседование\n"
+	setHoursReadState        fsm.State = "setHoursRead"
+	addVacationReadState     fsm.State = "addVacRead"
+	addVacationReadLastState fsm.State = "addVacReadLast"
+	setLimitsReadState       fsm.State = "setLimitsRead"
 
-	if !hr {
-		return common
-	}
+	languageReadState fsm.State = "langRead"
+	timeZoneReadState fsm.State = "tzRead"
 
-	return common +
-		"/create — создать собеседование\n" +
-		"/delete — удалить собеседование\n" +
-		"/addInterviewer — добавить интервьюера\n" +
-		"/delInterviewer — удалить интервьюера\n" +
-		"/addZoom — добавить ссылку на встречу"
-}
+	scorecardReadIIDState     fsm.State = "scReadIID"
+	scorecardReadScoreState   fsm.State = "scReadScore"
+	scorecardReadHireState    fsm.State = "scReadHire"
+	scorecardReadCommentState fsm.State = "scReadComment"
+
+	outcomeReadIIDState    fsm.State = "outReadIID"
+	outcomeReadAnswerState fsm.State = "outReadAnswer"
+
+	setVacancyReadIDState    fsm.State = "setVacReadID"
+	setVacancyReadFieldState fsm.State = "setVacReadField"
+	delVacancyReadIDState    fsm.State = "delVacReadID"
+
+	applyReadVacancyState fsm.State = "applyReadVac"
+	applyReadCTgState     fsm.State = "applyReadTg"
+
+	setPanelReadIIDState  fsm.State = "setPanelReadIID"
+	setPanelReadSizeState fsm.State = "setPanelReadSize"
+
+	setMinGradeReadIIDState   fsm.State = "setMinGradeReadIID"
+	setMinGradeReadGradeState fsm.State = "setMinGradeReadGrade"
+
+	setSkillsReadTgState           fsm.State = "setSkillsReadTg"
+	setSkillsReadState             fsm.State = "setSkillsRead"
+	setInterviewSkillsReadIIDState fsm.State = "setISkillsReadIID"
+	setInterviewSkillsReadState    fsm.State = "setISkillsRead"
+)
 
 func (b *Bot) setupHandlers() {
+	// must be set before handlers are bound
+	b.bot.Use(b.detectUser)
+
 	manager := fsm.NewManager(
 		b.bot,
 		nil,
-		memory.NewStorage(),
+		fsmStorage{ctx: b.ctx, dialogs: b.repo.Dialogs()},
 		nil,
 	)
 
@@ -65,26 +87,97 @@ func (b *Bot) setupHandlers() {
 
 	manager.Bind("/match", initialState, b.panicHandler(b.runMatch))
 	manager.Bind(telebot.OnText, matchReadIIDState, b.panicHandler(b.matchReadIID))
-	manager.Bind(telebot.OnText, matchReadIntervalState, b.panicHandler(b.match))
+	manager.Bind(telebot.OnText, matchReadIntervalState, b.panicHandler(b.matchReadInterval))
+	manager.Bind(calendarBtn, matchReadIntervalState, b.panicHandler(b.onCalendar(b.matchPickDay)))
+	manager.Bind(telebot.OnText, matchReadSlotState, b.panicHandler(b.match))
+	manager.Bind(timeGridBtn, matchReadSlotState, b.panicHandler(b.onTimeGrid(b.matchPickSlot)))
 
 	manager.Bind("/cancel", initialState, b.panicHandler(b.runCancel))
 	manager.Bind(telebot.OnText, cancelReadIIDState, b.panicHandler(b.cancel))
 
+	manager.Bind("/reschedule", initialState, b.panicHandler(b.runReschedule))
+	manager.Bind(telebot.OnText, rescheduleReadIIDState, b.panicHandler(b.rescheduleReadIID))
+
 	manager.Bind("/create", initialState, b.panicHandler(b.runCreate))
 	manager.Bind(telebot.OnText, createReadInfoState, b.panicHandler(b.createReadInfo))
+	manager.Bind(vacancyBtn, createReadInfoState, b.panicHandler(b.createReadInfo))
+	manager.Bind(telebot.OnText, createReadDurationState, b.panicHandler(b.createReadDuration))
 	manager.Bind(telebot.OnText, createReadCTgState, b.panicHandler(b.create))
 
 	manager.Bind("/delete", initialState, b.panicHandler(b.runDelete))
 	manager.Bind(telebot.OnText, deleteReadIIDState, b.panicHandler(b.delete))
 
 	manager.Bind("/addInterviewer", initialState, b.panicHandler(b.runAddInterviewer))
-	manager.Bind(telebot.OnText, addIntReadTgState, b.panicHandler(b.addInterviewer))
+	manager.Bind(telebot.OnText, addIntReadTgState, b.panicHandler(b.addInterviewerReadTg))
+	manager.Bind(telebot.OnText, addIntReadGradeState, b.panicHandler(b.addInterviewer))
 	manager.Bind("/delInterviewer", initialState, b.panicHandler(b.runDelInterviewer))
 	manager.Bind(telebot.OnText, delIntReadTgState, b.panicHandler(b.delInterviewer))
 
 	manager.Bind("/addZoom", initialState, b.panicHandler(b.runAddZoom))
 	manager.Bind(telebot.OnText, addZoomReadIIDState, b.panicHandler(b.addZoomReadIID))
 	manager.Bind(telebot.OnText, addZoomReadLinkState, b.panicHandler(b.addZoom))
+
+	manager.Bind("/availability", initialState, b.panicHandler(b.showAvailability))
+	manager.Bind("/setWorkingHours", initialState, b.panicHandler(b.runSetWorkingHours))
+	manager.Bind(telebot.OnText, setHoursReadState, b.panicHandler(b.setWorkingHours))
+	manager.Bind("/addVacation", initialState, b.panicHandler(b.runAddVacation))
+	manager.Bind(telebot.OnText, addVacationReadState, b.panicHandler(b.addVacation))
+	manager.Bind(calendarBtn, addVacationReadState, b.panicHandler(b.onCalendar(b.addVacationPickFirst)))
+	manager.Bind(telebot.OnText, addVacationReadLastState, b.panicHandler(b.addVacation))
+	manager.Bind(calendarBtn, addVacationReadLastState, b.panicHandler(b.onCalendar(b.addVacationPickLast)))
+	manager.Bind("/clearVacations", initialState, b.panicHandler(b.clearVacations))
+	manager.Bind("/setLimits", initialState, b.panicHandler(b.runSetLimits))
+	manager.Bind(telebot.OnText, setLimitsReadState, b.panicHandler(b.setLimits))
+
+	manager.Bind("/language", initialState, b.panicHandler(b.runLanguage))
+	manager.Bind(telebot.OnText, languageReadState, b.panicHandler(b.setLanguage))
+	manager.Bind(languageBtn, languageReadState, b.panicHandler(b.setLanguage))
+
+	manager.Bind("/timezone", initialState, b.panicHandler(b.runTimeZone))
+	manager.Bind(telebot.OnText, timeZoneReadState, b.panicHandler(b.setTimeZone))
+
+	manager.Bind("/scorecard", initialState, b.panicHandler(b.runScorecard))
+	manager.Bind(telebot.OnText, scorecardReadIIDState, b.panicHandler(b.scorecardReadIID))
+	manager.Bind(telebot.OnText, scorecardReadScoreState, b.panicHandler(b.scorecardScore))
+	manager.Bind(scorecardBtn, scorecardReadScoreState, b.panicHandler(b.scorecardScore))
+	manager.Bind(telebot.OnText, scorecardReadHireState, b.panicHandler(b.scorecardHire))
+	manager.Bind(scorecardBtn, scorecardReadHireState, b.panicHandler(b.scorecardHire))
+	manager.Bind(telebot.OnText, scorecardReadCommentState, b.panicHandler(b.scorecardComment))
+
+	manager.Bind("/outcome", initialState, b.panicHandler(b.runOutcome))
+	manager.Bind(telebot.OnText, outcomeReadIIDState, b.panicHandler(b.outcomeReadIID))
+	manager.Bind(telebot.OnText, outcomeReadAnswerState, b.panicHandler(b.outcomeAnswer))
+	manager.Bind(outcomeBtn, outcomeReadAnswerState, b.panicHandler(b.outcomeAnswer))
+
+	manager.Bind("/vacancies", initialState, b.panicHandler(b.showVacancies))
+	manager.Bind("/setVacancy", initialState, b.panicHandler(b.runSetVacancy))
+	manager.Bind(telebot.OnText, setVacancyReadIDState, b.panicHandler(b.setVacancyReadID))
+	manager.Bind(vacancyBtn, setVacancyReadIDState, b.panicHandler(b.setVacancyReadID))
+	manager.Bind(telebot.OnText, setVacancyReadFieldState, b.panicHandler(b.setVacancyReadField))
+	manager.Bind("/delVacancy", initialState, b.panicHandler(b.runDelVacancy))
+	manager.Bind(telebot.OnText, delVacancyReadIDState, b.panicHandler(b.delVacancy))
+	manager.Bind(vacancyBtn, delVacancyReadIDState, b.panicHandler(b.delVacancy))
+
+	manager.Bind("/apply", initialState, b.panicHandler(b.runApply))
+	manager.Bind(telebot.OnText, applyReadVacancyState, b.panicHandler(b.applyReadVacancy))
+	manager.Bind(vacancyBtn, applyReadVacancyState, b.panicHandler(b.applyReadVacancy))
+	manager.Bind(telebot.OnText, applyReadCTgState, b.panicHandler(b.apply))
+	manager.Bind("/pipeline", fsm.AnyState, b.panicHandler(b.showPipeline))
+
+	manager.Bind("/setPanel", initialState, b.panicHandler(b.runSetPanel))
+	manager.Bind(telebot.OnText, setPanelReadIIDState, b.panicHandler(b.setPanelReadIID))
+	manager.Bind(telebot.OnText, setPanelReadSizeState, b.panicHandler(b.setPanel))
+
+	manager.Bind("/setMinGrade", initialState, b.panicHandler(b.runSetMinGrade))
+	manager.Bind(telebot.OnText, setMinGradeReadIIDState, b.panicHandler(b.setMinGradeReadIID))
+	manager.Bind(telebot.OnText, setMinGradeReadGradeState, b.panicHandler(b.setMinGrade))
+
+	manager.Bind("/setSkills", initialState, b.panicHandler(b.runSetSkills))
+	manager.Bind(telebot.OnText, setSkillsReadTgState, b.panicHandler(b.setSkillsReadTg))
+	manager.Bind(telebot.OnText, setSkillsReadState, b.panicHandler(b.setSkills))
+	manager.Bind("/setInterviewSkills", initialState, b.panicHandler(b.runSetInterviewSkills))
+	manager.Bind(telebot.OnText, setInterviewSkillsReadIIDState, b.panicHandler(b.setInterviewSkillsReadIID))
+	manager.Bind(telebot.OnText, setInterviewSkillsReadState, b.panicHandler(b.setInterviewSkills))
 }
 
 func (b *Bot) panicHandler(h fsm.Handler) fsm.Handler {
@@ -116,7 +209,7 @@ func (b *Bot) final(c telebot.Context, s fsm.Context, msg string, opts ...any) e
 
 func (b *Bot) fail(c telebot.Context, s fsm.Context, err error) error {
 	b.log.Error(err)
-	return b.final(c, s, "Что-то пошло не так")
+	return b.final(c, s, b.text(c, "fail", nil))
 }
 
 func (b *Bot) start(c telebot.Context, s fsm.Context) error {
@@ -128,11 +221,15 @@ func (b *Bot) start(c telebot.Context, s fsm.Context) error {
 	known, err := b.repo.Users().Upsert(b.ctx, sender.Username, &sender.ID, nil, nil)
 	if err != nil {
 		b.log.Error(errors.WrapFail(err, "upsert user on start"))
-		return b.final(
-			c, s,
-			"Ошибка. Если вы используете бота в первый раз, "+
-				"функционал может быть недоступен. Свяжитесь с поддержкой",
-		)
+		return b.final(c, s, b.text(c, "start.failed", nil))
+	}
+
+	// a new user has not been found by detectUser
+	if known == nil && sender.LanguageCode != "" {
+		_, err = b.repo.Users().SetLanguage(b.ctx, sender.Username, models.Language{Telegram: sender.LanguageCode})
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "save telegram language"))
+		}
 	}
 
 	err = b.repo.Interviews().FixTg(b.ctx, sender.Username, sender.ID)
@@ -141,5 +238,8 @@ func (b *Bot) start(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, initialState)
-	return c.Send(usage(known != nil && known.Category == models.HRUser))
+	return c.Send(b.text(c, "usage", vars{
+		"HR":          known != nil && known.Category == models.HRUser,
+		"Interviewer": known != nil && known.IntGrade > models.GradeNotInterviewer,
+	}))
 }
@@ -1,7 +1,7 @@
 package telegram
 
 import (
-	"fmt"
+	"strings"
 	"time"
 
 	"github.com/vitaliy-ukiru/fsm-telebot"
@@ -12,16 +12,16 @@ import (
 	"github.com/nikmy/meowbot/pkg/txn"
 )
 
-func (b *Bot) readTg(c telebot.Context) (string, string) {
+func (b *Bot) readTg(c telebot.Context) (string, bool) {
 	tg := c.Text()
 	if len(tg) < 2 || tg[0] != '@' {
-		return "", "Некорректный telegram"
+		return "", false
 	}
-	return tg[1:], ""
+	return tg[1:], true
 }
 
 func (b *Bot) denyNotHR(c telebot.Context, s fsm.Context) error {
-	return b.final(c, s, "Это может сделать только HR сотрудник")
+	return b.final(c, s, b.text(c, "deny.not_hr", nil))
 }
 
 func (b *Bot) checkHR(username string) bool {
@@ -31,7 +31,7 @@ func (b *Bot) checkHR(username string) bool {
 		return false
 	}
 
-	return user.Category >= models.HRUser
+	return user != nil && user.Category >= models.HRUser
 }
 
 func (b *Bot) runCreate(c telebot.Context, s fsm.Context) error {
@@ -46,19 +46,48 @@ func (b *Bot) runCreate(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, createReadInfoState)
-	return c.Send("Введите название вакансии")
+	err := b.askVacancy(c, "create.ask_vacancy")
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	return nil
 }
 
 func (b *Bot) createReadInfo(c telebot.Context, s fsm.Context) error {
-	vac := c.Text()
+	vac := b.vacancyChoice(c)
 
 	err := s.Update("vac", vac)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "update state with vac"))
 	}
 
+	b.setState(s, createReadDurationState)
+	return c.Send(b.text(c, "create.ask_duration", vars{"Default": b.defaultDuration(vac)}))
+}
+
+func (b *Bot) createReadDuration(c telebot.Context, s fsm.Context) error {
+	var vac string
+	err := s.Get("vac", &vac)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get vacancy from state"))
+	}
+
+	duration := b.defaultDuration(vac)
+	if strings.TrimSpace(c.Text()) != "-" {
+		duration, err = parseDuration(c.Text())
+		if err != nil {
+			b.log.Debug(err)
+			return c.Send(b.text(c, "bad_format", nil))
+		}
+	}
+
+	err = s.Update("duration", duration)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with duration"))
+	}
+
 	b.setState(s, createReadCTgState)
-	return c.Send("Введите telegram кандидата")
+	return c.Send(b.text(c, "create.ask_candidate", nil))
 }
 
 func (b *Bot) create(c telebot.Context, s fsm.Context) error {
@@ -68,9 +97,15 @@ func (b *Bot) create(c telebot.Context, s fsm.Context) error {
 		return b.fail(c, s, errors.WrapFail(err, "get vacancy from state"))
 	}
 
-	tg, msg := b.readTg(c)
-	if msg != "" {
-		return b.final(c, s, msg)
+	var duration time.Duration
+	err = s.Get("duration", &duration)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get duration from state"))
+	}
+
+	tg, ok := b.readTg(c)
+	if !ok {
+		return b.final(c, s, b.text(c, "bad_tg", nil))
 	}
 
 	sender := c.Sender()
@@ -83,17 +118,17 @@ func (b *Bot) create(c telebot.Context, s fsm.Context) error {
 		return b.fail(c, s, errors.WrapFail(err, "upsert user"))
 	}
 
-	id, err := b.repo.Interviews().Create(b.ctx, vac, tg)
+	id, err := b.sched.CreateInterview(b.ctx, vac, tg, duration)
 	if err != nil {
-		b.log.Error(err)
 		return b.fail(c, s, errors.WrapFail(err, "create interview"))
 	}
 
-	if known != nil && known.Telegram != 0 {
-		err = b.notify(known.Telegram, fmt.Sprintf(
-			"Для вас создано новое собеседование на должность %s, id —`%s`.\nИспользуйте /match, чтобы подобрать удобное время",
-			vac, id,
-		))
+	if known != nil {
+		err = b.notify(b.ctx, known.Username, known.Telegram, message{"interview.created", vars{
+			"ID":       id,
+			"Vacancy":  vac,
+			"Duration": duration,
+		}})
 		if err != nil {
 			b.log.Warn(errors.WrapFail(err, "notify candidate about new interview"))
 		}
@@ -101,7 +136,7 @@ func (b *Bot) create(c telebot.Context, s fsm.Context) error {
 
 	return b.final(
 		c, s,
-		fmt.Sprintf("Создано собеседование с id `%s`", id),
+		b.text(c, "create.done", vars{"ID": id}),
 		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
 	)
 }
@@ -118,7 +153,7 @@ func (b *Bot) runDelete(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, deleteReadIIDState)
-	return c.Send("Введите ID собеседования")
+	return c.Send(b.text(c, "interview.ask_id", nil))
 }
 
 func (b *Bot) delete(c telebot.Context, s fsm.Context) error {
@@ -130,15 +165,6 @@ func (b *Bot) delete(c telebot.Context, s fsm.Context) error {
 	}
 	defer cancel()
 
-	found, err := b.repo.Interviews().Delete(b.ctx, iid)
-	if err != nil {
-		return b.fail(c, s, errors.WrapFail(err, "find interview by id"))
-	}
-
-	if found == nil {
-		return b.final(c, s, "Такого собеседования нет")
-	}
-
 	tx, err := txn.Start(ctx)
 	if err != nil {
 		b.log.Error(errors.WrapFail(err, "start txn"))
@@ -150,36 +176,28 @@ func (b *Bot) delete(c telebot.Context, s fsm.Context) error {
 		}
 	}()
 
-	cancelled, err := b.cancelInterview(ctx, found, models.RoleHR)
+	found, cancelled, err := b.sched.DeleteInterview(ctx, iid)
 	if err != nil {
-		return errors.WrapFail(err, "cancel interview")
+		return b.fail(c, s, errors.WrapFail(err, "delete interview"))
 	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "commit txn"))
-	}
-
-	if !cancelled {
-		return b.final(c, s, "Собеседование удалено")
-	}
-
-	msg := fmt.Sprintf("Интервью `%s` на должность \"%s\" удалено", found.ID, found.Vacancy)
-	err = b.notify(found.CandidateTg, msg)
-	if err != nil {
-		b.log.Warn(errors.WrapFail(err, "notify candidate about deletion"))
+	if found == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
-	if found.InterviewerTg == 0 {
-		return b.final(c, s, "Собеседование удалено")
+	if cancelled {
+		err = b.NotifyDeleted(ctx, found)
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "notify about deletion"))
+		}
 	}
 
-	err = b.notify(found.InterviewerTg, msg)
+	err = tx.Commit(ctx)
 	if err != nil {
-		b.log.Warn(errors.WrapFail(err, "notify candidate about deletion"))
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
 	}
 
-	return b.final(c, s, "Собеседование удалено")
+	return b.final(c, s, b.text(c, "delete.done", nil))
 }
 
 func (b *Bot) runAddInterviewer(c telebot.Context, s fsm.Context) error {
@@ -194,27 +212,47 @@ func (b *Bot) runAddInterviewer(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, addIntReadTgState)
-	return c.Send("Введите telegram будущего интервьюера")
+	return c.Send(b.text(c, "add_interviewer.ask", nil))
+}
+
+func (b *Bot) addInterviewerReadTg(c telebot.Context, s fsm.Context) error {
+	tg, ok := b.readTg(c)
+	if !ok {
+		return b.final(c, s, b.text(c, "bad_tg", nil))
+	}
+
+	err := s.Update("tg", tg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with tg"))
+	}
+
+	b.setState(s, addIntReadGradeState)
+	return c.Send(b.text(c, "add_interviewer.ask_grade", vars{"Min": models.GradeJunior, "Max": models.GradeStaff}))
 }
 
 func (b *Bot) addInterviewer(c telebot.Context, s fsm.Context) error {
-	tg, msg := b.readTg(c)
-	if msg != "" {
-		return b.final(c, s, msg)
+	var tg string
+	err := s.Get("tg", &tg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get tg from state"))
 	}
 
-	grade := 1
+	grade, ok := parseGrade(c.Text())
+	if !ok || models.ValidateGrade(grade) != nil {
+		return c.Send(b.text(c, "add_interviewer.bad_grade", vars{"Min": models.GradeJunior, "Max": models.GradeStaff}))
+	}
 
 	old, err := b.repo.Users().Upsert(b.ctx, tg, nil, nil, &grade)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "do Users.Upsert"))
 	}
 
-	if old.IntGrade > models.GradeNotInterviewer {
-		return b.final(c, s, fmt.Sprintf("@%s уже интервьюер", old.Username))
+	// old is nil for a just created user
+	if old != nil && old.IntGrade == grade {
+		return b.final(c, s, b.text(c, "add_interviewer.already", vars{"Username": tg, "Grade": grade}))
 	}
 
-	return b.final(c, s, fmt.Sprintf("Теперь @%s — интервьюер", old.Username))
+	return b.final(c, s, b.text(c, "add_interviewer.done", vars{"Username": tg, "Grade": grade}))
 }
 
 func (b *Bot) runDelInterviewer(c telebot.Context, s fsm.Context) error {
@@ -229,17 +267,15 @@ func (b *Bot) runDelInterviewer(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, delIntReadTgState)
-	return c.Send("Введите telegram интервьюера")
+	return c.Send(b.text(c, "del_interviewer.ask", nil))
 }
 
 func (b *Bot) delInterviewer(c telebot.Context, s fsm.Context) error {
-	tg, msg := b.readTg(c)
-	if msg != "" {
-		return b.final(c, s, msg)
+	tg, ok := b.readTg(c)
+	if !ok {
+		return b.final(c, s, b.text(c, "bad_tg", nil))
 	}
 
-	gradeDown := 0
-
 	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*5)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "init session context"))
@@ -257,31 +293,22 @@ func (b *Bot) delInterviewer(c telebot.Context, s fsm.Context) error {
 		}
 	}()
 
-	old, err := b.repo.Users().Update(b.ctx, tg, nil, nil, &gradeDown)
+	old, cancelled, err := b.sched.RevokeInterviewer(ctx, tg)
 	if err != nil {
-		return b.fail(c, s, errors.WrapFail(err, "do Users.Upsert"))
+		return b.fail(c, s, errors.WrapFail(err, "revoke interviewer"))
 	}
 	if old == nil {
-		return b.final(c, s, "Такого пользователя не существует")
+		return b.final(c, s, b.text(c, "user.not_found", nil))
 	}
 
 	if old.IntGrade == models.GradeNotInterviewer {
-		return b.final(c, s, fmt.Sprintf("@%s уже не интервьюер", old.Username))
-	}
-
-	assigned, err := b.repo.Interviews().FindByUser(ctx, tg)
-	if err != nil {
-		return errors.WrapFail(err, "find interviews assigned to deleted interviewer")
+		return b.final(c, s, b.text(c, "del_interviewer.already", vars{"Username": old.Username}))
 	}
 
-	for i := range assigned {
-		if assigned[i].InterviewerUN != tg {
-			continue
-		}
-
-		_, err := b.cancelInterview(ctx, assigned[i], models.RoleInterviewer)
+	for _, i := range cancelled {
+		err = b.NotifyCancelled(ctx, i)
 		if err != nil {
-			return errors.WrapFail(err, "cancel interview assigned to deleted interviewer")
+			return b.fail(c, s, errors.WrapFail(err, "notify about cancel of %s", i.ID))
 		}
 	}
 
@@ -290,7 +317,7 @@ func (b *Bot) delInterviewer(c telebot.Context, s fsm.Context) error {
 		b.log.Error(errors.WrapFail(err, "commit txn"))
 	}
 
-	return b.final(c, s, fmt.Sprintf("@%s больше не интервьюер", old.Username))
+	return b.final(c, s, b.text(c, "del_interviewer.done", vars{"Username": old.Username}))
 }
 
 func (b *Bot) runAddZoom(c telebot.Context, s fsm.Context) error {
@@ -305,7 +332,7 @@ func (b *Bot) runAddZoom(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, addZoomReadIIDState)
-	return c.Send("Введите id собеседования")
+	return c.Send(b.text(c, "zoom.ask_id", nil))
 }
 
 func (b *Bot) addZoomReadIID(c telebot.Context, s fsm.Context) error {
@@ -316,7 +343,7 @@ func (b *Bot) addZoomReadIID(c telebot.Context, s fsm.Context) error {
 	}
 
 	if found == nil {
-		return b.final(c, s, "Такого собеседования нет")
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
 	err = s.Update("iid", iid)
@@ -325,7 +352,7 @@ func (b *Bot) addZoomReadIID(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, addZoomReadLinkState)
-	return c.Send("Введите ссылку на встречу")
+	return c.Send(b.text(c, "zoom.ask_link", nil))
 }
 
 func (b *Bot) addZoom(c telebot.Context, s fsm.Context) error {
@@ -337,10 +364,10 @@ func (b *Bot) addZoom(c telebot.Context, s fsm.Context) error {
 
 	link := c.Text()
 
-	err = b.repo.Interviews().Update(b.ctx, iid, nil, nil, nil, &link)
+	err = b.repo.Interviews().Update(b.ctx, iid, nil, nil, nil, &link, nil, nil, nil)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "update interview"))
 	}
 
-	return b.final(c, s, "Ссылка добавлена")
+	return b.final(c, s, b.text(c, "zoom.done", nil))
 }
@@ -789,6 +789,20 @@ func (m *MockrepoClient) EXPECT() *MockrepoClientMockRecorder {
 	return m.recorder
 }
 
+// Applications mocks base method.
+func (m *MockrepoClient) Applications() models.ApplicationsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Applications")
+	ret0, _ := ret[0].(models.ApplicationsRepo)
+	return ret0
+}
+
+// Applications indicates an expected call of Applications.
+func (mr *MockrepoClientMockRecorder) Applications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Applications", reflect.TypeOf((*MockrepoClient)(nil).Applications))
+}
+
 // Close mocks base method.
 func (m *MockrepoClient) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -803,6 +817,20 @@ func (mr *MockrepoClientMockRecorder) Close(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockrepoClient)(nil).Close), ctx)
 }
 
+// Dialogs mocks base method.
+func (m *MockrepoClient) Dialogs() models.DialogsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dialogs")
+	ret0, _ := ret[0].(models.DialogsRepo)
+	return ret0
+}
+
+// Dialogs indicates an expected call of Dialogs.
+func (mr *MockrepoClientMockRecorder) Dialogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dialogs", reflect.TypeOf((*MockrepoClient)(nil).Dialogs))
+}
+
 // Interviews mocks base method.
 func (m *MockrepoClient) Interviews() models.InterviewsRepo {
 	m.ctrl.T.Helper()
@@ -832,6 +860,20 @@ func (mr *MockrepoClientMockRecorder) NewSession() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockrepoClient)(nil).NewSession))
 }
 
+// Outbox mocks base method.
+func (m *MockrepoClient) Outbox() models.OutboxRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(models.OutboxRepo)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockrepoClientMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockrepoClient)(nil).Outbox))
+}
+
 // Users mocks base method.
 func (m *MockrepoClient) Users() models.UsersRepo {
 	m.ctrl.T.Helper()
@@ -846,6 +888,20 @@ func (mr *MockrepoClientMockRecorder) Users() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockrepoClient)(nil).Users))
 }
 
+// Vacancies mocks base method.
+func (m *MockrepoClient) Vacancies() models.VacanciesRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vacancies")
+	ret0, _ := ret[0].(models.VacanciesRepo)
+	return ret0
+}
+
+// Vacancies indicates an expected call of Vacancies.
+func (mr *MockrepoClientMockRecorder) Vacancies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vacancies", reflect.TypeOf((*MockrepoClient)(nil).Vacancies))
+}
+
 // MockinterviewsApi is a mock of interviewsApi interface.
 type MockinterviewsApi struct {
 	ctrl     *gomock.Controller
@@ -869,6 +925,20 @@ func (m *MockinterviewsApi) EXPECT() *MockinterviewsApiMockRecorder {
 	return m.recorder
 }
 
+// Attend mocks base method.
+func (m *MockinterviewsApi) Attend(ctx context.Context, id string, attendance [2]models.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attend", ctx, id, attendance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attend indicates an expected call of Attend.
+func (mr *MockinterviewsApiMockRecorder) Attend(ctx, id, attendance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attend", reflect.TypeOf((*MockinterviewsApi)(nil).Attend), ctx, id, attendance)
+}
+
 // Cancel mocks base method.
 func (m *MockinterviewsApi) Cancel(ctx context.Context, id string, side models.Role) error {
 	m.ctrl.T.Helper()
@@ -884,18 +954,18 @@ func (mr *MockinterviewsApiMockRecorder) Cancel(ctx, id, side any) *gomock.Call
 }
 
 // Create mocks base method.
-func (m *MockinterviewsApi) Create(ctx context.Context, vacancy, candidateTg string) (string, error) {
+func (m *MockinterviewsApi) Create(ctx context.Context, vacancy, candidateTg string, duration time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, vacancy, candidateTg)
+	ret := m.ctrl.Call(m, "Create", ctx, vacancy, candidateTg, duration)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockinterviewsApiMockRecorder) Create(ctx, vacancy, candidateTg any) *gomock.Call {
+func (mr *MockinterviewsApiMockRecorder) Create(ctx, vacancy, candidateTg, duration any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockinterviewsApi)(nil).Create), ctx, vacancy, candidateTg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockinterviewsApi)(nil).Create), ctx, vacancy, candidateTg, duration)
 }
 
 // Delete mocks base method.
@@ -986,6 +1056,21 @@ func (mr *MockinterviewsApiMockRecorder) GetUpcoming(ctx, lastNotifyBefore, star
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcoming", reflect.TypeOf((*MockinterviewsApi)(nil).GetUpcoming), ctx, lastNotifyBefore, startsBefore)
 }
 
+// List mocks base method.
+func (m *MockinterviewsApi) List(ctx context.Context, filter models.InterviewsFilter) ([]*models.Interview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*models.Interview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockinterviewsApiMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockinterviewsApi)(nil).List), ctx, filter)
+}
+
 // Notify mocks base method.
 func (m *MockinterviewsApi) Notify(ctx context.Context, id string, at int64, notified [2]bool) error {
 	m.ctrl.T.Helper()
@@ -1000,32 +1085,74 @@ func (mr *MockinterviewsApiMockRecorder) Notify(ctx, id, at, notified any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockinterviewsApi)(nil).Notify), ctx, id, at, notified)
 }
 
+// RemovePanelist mocks base method.
+func (m *MockinterviewsApi) RemovePanelist(ctx context.Context, id, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePanelist", ctx, id, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePanelist indicates an expected call of RemovePanelist.
+func (mr *MockinterviewsApiMockRecorder) RemovePanelist(ctx, id, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePanelist", reflect.TypeOf((*MockinterviewsApi)(nil).RemovePanelist), ctx, id, username)
+}
+
 // Schedule mocks base method.
-func (m *MockinterviewsApi) Schedule(ctx context.Context, id string, candidate, interviewer models.User, slot models.Meeting) error {
+func (m *MockinterviewsApi) Schedule(ctx context.Context, id string, candidate, interviewer models.User, panel []models.Panelist, slot models.Meeting) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Schedule", ctx, id, candidate, interviewer, slot)
+	ret := m.ctrl.Call(m, "Schedule", ctx, id, candidate, interviewer, panel, slot)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Schedule indicates an expected call of Schedule.
-func (mr *MockinterviewsApiMockRecorder) Schedule(ctx, id, candidate, interviewer, slot any) *gomock.Call {
+func (mr *MockinterviewsApiMockRecorder) Schedule(ctx, id, candidate, interviewer, panel, slot any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinterviewsApi)(nil).Schedule), ctx, id, candidate, interviewer, slot)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinterviewsApi)(nil).Schedule), ctx, id, candidate, interviewer, panel, slot)
+}
+
+// Score mocks base method.
+func (m *MockinterviewsApi) Score(ctx context.Context, id string, scorecard models.Scorecard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Score", ctx, id, scorecard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Score indicates an expected call of Score.
+func (mr *MockinterviewsApiMockRecorder) Score(ctx, id, scorecard any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Score", reflect.TypeOf((*MockinterviewsApi)(nil).Score), ctx, id, scorecard)
+}
+
+// SetPanel mocks base method.
+func (m *MockinterviewsApi) SetPanel(ctx context.Context, id string, panel models.Panel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPanel", ctx, id, panel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPanel indicates an expected call of SetPanel.
+func (mr *MockinterviewsApiMockRecorder) SetPanel(ctx, id, panel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPanel", reflect.TypeOf((*MockinterviewsApi)(nil).SetPanel), ctx, id, panel)
 }
 
 // Update mocks base method.
-func (m *MockinterviewsApi) Update(ctx context.Context, id string, vacancy, candidate *string, data *[]byte, zoom *string) error {
+func (m *MockinterviewsApi) Update(ctx context.Context, id string, vacancy, candidate *string, data *[]byte, zoom *string, duration *time.Duration, minGrade *int, skills *[]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, vacancy, candidate, data, zoom)
+	ret := m.ctrl.Call(m, "Update", ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockinterviewsApiMockRecorder) Update(ctx, id, vacancy, candidate, data, zoom any) *gomock.Call {
+func (mr *MockinterviewsApiMockRecorder) Update(ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockinterviewsApi)(nil).Update), ctx, id, vacancy, candidate, data, zoom)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockinterviewsApi)(nil).Update), ctx, id, vacancy, candidate, data, zoom, duration, minGrade, skills)
 }
 
 // MockusersApi is a mock of usersApi interface.
@@ -1066,19 +1193,124 @@ func (mr *MockusersApiMockRecorder) Get(ctx, username any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockusersApi)(nil).Get), ctx, username)
 }
 
+// List mocks base method.
+func (m *MockusersApi) List(ctx context.Context, filter models.UsersFilter) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockusersApiMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockusersApi)(nil).List), ctx, filter)
+}
+
 // Match mocks base method.
-func (m *MockusersApi) Match(ctx context.Context, targetInterval [2]int64) ([]models.User, error) {
+func (m *MockusersApi) Match(ctx context.Context, targetInterval [2]int64, filter models.InterviewerFilter) ([]models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Match", ctx, targetInterval)
+	ret := m.ctrl.Call(m, "Match", ctx, targetInterval, filter)
 	ret0, _ := ret[0].([]models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Match indicates an expected call of Match.
-func (mr *MockusersApiMockRecorder) Match(ctx, targetInterval any) *gomock.Call {
+func (mr *MockusersApiMockRecorder) Match(ctx, targetInterval, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockusersApi)(nil).Match), ctx, targetInterval)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockusersApi)(nil).Match), ctx, targetInterval, filter)
+}
+
+// SetAvailability mocks base method.
+func (m *MockusersApi) SetAvailability(ctx context.Context, username string, availability *models.Availability) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, username, availability)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockusersApiMockRecorder) SetAvailability(ctx, username, availability any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockusersApi)(nil).SetAvailability), ctx, username, availability)
+}
+
+// SetLanguage mocks base method.
+func (m *MockusersApi) SetLanguage(ctx context.Context, username string, language models.Language) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLanguage", ctx, username, language)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLanguage indicates an expected call of SetLanguage.
+func (mr *MockusersApiMockRecorder) SetLanguage(ctx, username, language any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLanguage", reflect.TypeOf((*MockusersApi)(nil).SetLanguage), ctx, username, language)
+}
+
+// SetLimits mocks base method.
+func (m *MockusersApi) SetLimits(ctx context.Context, username string, limits models.Limits) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimits", ctx, username, limits)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLimits indicates an expected call of SetLimits.
+func (mr *MockusersApiMockRecorder) SetLimits(ctx, username, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimits", reflect.TypeOf((*MockusersApi)(nil).SetLimits), ctx, username, limits)
+}
+
+// SetNotifications mocks base method.
+func (m *MockusersApi) SetNotifications(ctx context.Context, username string, notifications models.Notifications) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotifications", ctx, username, notifications)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotifications indicates an expected call of SetNotifications.
+func (mr *MockusersApiMockRecorder) SetNotifications(ctx, username, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotifications", reflect.TypeOf((*MockusersApi)(nil).SetNotifications), ctx, username, notifications)
+}
+
+// SetSkills mocks base method.
+func (m *MockusersApi) SetSkills(ctx context.Context, username string, skills []string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSkills", ctx, username, skills)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSkills indicates an expected call of SetSkills.
+func (mr *MockusersApiMockRecorder) SetSkills(ctx, username, skills any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSkills", reflect.TypeOf((*MockusersApi)(nil).SetSkills), ctx, username, skills)
+}
+
+// SetTimeZone mocks base method.
+func (m *MockusersApi) SetTimeZone(ctx context.Context, username, timeZone string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimeZone", ctx, username, timeZone)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTimeZone indicates an expected call of SetTimeZone.
+func (mr *MockusersApiMockRecorder) SetTimeZone(ctx, username, timeZone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimeZone", reflect.TypeOf((*MockusersApi)(nil).SetTimeZone), ctx, username, timeZone)
 }
 
 // Update mocks base method.
@@ -1126,6 +1358,101 @@ func (mr *MockusersApiMockRecorder) Upsert(ctx, username, telegramID, category,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockusersApi)(nil).Upsert), ctx, username, telegramID, category, intGrade)
 }
 
+// MockdialogsApi is a mock of dialogsApi interface.
+type MockdialogsApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockdialogsApiMockRecorder
+}
+
+// MockdialogsApiMockRecorder is the mock recorder for MockdialogsApi.
+type MockdialogsApiMockRecorder struct {
+	mock *MockdialogsApi
+}
+
+// NewMockdialogsApi creates a new mock instance.
+func NewMockdialogsApi(ctrl *gomock.Controller) *MockdialogsApi {
+	mock := &MockdialogsApi{ctrl: ctrl}
+	mock.recorder = &MockdialogsApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdialogsApi) EXPECT() *MockdialogsApiMockRecorder {
+	return m.recorder
+}
+
+// GetData mocks base method.
+func (m *MockdialogsApi) GetData(ctx context.Context, chatID, userID int64, key string, to any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetData", ctx, chatID, userID, key, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetData indicates an expected call of GetData.
+func (mr *MockdialogsApiMockRecorder) GetData(ctx, chatID, userID, key, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetData", reflect.TypeOf((*MockdialogsApi)(nil).GetData), ctx, chatID, userID, key, to)
+}
+
+// GetState mocks base method.
+func (m *MockdialogsApi) GetState(ctx context.Context, chatID, userID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", ctx, chatID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockdialogsApiMockRecorder) GetState(ctx, chatID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockdialogsApi)(nil).GetState), ctx, chatID, userID)
+}
+
+// ResetState mocks base method.
+func (m *MockdialogsApi) ResetState(ctx context.Context, chatID, userID int64, withData bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetState", ctx, chatID, userID, withData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetState indicates an expected call of ResetState.
+func (mr *MockdialogsApiMockRecorder) ResetState(ctx, chatID, userID, withData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetState", reflect.TypeOf((*MockdialogsApi)(nil).ResetState), ctx, chatID, userID, withData)
+}
+
+// SetState mocks base method.
+func (m *MockdialogsApi) SetState(ctx context.Context, chatID, userID int64, state string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetState", ctx, chatID, userID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetState indicates an expected call of SetState.
+func (mr *MockdialogsApiMockRecorder) SetState(ctx, chatID, userID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockdialogsApi)(nil).SetState), ctx, chatID, userID, state)
+}
+
+// UpdateData mocks base method.
+func (m *MockdialogsApi) UpdateData(ctx context.Context, chatID, userID int64, key string, data any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateData", ctx, chatID, userID, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateData indicates an expected call of UpdateData.
+func (mr *MockdialogsApiMockRecorder) UpdateData(ctx, chatID, userID, key, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateData", reflect.TypeOf((*MockdialogsApi)(nil).UpdateData), ctx, chatID, userID, key, data)
+}
+
 // Mockpubsub is a mock of pubsub interface.
 type Mockpubsub struct {
 	ctrl     *gomock.Controller
@@ -1214,31 +1541,3 @@ func (mr *MockTimeProviderMockRecorder) NowMillis() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NowMillis", reflect.TypeOf((*MockTimeProvider)(nil).NowMillis))
 }
-
-// UTCDiff mocks base method.
-func (m *MockTimeProvider) UTCDiff() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UTCDiff")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// UTCDiff indicates an expected call of UTCDiff.
-func (mr *MockTimeProviderMockRecorder) UTCDiff() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTCDiff", reflect.TypeOf((*MockTimeProvider)(nil).UTCDiff))
-}
-
-// ZoneName mocks base method.
-func (m *MockTimeProvider) ZoneName() string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ZoneName")
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// ZoneName indicates an expected call of ZoneName.
-func (mr *MockTimeProviderMockRecorder) ZoneName() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZoneName", reflect.TypeOf((*MockTimeProvider)(nil).ZoneName))
-}
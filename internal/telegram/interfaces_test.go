@@ -28,6 +28,10 @@ type usersApi interface {
 	models.UsersRepo
 }
 
+type dialogsApi interface {
+	models.DialogsRepo
+}
+
 type pubsub interface {
 	Pull(channel string) ([][]byte, error)
 }
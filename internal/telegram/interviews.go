@@ -3,9 +3,7 @@ package telegram
 import (
 	"cmp"
 	"context"
-	"fmt"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -13,13 +11,14 @@ import (
 	"gopkg.in/telebot.v3"
 
 	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
 	"github.com/nikmy/meowbot/pkg/errors"
 	"github.com/nikmy/meowbot/pkg/txn"
 )
 
 func (b *Bot) runMatch(c telebot.Context, s fsm.Context) error {
 	b.setState(s, matchReadIIDState)
-	return c.Send("Введите ID собеседования")
+	return c.Send(b.text(c, "interview.ask_id", nil))
 }
 
 func (b *Bot) matchReadIID(c telebot.Context, s fsm.Context) error {
@@ -31,7 +30,7 @@ func (b *Bot) matchReadIID(c telebot.Context, s fsm.Context) error {
 	}
 
 	if i == nil {
-		return b.final(c, s, "Такого собеседования нет")
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
 	sender := c.Sender()
@@ -40,7 +39,7 @@ func (b *Bot) matchReadIID(c telebot.Context, s fsm.Context) error {
 	}
 
 	if i.CandidateUN != sender.Username {
-		return b.final(c, s, "Вы не являетесь кандидатом в этом собеседовании")
+		return b.final(c, s, b.text(c, "match.not_candidate", nil))
 	}
 
 	err = s.Update("iid", iid)
@@ -49,26 +48,163 @@ func (b *Bot) matchReadIID(c telebot.Context, s fsm.Context) error {
 	}
 
 	b.setState(s, matchReadIntervalState)
-	return c.Send("Введите дату и время в формате ДД ММ ГГГГ ЧЧ ММ")
+	return c.Send(
+		b.text(c, "match.pick_date", nil),
+		b.calendarMarkup(c, b.userToday(c), b.userToday(c)),
+	)
 }
 
-func (b *Bot) match(c telebot.Context, s fsm.Context) error {
+func (b *Bot) matchReadInterval(c telebot.Context, s fsm.Context) error {
+	first, last, err := parseDateRange(c.Text())
+	if err != nil {
+		b.log.Debug(err)
+		return c.Send(b.text(c, "bad_date", nil))
+	}
+
+	return b.matchSuggest(c, s, first, last)
+}
+
+func (b *Bot) matchPickDay(c telebot.Context, s fsm.Context, day time.Time) error {
+	return b.matchSuggest(c, s, day, day.AddDate(0, 0, 1))
+}
+
+// minNotice is how long before the start a slot can still be chosen, at least a minute
+// to leave time for the choice to be booked
+func (b *Bot) minNotice() time.Duration {
+	return max(time.Minute, b.sched.MinNotice())
+}
+
+func (b *Bot) matchSuggest(c telebot.Context, s fsm.Context, first, last time.Time) error {
 	var iid string
 	err := s.Get("iid", &iid)
 	if err != nil {
 		b.log.Debug(err)
-		return b.final(c, s, "Ошибка, попробуйте ещё раз")
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	if last.Sub(first) > b.slots.MaxRange {
+		last = first.Add(b.slots.MaxRange)
+	}
+
+	zone := b.zone(c)
+	from := fromUserTime(first, zone)
+	if earliest := b.time.Now().Add(b.minNotice()); from.Before(earliest) {
+		from = earliest
+	}
+	to := fromUserTime(last, zone)
+
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview to match"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	cand, err := b.repo.Users().Get(b.ctx, i.CandidateUN)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if cand == nil {
+		return b.final(c, s, b.text(c, "user.unknown", nil))
+	}
+
+	filter, err := b.sched.InterviewerFilter(b.ctx, i)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get interviewer filter"))
+	}
+
+	slots, err := b.suggestSlots(b.ctx, *cand, from.UnixMilli(), to.UnixMilli(), i.MeetDuration(), filter, i)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "suggest slots"))
+	}
+
+	if len(slots) == 0 {
+		return c.Send(b.text(c, "match.no_slots", nil), b.calendarMarkup(c, first, b.userToday(c)))
 	}
 
-	t, err := time.Parse("02 01 2006 15 04", c.Text())
+	err = s.Update("slots", slots)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with slots"))
+	}
+
+	// for a single day there is no need to show the date on every button
+	layout := slotsLayout(slots, zone)
+
+	options := make([]timeGridOption, 0, len(slots))
+	for _, slot := range slots {
+		options = append(options, timeGridOption{
+			Text:  toUserTime(slot[0], zone).Format(layout),
+			Value: slot[0],
+		})
+	}
+
+	b.setState(s, matchReadSlotState)
+	return c.Send(
+		b.text(c, "match.pick_slot", vars{"Zone": zone.String()}),
+		timeGridMarkup(options),
+	)
+}
+
+func (b *Bot) match(c telebot.Context, s fsm.Context) error {
+	var slots []models.Meeting
+	err := s.Get("slots", &slots)
 	if err != nil {
 		b.log.Debug(err)
-		return b.final(c, s, "Плохой формат даты.")
+		return b.final(c, s, b.text(c, "retry", nil))
 	}
 
-	left := t.UTC().Add(b.time.UTCDiff())
-	if left.Sub(b.time.Now()) < time.Minute {
-		return b.final(c, s, "В это время нельзя провести интервью")
+	text := strings.TrimSpace(c.Text())
+	zone := b.zone(c)
+	layout := slotsLayout(slots, zone)
+	idx := slices.IndexFunc(slots, func(slot models.Meeting) bool {
+		return formatSlot(slot, zone) == text || toUserTime(slot[0], zone).Format(layout) == text
+	})
+	if idx == -1 {
+		return c.Send(b.text(c, "match.pick_offered", nil))
+	}
+
+	return b.matchSlot(c, s, slots[idx])
+}
+
+func (b *Bot) matchPickSlot(c telebot.Context, s fsm.Context, start int64) error {
+	var slots []models.Meeting
+	err := s.Get("slots", &slots)
+	if err != nil {
+		b.log.Debug(err)
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	idx := slices.IndexFunc(slots, func(slot models.Meeting) bool {
+		return slot[0] == start
+	})
+	if idx == -1 {
+		return b.final(c, s, b.text(c, "match.stale", nil))
+	}
+
+	return b.matchSlot(c, s, slots[idx])
+}
+
+func (b *Bot) matchSlot(c telebot.Context, s fsm.Context, meet models.Meeting) error {
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		b.log.Debug(err)
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	if meet[0]-b.time.NowMillis() < b.minNotice().Milliseconds() {
+		return b.final(c, s, b.text(c, "match.too_soon", nil))
+	}
+
+	var reschedule bool
+	err = s.Get("reschedule", &reschedule)
+	if err != nil && !errors.Is(err, fsm.ErrNotFound) {
+		return b.fail(c, s, errors.WrapFail(err, "get reschedule flag"))
+	}
+
+	if reschedule {
+		return b.rescheduleSlot(c, s, iid, meet)
 	}
 
 	sender := c.Sender()
@@ -81,32 +217,136 @@ func (b *Bot) match(c telebot.Context, s fsm.Context) error {
 		return b.fail(c, s, err)
 	}
 	if cand == nil {
-		return b.final(c, s, "Мы не знакомы. Попробуйте /start")
+		return b.final(c, s, b.text(c, "user.unknown", nil))
 	}
 
-	meet := models.Meeting{left.UnixMilli(), left.Add(time.Hour).UnixMilli()}
-
 	_, free := cand.AddMeeting(meet)
 	if !free {
-		return b.final(c, s, "В это время вы заняты")
+		return b.final(c, s, b.text(c, "match.busy", nil))
 	}
 
-	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*10)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "create session context"))
+	}
+	defer cancel()
+
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "start txn"))
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, iid)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "find interview to match"))
 	}
 
 	if i == nil {
-		return b.final(c, s, "Такого собеседования нет")
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
 	if i.Meet != nil {
-		return b.final(c, s, fmt.Sprintf("Собеседование уже назначено на %s", time.UnixMilli(i.Meet[0])))
+		return b.final(c, s, b.text(c, "match.already", vars{"Time": moment(i.Meet[0])}))
+	}
+
+	lead, panelists, err := b.sched.Book(ctx, i, meet)
+	switch {
+	case errors.Is(err, scheduling.ErrTooSoon):
+		return b.final(c, s, b.text(c, "match.too_soon", nil))
+	case errors.Is(err, scheduling.ErrCandidateBusy):
+		return b.final(c, s, b.text(c, "match.busy", nil))
+	case errors.Is(err, scheduling.ErrNoInterviewer):
+		return b.final(c, s, b.text(c, "match.taken", nil))
+	case err != nil:
+		return b.fail(c, s, errors.WrapFail(err, "book meeting"))
+	}
+
+	msg := message{"interview.assigned", vars{
+		"ID":       iid,
+		"Time":     moment(meet[0]),
+		"Duration": i.MeetDuration(),
+	}}
+
+	err = b.notify(ctx, lead.Username, lead.Telegram, msg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "notify interviewer"))
+	}
+
+	for _, panelist := range panelists {
+		err = b.notify(ctx, panelist.Username, panelist.Telegram, msg)
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "notify panelist"))
+		}
 	}
 
-	pool, err := b.repo.Users().Match(b.ctx, meet)
+	err = tx.Commit(ctx)
 	if err != nil {
-		return b.fail(c, s, errors.WrapFail(err, "do Users.Mathc request"))
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
+	}
+
+	return b.final(c, s, b.text(c, msg.key, msg.data), &telebot.SendOptions{ParseMode: telebot.ModeMarkdown})
+}
+
+func (b *Bot) runReschedule(c telebot.Context, s fsm.Context) error {
+	b.setState(s, rescheduleReadIIDState)
+	return c.Send(b.text(c, "interview.ask_id", nil))
+}
+
+func (b *Bot) rescheduleReadIID(c telebot.Context, s fsm.Context) error {
+	iid := c.Text()
+
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview by id"))
+	}
+
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if sender.Username != i.CandidateUN && !i.IsInterviewer(sender.Username) {
+		return b.final(c, s, b.text(c, "deny.not_participant", nil))
+	}
+
+	if i.Status != models.InterviewStatusScheduled || i.Meet == nil {
+		return b.final(c, s, b.text(c, "reschedule.not_scheduled", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	err = s.Update("reschedule", true)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with reschedule flag"))
+	}
+
+	b.setState(s, matchReadIntervalState)
+	return c.Send(
+		b.text(c, "reschedule.pick_date", vars{"Time": moment(i.Meet[0])}),
+		b.calendarMarkup(c, b.userToday(c), b.userToday(c)),
+	)
+}
+
+func (b *Bot) rescheduleSlot(c telebot.Context, s fsm.Context, iid string, meet models.Meeting) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
 	}
 
 	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*10)
@@ -115,39 +355,152 @@ func (b *Bot) match(c telebot.Context, s fsm.Context) error {
 	}
 	defer cancel()
 
-	assigned, candFree := false, true
-	for candFree && len(pool) > 0 {
-		assigned, candFree = b.tryAssign(ctx, *cand, pool[0], iid, meet)
-		if b.ctx.Err() != nil {
-			b.log.Error(err)
-			break
-		}
-		if assigned {
-			break
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "start txn"))
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
 		}
-		pool = pool[1:]
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview to reschedule"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
-	if !candFree {
-		return b.final(c, s, "В это время вы заняты")
+	lead, panelists, err := b.sched.Reschedule(ctx, i, meet)
+	switch {
+	case errors.Is(err, scheduling.ErrNotScheduled):
+		return b.final(c, s, b.text(c, "reschedule.not_scheduled", nil))
+	case errors.Is(err, scheduling.ErrTooSoon):
+		return b.final(c, s, b.text(c, "match.too_soon", nil))
+	case errors.Is(err, scheduling.ErrCandidateBusy):
+		return b.final(c, s, b.text(c, "reschedule.candidate_busy", nil))
+	case errors.Is(err, scheduling.ErrNoInterviewer):
+		return b.final(c, s, b.text(c, "reschedule.taken", nil))
+	case err != nil:
+		return b.fail(c, s, errors.WrapFail(err, "reschedule interview"))
 	}
 
-	if len(pool) == 0 {
-		return b.final(
-			c, s,
-			"На выбранный слот совпадений не нашлось :(\n"+
-				"Попробуйте изменить дату или время.",
-		)
+	err = b.notifyRescheduled(ctx, i, lead, panelists, meet, sender.Username)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "notify about reschedule"))
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
 	}
 
-	msg := fmt.Sprintf("Назначили собеседование `%s` на %s", iid, left.Format("02.01.06 15:04:05"))
+	return b.final(
+		c, s,
+		b.text(c, "interview.moved", vars{"ID": iid, "Time": moment(meet[0])}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
+}
+
+// NotifyRescheduled enqueues messages about the interview moved via HR API to all participants
+func (b *Bot) NotifyRescheduled(
+	ctx context.Context,
+	old *models.Interview,
+	lead models.User,
+	panelists []models.Panelist,
+	meet models.Meeting,
+) error {
+	return b.notifyRescheduled(ctx, old, lead, panelists, meet, "")
+}
+
+// NotifyCancelled enqueues messages about the interview cancelled via HR API to all participants
+func (b *Bot) NotifyCancelled(ctx context.Context, i *models.Interview) error {
+	return b.notifyAll(ctx, i, message{"interview.cancelled_by_hr", vars{"ID": i.ID}})
+}
+
+// NotifyDeleted enqueues messages about the scheduled interview deleted by HR to all participants
+func (b *Bot) NotifyDeleted(ctx context.Context, i *models.Interview) error {
+	return b.notifyAll(ctx, i, message{"interview.deleted", vars{"ID": i.ID, "Vacancy": i.Vacancy}})
+}
 
-	err = b.notify(pool[0].Telegram, msg)
+// NotifyFinished asks all participants of the interview finished via HR API about its outcome
+func (b *Bot) NotifyFinished(ctx context.Context, i *models.Interview) error {
+	return b.notifyAll(ctx, i, message{"outcome.prompt", vars{"ID": i.ID, "Vacancy": i.Vacancy}})
+}
+
+// notifyAll enqueues msg to the candidate and the whole panel
+func (b *Bot) notifyAll(ctx context.Context, i *models.Interview, msg message) error {
+	err := b.notify(ctx, i.CandidateUN, i.CandidateTg, msg)
 	if err != nil {
-		b.log.Warn(errors.WrapFail(err, "notify interviewer"))
+		return errors.WrapFail(err, "notify candidate")
 	}
 
-	return b.final(c, s, msg, &telebot.SendOptions{ParseMode: telebot.ModeMarkdown})
+	return errors.WrapFail(b.notifyPanel(ctx, i, msg, ""), "notify interviewers")
+}
+
+// notifyRescheduled enqueues a single message to every participant except the initiator.
+// Panel members who stay are told about the new time, new ones get the assignment
+// and dropped ones are told that the interview is no longer theirs.
+func (b *Bot) notifyRescheduled(
+	ctx context.Context,
+	old *models.Interview,
+	lead models.User,
+	panelists []models.Panelist,
+	meet models.Meeting,
+	initiator string,
+) error {
+	moved := message{"interview.moved", vars{"ID": old.ID, "Time": moment(meet[0])}}
+
+	if old.CandidateUN != initiator {
+		err := b.notify(ctx, old.CandidateUN, old.CandidateTg, moved)
+		if err != nil {
+			return errors.WrapFail(err, "notify candidate")
+		}
+	}
+
+	assigned := message{"interview.assigned", vars{
+		"ID":       old.ID,
+		"Time":     moment(meet[0]),
+		"Duration": old.MeetDuration(),
+	}}
+
+	members := append([]models.Panelist{{Username: lead.Username, Telegram: lead.Telegram}}, panelists...)
+	for _, member := range members {
+		if member.Username == initiator {
+			continue
+		}
+
+		msg := assigned
+		if old.IsInterviewer(member.Username) {
+			msg = moved
+		}
+
+		err := b.notify(ctx, member.Username, member.Telegram, msg)
+		if err != nil {
+			return errors.WrapFail(err, "notify interviewer")
+		}
+	}
+
+	previous := append([]models.Panelist{{Username: old.InterviewerUN, Telegram: old.InterviewerTg}}, old.Panelists...)
+	for _, member := range previous {
+		kept := slices.ContainsFunc(members, func(p models.Panelist) bool { return p.Username == member.Username })
+		if kept || member.Username == initiator {
+			continue
+		}
+
+		err := b.notify(ctx, member.Username, member.Telegram, message{"interview.reassigned", vars{"ID": old.ID}})
+		if err != nil {
+			return errors.WrapFail(err, "notify previous interviewer")
+		}
+	}
+
+	return nil
 }
 
 func (b *Bot) showInterviews(c telebot.Context, s fsm.Context) error {
@@ -166,8 +519,13 @@ func (b *Bot) showInterviews(c telebot.Context, s fsm.Context) error {
 		return b.fail(c, s, errors.WrapFail(err, "find by candidate"))
 	}
 
+	// past interviews are not shown
+	assigned = slices.DeleteFunc(assigned, func(i *models.Interview) bool {
+		return i.Status == models.InterviewStatusFinished || i.Status == models.InterviewStatusCancelled
+	})
+
 	if len(assigned) == 0 {
-		return b.final(c, s, "У вас нет назначенных собеседований")
+		return b.final(c, s, b.text(c, "show.none", nil))
 	}
 
 	slices.SortFunc(assigned, func(a, b *models.Interview) int {
@@ -183,47 +541,32 @@ func (b *Bot) showInterviews(c telebot.Context, s fsm.Context) error {
 		)
 	})
 
-	var sb strings.Builder
-
-	for idx, i := range assigned {
-		sb.WriteString(strconv.Itoa(idx))
-		sb.WriteString(". ")
-
-		sb.WriteRune('`')
-		sb.WriteString(i.ID)
-		sb.WriteRune('`')
-
-		sb.WriteString(": \"")
-		sb.WriteString(i.Vacancy)
-
-		sb.WriteString("\"\t")
-		switch sender.ID {
-		case i.InterviewerTg:
-			sb.WriteString("Интер.")
-		case i.CandidateTg:
-			sb.WriteString("Канд.")
+	list := make([]vars, 0, len(assigned))
+	for _, i := range assigned {
+		var meet any = ""
+		if i.Meet != nil {
+			meet = moment(i.Meet[0])
 		}
-		sb.WriteString(", ")
 
-		if i.Meet == nil {
-			sb.WriteString(" не запланировано;\n")
-			continue
-		}
-
-		timeInfo := fmt.Sprintf(
-			" %s %s;\n",
-			time.UnixMilli(i.Meet[0]).Format(time.DateTime),
-			b.time.ZoneName(),
-		)
-		sb.WriteString(timeInfo)
+		list = append(list, vars{
+			"ID":          i.ID,
+			"Vacancy":     i.Vacancy,
+			"Interviewer": i.IsInterviewer(sender.Username),
+			"Candidate":   sender.ID == i.CandidateTg,
+			"Time":        meet,
+		})
 	}
 
-	return b.final(c, s, sb.String(), &telebot.SendOptions{ParseMode: telebot.ModeMarkdown})
+	return b.final(
+		c, s,
+		b.text(c, "show.list", vars{"Interviews": list}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
 }
 
 func (b *Bot) runCancel(c telebot.Context, s fsm.Context) error {
 	b.setState(s, cancelReadIIDState)
-	return c.Send("Введите ID собеседования")
+	return c.Send(b.text(c, "interview.ask_id", nil))
 }
 
 func (b *Bot) cancel(c telebot.Context, s fsm.Context) error {
@@ -257,183 +600,74 @@ func (b *Bot) cancel(c telebot.Context, s fsm.Context) error {
 	}
 
 	if i == nil {
-		return b.final(c, s, "Собеседование не найдено")
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
 	}
 
 	if i.Meet == nil {
-		return b.final(c, s, "Собеседование не запланировано")
+		return b.final(c, s, b.text(c, "interview.not_scheduled", nil))
 	}
 
 	var side models.Role
-	switch sender.ID {
-	case i.CandidateTg:
+	switch {
+	case sender.ID == i.CandidateTg:
 		side = models.RoleCandidate
-	case i.InterviewerTg:
+	case sender.ID == i.InterviewerTg || i.IsInterviewer(sender.Username):
 		side = models.RoleInterviewer
 	default:
-		return b.final(c, s, "Вы не являетесь участником собеседования")
+		return b.final(c, s, b.text(c, "deny.not_participant", nil))
 	}
 
-	scheduled, err := b.cancelInterview(ctx, i, side)
+	scheduled, err := b.sched.CancelInterview(ctx, i, side)
 	if err != nil {
 		return b.fail(c, s, errors.WrapFail(err, "cancel interview"))
 	}
 	if !scheduled {
-		return b.final(c, s, "Интервью не запланировано")
+		return b.final(c, s, b.text(c, "interview.not_scheduled", nil))
 	}
 
-	switch side {
-	case models.RoleInterviewer:
-		err = b.notify(i.CandidateTg, fmt.Sprintf("Интервьюер отменил собеседование `%s`", i.ID))
-		err = errors.WrapFail(err, "notify candidate about cancel")
-	case models.RoleCandidate:
-		err = b.notify(i.InterviewerTg, fmt.Sprintf("Кандидат отменил собеседование `%s`", i.ID))
-		err = errors.WrapFail(err, "notify candidate about cancel")
-	}
-
-	if err != nil {
-		return b.fail(c, s, err)
-	}
-
-	err = tx.Commit(ctx)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "commit txn"))
-	}
-
-	return b.final(c, s, "Собеседование отменено")
-}
-
-func (b *Bot) cancelInterview(ctx context.Context, interview *models.Interview, side models.Role) (bool, error) {
-	if interview.Meet == nil {
-		return false, nil
-	}
-
-	err := b.repo.Interviews().Cancel(ctx, interview.ID, side)
-	if err != nil {
-		return false, errors.WrapFail(err, "do Interviews.Cancel request")
-	}
-
-	ok, err := b.cancelMeeting(ctx, interview.InterviewerUN, *interview.Meet)
-	if err != nil {
-		return false, errors.WrapFail(err, "cancel meeting")
-	}
-	if !ok {
-		return false, nil
-	}
-
-	ok, err = b.cancelMeeting(ctx, interview.CandidateUN, *interview.Meet)
-	if err != nil {
-		return false, errors.WrapFail(err, "cancel meeting")
-	}
-	if !ok {
-		return false, nil
-	}
-
-	return true, nil
-}
-
-func (b *Bot) cancelMeeting(ctx context.Context, username string, meet models.Meeting) (bool, error) {
-	user, err := b.repo.Users().Get(ctx, username)
-	if err != nil {
-		return false, errors.WrapFail(err, "find user")
-	}
-
-	if user == nil {
-		return false, nil
-	}
-
-	meets, found := user.FindAndDeleteMeeting(meet)
-	if !found {
-		return false, nil
-	}
-
-	updated, err := b.repo.Users().UpdateMeetings(ctx, username, meets, user.Assigned)
-	if err != nil {
-		return false, errors.WrapFail(err, "update meetings")
-	}
-
-	return updated, nil
-}
-
-func (b *Bot) tryAssign(
-	ctx context.Context,
-	candidate models.User,
-	interviewer models.User,
-	iid string,
-	meet models.Meeting,
-) (bool, bool) {
-	if candidate.Username == interviewer.Username {
-		return false, true
-	}
-
-	tx, err := txn.New(ctx).
-		SetModel(txn.CausalConsistency).
-		SetIsolation(txn.SnapshotIsolation).
-		Start(ctx)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "start txn"))
-		return false, true
-	}
-	defer func() {
-		err := tx.Close(ctx)
+	msg := message{"interview.cancelled_by_candidate", vars{"ID": i.ID}}
+	if side == models.RoleInterviewer {
+		msg = message{"interview.cancelled_by_interviewer", vars{"ID": i.ID}}
+		err = b.notify(ctx, i.CandidateUN, i.CandidateTg, msg)
 		if err != nil {
-			b.log.Warn(errors.WrapFail(err, "close txn"))
+			return b.fail(c, s, errors.WrapFail(err, "notify candidate about cancel"))
 		}
-	}()
-
-	scheduled, err := b.scheduleMeeting(ctx, candidate.Username, meet)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "schedule meeting for candidate"))
-		return false, true
-	}
-	if !scheduled {
-		return false, false
 	}
 
-	scheduled, err = b.scheduleMeeting(ctx, interviewer.Username, meet)
+	err = b.notifyPanel(ctx, i, msg, sender.Username)
 	if err != nil {
-		b.log.Error(errors.WrapFail(err, "schedule meet for interviewer"))
-		return false, true
-	}
-	if !scheduled {
-		return false, true
-	}
-
-	err = b.repo.Interviews().Schedule(ctx, iid, candidate, interviewer, meet)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "schedule interview"))
-		return false, true
+		return b.fail(c, s, errors.WrapFail(err, "notify interviewers about cancel"))
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		b.log.Error(errors.WrapFail(err, "commit txn"))
-		return false, true
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
 	}
 
-	return true, true
+	return b.final(c, s, b.text(c, "cancel.done", nil))
 }
 
-func (b *Bot) scheduleMeeting(ctx context.Context, username string, meet models.Meeting) (bool, error) {
-	user, err := b.repo.Users().Get(ctx, username)
-	if err != nil {
-		return false, errors.WrapFail(err, "find user")
-	}
-
-	if user == nil {
-		return false, nil
+// notifyPanel enqueues msg to the lead interviewer and every panelist except the initiator,
+// empty initiator means everyone
+func (b *Bot) notifyPanel(ctx context.Context, i *models.Interview, msg message, initiator string) error {
+	assigned := i.InterviewerUN != "" || i.InterviewerTg != 0
+	if assigned && (initiator == "" || i.InterviewerUN != initiator) {
+		err := b.notify(ctx, i.InterviewerUN, i.InterviewerTg, msg)
+		if err != nil {
+			return err
+		}
 	}
 
-	insertIdx, can := user.AddMeeting(meet)
-	if !can {
-		return false, nil
-	}
-	meets := slices.Insert(user.Assigned, insertIdx, meet)
+	for _, panelist := range i.Panelists {
+		if initiator != "" && panelist.Username == initiator {
+			continue
+		}
 
-	assigned, err := b.repo.Users().UpdateMeetings(ctx, username, meets, user.Assigned)
-	if err != nil {
-		return false, errors.WrapFail(err, "update meetings")
+		err := b.notify(ctx, panelist.Username, panelist.Telegram, msg)
+		if err != nil {
+			return err
+		}
 	}
 
-	return assigned, nil
+	return nil
 }
@@ -77,6 +77,7 @@ func TestBot_showInterviews(t *testing.T) {
 			cMock := NewMocktelebotContext(ctrl)
 
 			cMock.EXPECT().Sender().Return(tt.mock.sender).Times(1)
+			cMock.EXPECT().Get(gomock.Any()).Return(nil).AnyTimes()
 			cMock.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).Return(*new(error))
 
 			sMock := NewMockfsmContext(ctrl)
@@ -96,9 +97,6 @@ func TestBot_showInterviews(t *testing.T) {
 				repoMock.EXPECT().Interviews().Return(iMock).MaxTimes(2)
 			}
 
-			tMock := NewMockTimeProvider(ctrl)
-			tMock.EXPECT().ZoneName().Return("").AnyTimes()
-
 			failed := false
 			log := zap.NewExample(
 				zap.Hooks(func(e zapcore.Entry) error {
@@ -110,9 +108,9 @@ func TestBot_showInterviews(t *testing.T) {
 			).Sugar()
 
 			b := &Bot{
-				repo: repoMock,
-				time: tMock,
-				log:  log,
+				messages: newTestMessages(t),
+				repo:     repoMock,
+				log:      log,
 			}
 
 			err := b.showInterviews(cMock, sMock)
@@ -0,0 +1,185 @@
+package telegram
+
+import (
+	"cmp"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+var languageBtn = &telebot.Btn{Unique: "lang"}
+
+const (
+	// languageAuto resets chosen language, so the Telegram one is used
+	languageAuto = "auto"
+
+	localeKey = "locale"
+)
+
+// vars is data of catalog messages
+type vars map[string]any
+
+// message is a catalog message with its data, it is rendered
+// in the locale of the recipient when enqueued
+type message struct {
+	key  string
+	data any
+}
+
+// text renders catalog message in the locale and the time zone of the sender
+func (b *Bot) text(c telebot.Context, key string, data any) string {
+	return b.render(b.locale(c), b.zone(c), key, data)
+}
+
+// render formats moments of data in the zone and renders catalog message
+func (b *Bot) render(locale string, zone *time.Location, key string, data any) string {
+	if v, ok := data.(vars); ok {
+		data = v.in(zone)
+	}
+	return b.messages.Render(locale, key, data)
+}
+
+// locale returns locale of the sender detected by middleware,
+// empty one is rendered in the default locale
+func (b *Bot) locale(c telebot.Context) string {
+	locale, _ := c.Get(localeKey).(string)
+	return locale
+}
+
+// detectUser remembers locale and time zone of the sender in update context. It also
+// keeps language reported by Telegram, so notifications are sent in the same language.
+func (b *Bot) detectUser(next telebot.HandlerFunc) telebot.HandlerFunc {
+	return func(c telebot.Context) error {
+		if sender := c.Sender(); sender != nil {
+			user := b.senderUser(sender)
+			c.Set(localeKey, user.Language.Locale())
+			c.Set(zoneKey, user.Location())
+		}
+		return next(c)
+	}
+}
+
+// senderUser returns the sender as stored, an unknown one has only Telegram language
+func (b *Bot) senderUser(sender *telebot.User) *models.User {
+	unknown := &models.User{
+		Username: sender.Username,
+		Language: models.Language{Telegram: sender.LanguageCode},
+	}
+
+	user, err := b.repo.Users().Get(b.ctx, sender.Username)
+	if err != nil {
+		b.log.Warn(errors.WrapFail(err, "get user to detect locale"))
+		return unknown
+	}
+	if user == nil {
+		return unknown
+	}
+
+	if sender.LanguageCode != "" && user.Language.Telegram != sender.LanguageCode {
+		user.Language.Telegram = sender.LanguageCode
+		_, err = b.repo.Users().SetLanguage(b.ctx, user.Username, user.Language)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "save telegram language"))
+		}
+	}
+
+	return user
+}
+
+// words returns list message of n words, falling back to the default locale
+// if an override has broken it
+func (b *Bot) words(locale string, key string, n int) []string {
+	words := b.messages.Words(locale, key)
+	if len(words) != n {
+		return b.messages.Words(b.messages.Default(), key)
+	}
+	return words
+}
+
+func (b *Bot) runLanguage(c telebot.Context, s fsm.Context) error {
+	b.setState(s, languageReadState)
+	return c.Send(b.text(c, "language.prompt", nil), b.languageMarkup(c))
+}
+
+func (b *Bot) languageMarkup(c telebot.Context) *telebot.ReplyMarkup {
+	var rows [][]telebot.InlineButton
+	for _, locale := range b.messages.Locales() {
+		name := b.messages.Render(locale, "language.name", nil)
+		rows = append(rows, []telebot.InlineButton{button(languageBtn, name, locale)})
+	}
+
+	auto := button(languageBtn, b.text(c, "language.auto", nil), languageAuto)
+	rows = append(rows, []telebot.InlineButton{auto})
+
+	return &telebot.ReplyMarkup{InlineKeyboard: rows}
+}
+
+// setLanguage handles both the button and the locale or language name typed by user
+func (b *Bot) setLanguage(c telebot.Context, s fsm.Context) error {
+	var choice string
+	if cb := c.Callback(); cb != nil {
+		b.closeKeyboard(c)
+		choice = cb.Data
+	} else {
+		choice = c.Text()
+	}
+
+	chosen, ok := b.parseLanguage(c, choice)
+	if !ok {
+		return c.Send(b.text(c, "language.unknown", nil))
+	}
+
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	user, err := b.repo.Users().Get(b.ctx, sender.Username)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get user"))
+	}
+	if user == nil {
+		return b.final(c, s, b.text(c, "user.unknown", nil))
+	}
+
+	language := models.Language{
+		Chosen:   chosen,
+		Telegram: cmp.Or(sender.LanguageCode, user.Language.Telegram),
+	}
+
+	updated, err := b.repo.Users().SetLanguage(b.ctx, user.Username, language)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Users.SetLanguage request"))
+	}
+	if updated == nil {
+		return b.final(c, s, b.text(c, "user.unknown", nil))
+	}
+
+	c.Set(localeKey, updated.Language.Locale())
+	return b.final(c, s, b.text(c, "language.saved", nil))
+}
+
+// parseLanguage returns chosen locale, empty one for languageAuto
+func (b *Bot) parseLanguage(c telebot.Context, choice string) (string, bool) {
+	choice = strings.TrimSpace(choice)
+	if choice == languageAuto || strings.EqualFold(choice, b.text(c, "language.auto", nil)) {
+		return "", true
+	}
+
+	if locale, ok := b.messages.Match(choice); ok {
+		return locale, true
+	}
+
+	for _, locale := range b.messages.Locales() {
+		if strings.EqualFold(choice, b.messages.Render(locale, "language.name", nil)) {
+			return locale, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,139 @@
+package telegram
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/i18n"
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func newTestMessages(t *testing.T) *i18n.Catalog {
+	messages, err := i18n.New(i18n.Config{})
+	require.NoError(t, err)
+	return messages
+}
+
+func TestBot_senderUser(t *testing.T) {
+	type testcase struct {
+		name     string
+		known    *models.Language
+		code     string
+		want     string
+		wantUser models.Language
+	}
+
+	tests := [...]testcase{
+		{
+			name: "unknown user",
+			code: "en",
+			want: "en",
+		},
+		{
+			name:     "telegram language is saved",
+			known:    &models.Language{Telegram: "ru"},
+			code:     "en",
+			want:     "en",
+			wantUser: models.Language{Telegram: "en"},
+		},
+		{
+			name:     "chosen language wins",
+			known:    &models.Language{Chosen: "ru"},
+			code:     "en",
+			want:     "ru",
+			wantUser: models.Language{Chosen: "ru", Telegram: "en"},
+		},
+		{
+			name:     "no language code",
+			known:    &models.Language{Telegram: "en"},
+			want:     "en",
+			wantUser: models.Language{Telegram: "en"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+			if tt.known != nil {
+				_, err := client.Users().Upsert(ctx, "cat", nil, nil, nil)
+				require.NoError(t, err)
+				_, err = client.Users().SetLanguage(ctx, "cat", *tt.known)
+				require.NoError(t, err)
+			}
+
+			b := &Bot{ctx: ctx, log: zap.NewNop().Sugar(), repo: client}
+			sender := b.senderUser(&telebot.User{Username: "cat", LanguageCode: tt.code})
+			require.Equal(t, tt.want, sender.Language.Locale())
+
+			if tt.known != nil {
+				user, err := client.Users().Get(ctx, "cat")
+				require.NoError(t, err)
+				require.Equal(t, tt.wantUser, user.Language)
+			}
+		})
+	}
+}
+
+func TestBot_parseLanguage(t *testing.T) {
+	type testcase struct {
+		choice string
+		want   string
+		wantOk bool
+	}
+
+	tests := [...]testcase{
+		{choice: "en", want: "en", wantOk: true},
+		{choice: " en-GB ", want: "en", wantOk: true},
+		{choice: "русский", want: "ru", wantOk: true},
+		{choice: languageAuto, want: "", wantOk: true},
+		{choice: "Как в Telegram", want: "", wantOk: true},
+		{choice: "fr", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.choice, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			cMock := NewMocktelebotContext(ctrl)
+			cMock.EXPECT().Get(gomock.Any()).Return(nil).AnyTimes()
+
+			b := &Bot{messages: newTestMessages(t)}
+
+			got, ok := b.parseLanguage(cMock, tt.choice)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBot_notify_locale(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	tg := int64(42)
+	_, err := client.Users().Upsert(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+	_, err = client.Users().SetLanguage(ctx, "cat", models.Language{Chosen: "en", Telegram: "ru"})
+	require.NoError(t, err)
+
+	b := &Bot{repo: client, messages: newTestMessages(t)}
+
+	msg := message{"interview.cancelled_by_candidate", vars{"ID": "42"}}
+	require.NoError(t, b.notify(ctx, "cat", 0, msg))
+	require.NoError(t, b.notify(ctx, "dog", 7, msg))
+
+	pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, "The candidate has cancelled interview `42`", pending[0].Text)
+	require.Equal(t, "Кандидат отменил собеседование `42`", pending[1].Text, "unknown user gets default locale")
+}
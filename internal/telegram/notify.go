@@ -3,13 +3,9 @@ package telegram
 import (
 	"cmp"
 	"context"
-	"fmt"
 	"slices"
-	"strconv"
 	"time"
 
-	"gopkg.in/telebot.v3"
-
 	"github.com/nikmy/meowbot/internal/repo/models"
 	"github.com/nikmy/meowbot/pkg/errors"
 	"github.com/nikmy/meowbot/pkg/txn"
@@ -32,11 +28,6 @@ func (b *Bot) runNotifier() {
 	}
 }
 
-func (b *Bot) notify(userID int64, msg string) error {
-	_, err := b.bot.Send(models.User{Telegram: userID}, msg, &telebot.SendOptions{ParseMode: telebot.ModeMarkdown})
-	return err
-}
-
 func (b *Bot) watch() {
 	tick := time.NewTicker(b.notifyPeriod)
 	defer tick.Stop()
@@ -62,7 +53,7 @@ type notification struct {
 }
 
 func (b *Bot) sendNeededNotifications() error {
-	now := time.Now().Add(time.Hour * 3).UnixMilli()
+	now := time.Now().UnixMilli()
 	fut := now + b.notifyBefore[len(b.notifyBefore)-1]
 	prv := now - time.Minute.Milliseconds()
 
@@ -76,14 +67,14 @@ func (b *Bot) sendNeededNotifications() error {
 		return nil
 	}
 
-	b.sendAllNotifications(needed)
+	b.enqueueAllNotifications(needed)
 
-	b.log.Debugf("sent %d", len(needed))
+	b.log.Debugf("enqueued %d", len(needed))
 
 	return nil
 }
 
-func (b *Bot) sendAllNotifications(ns []notification) {
+func (b *Bot) enqueueAllNotifications(ns []notification) {
 	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, b.notifyPeriod)
 	if err != nil {
 		b.log.Error(errors.WrapFail(err, "create session context"))
@@ -92,46 +83,22 @@ func (b *Bot) sendAllNotifications(ns []notification) {
 	defer cancel()
 
 	for _, n := range ns {
-		if b.sendOneNotification(ctx, n.Interview.InterviewerTg, n, [2]bool{true}) {
-			b.sendOneNotification(ctx, n.Interview.CandidateTg, n, [2]bool{true, true})
+		err = b.enqueueNotification(ctx, n)
+		if err != nil {
+			b.log.Error(errors.WrapFail(err, "enqueue notification about %s", n.Interview.ID))
 		}
 	}
 }
 
-func (b *Bot) sendOneNotification(ctx context.Context, tgID int64, n notification, roles [2]bool) bool {
-	var msg string
-	if n.LeftTime == 0 {
-		msg = fmt.Sprintf(
-			"Собеседование %s вот-вот начнётся! Подключиться можно по ссылке %s\nУдачи!",
-			n.Interview.ID, n.Interview.Zoom,
-		)
-	} else {
-		var left string
-		weeks, days := int(n.LeftTime.Hours()/168), int(n.LeftTime.Hours()/24)
-		switch {
-		case weeks > 2:
-			left = strconv.Itoa(weeks) + " нед."
-		case days > 2:
-			left = strconv.Itoa(days) + " д."
-		case n.LeftTime.Hours() > 1:
-			left = strconv.Itoa(int(n.LeftTime.Hours())) + " ч."
-		default:
-			left = strconv.Itoa(int(n.LeftTime.Minutes())) + " мин."
-		}
-
-		msg = fmt.Sprintf(
-			"До собеседования `%s` на должность \"%s\" осталось менее %s",
-			n.Interview.ID, n.Interview.Vacancy, left,
-		)
-	}
-
+// enqueueNotification adds messages to outbox in the same txn with notification log,
+// so the next watch iteration neither loses nor duplicates them
+func (b *Bot) enqueueNotification(ctx context.Context, n notification) error {
 	tx, err := txn.New(ctx).
 		SetModel(txn.CausalConsistency).
 		SetIsolation(txn.SnapshotIsolation).
 		Start(ctx)
 	if err != nil {
-		b.log.Error(errors.WrapFail(err, "start txn"))
-		return false
+		return errors.WrapFail(err, "start txn")
 	}
 	defer func() {
 		err := tx.Close(ctx)
@@ -140,25 +107,43 @@ func (b *Bot) sendOneNotification(ctx context.Context, tgID int64, n notificatio
 		}
 	}()
 
-	err = b.notify(tgID, msg)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "notify user %d", tgID))
-		return false
+	msg := notificationText(n)
+	for _, role := range n.Recipients {
+		if role == models.RoleInterviewer {
+			err = b.notifyPanel(ctx, n.Interview, msg, "")
+		} else {
+			err = b.notify(ctx, n.Interview.CandidateUN, n.Interview.CandidateTg, msg)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	err = b.repo.Interviews().Notify(ctx, n.Interview.ID, n.NotifyTime, roles)
+	err = b.repo.Interviews().Notify(ctx, n.Interview.ID, n.NotifyTime, [2]bool{true, true})
 	if err != nil {
-		b.log.Error(errors.WrapFail(err, "do Interviews.Notify request"))
-		return false
+		return errors.WrapFail(err, "do Interviews.Notify request")
 	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		b.log.Error(errors.WrapFail(err, "commit txn"))
-		return false
+	return errors.WrapFail(tx.Commit(ctx), "commit txn")
+}
+
+func notificationText(n notification) message {
+	if n.LeftTime == 0 {
+		return message{"reminder.now", vars{"ID": n.Interview.ID, "Zoom": n.Interview.Zoom}}
+	}
+
+	var start any = ""
+	if n.Interview.Meet != nil {
+		start = moment(n.Interview.Meet[0])
 	}
 
-	return true
+	return message{"reminder.left", vars{
+		"ID":       n.Interview.ID,
+		"Vacancy":  n.Interview.Vacancy,
+		"Time":     start,
+		"Left":     n.LeftTime,
+		"Duration": n.Interview.MeetDuration(),
+	}}
 }
 
 func (b *Bot) getNeededNotifications(now int64, upcoming []*models.Interview) []notification {
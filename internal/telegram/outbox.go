@@ -0,0 +1,225 @@
+package telegram
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/notify"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const (
+	defaultOutboxPeriod       = time.Second
+	defaultOutboxBatchSize    = 32
+	defaultOutboxMaxAttempts  = 10
+	defaultOutboxBackoff      = 5 * time.Second
+	defaultOutboxMaxBackoff   = 10 * time.Minute
+	defaultOutboxClaimTimeout = time.Minute
+)
+
+// notify enqueues message in the user's locale and time zone via preferred channels, falling back
+// to telegram if none of them is configured. When ctx has a running txn,
+// messages are delivered only if the txn is committed.
+func (b *Bot) notify(ctx context.Context, username string, tg int64, msg message) error {
+	user, err := b.recipient(ctx, username, tg)
+	if err != nil {
+		return err
+	}
+
+	text := b.render(user.Language.Locale(), user.Location(), msg.key, msg.data)
+
+	for _, to := range b.contacts(user) {
+		_, err = b.repo.Outbox().Add(ctx, to, text)
+		if err != nil {
+			return errors.WrapFail(err, "enqueue message to %s %s", to.Channel, to.Address)
+		}
+	}
+
+	return nil
+}
+
+// recipient finds the user by username, tg is used if the user is not known
+func (b *Bot) recipient(ctx context.Context, username string, tg int64) (*models.User, error) {
+	user := &models.User{Username: username, Telegram: tg}
+	if username == "" {
+		return user, nil
+	}
+
+	found, err := b.repo.Users().Get(ctx, username)
+	if err != nil {
+		return nil, errors.WrapFail(err, "get user %s", username)
+	}
+	if found != nil {
+		user = found
+		user.Telegram = cmp.Or(user.Telegram, tg)
+	}
+
+	return user, nil
+}
+
+// contacts returns addresses of the user in configured channels
+func (b *Bot) contacts(user *models.User) []models.Contact {
+	contacts := slices.DeleteFunc(user.Contacts(), func(c models.Contact) bool {
+		_, configured := b.channels[c.Channel]
+		return !configured
+	})
+
+	if len(contacts) == 0 && user.Telegram != 0 {
+		contacts = append(contacts, models.Contact{Channel: models.ChannelTelegram, Address: user.Recipient()})
+	}
+
+	return contacts
+}
+
+// dispatcher delivers outbox messages. Each message is claimed before sending,
+// so replicas do not send it twice, and it is marked sent after. A message is
+// sent again only if the replica dies between sending and marking it.
+type dispatcher struct {
+	log      *zap.SugaredLogger
+	cfg      OutboxConfig
+	outbox   models.OutboxRepo
+	channels map[models.NotificationChannel]notify.Channel
+	now      func() time.Time
+}
+
+func newDispatcher(
+	log *zap.SugaredLogger,
+	cfg OutboxConfig,
+	outbox models.OutboxRepo,
+	channels map[models.NotificationChannel]notify.Channel,
+) *dispatcher {
+	if cfg.Period <= 0 {
+		cfg.Period = defaultOutboxPeriod
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultOutboxBatchSize
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultOutboxMaxAttempts
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = defaultOutboxBackoff
+	}
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = max(defaultOutboxMaxBackoff, cfg.Backoff)
+	}
+	if cfg.ClaimTimeout <= 0 {
+		cfg.ClaimTimeout = defaultOutboxClaimTimeout
+	}
+
+	return &dispatcher{
+		log:      log,
+		cfg:      cfg,
+		outbox:   outbox,
+		channels: channels,
+		now:      time.Now,
+	}
+}
+
+func (d *dispatcher) run(ctx context.Context) {
+	tick := time.NewTicker(d.cfg.Period)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			// drain the backlog without waiting for the next tick
+			n := d.dispatch(ctx)
+			for n == d.cfg.BatchSize && ctx.Err() == nil {
+				n = d.dispatch(ctx)
+			}
+		}
+	}
+}
+
+// dispatch tries to deliver one batch of due messages, returns its size
+func (d *dispatcher) dispatch(ctx context.Context) int {
+	pending, err := d.outbox.Pending(ctx, d.now().UnixMilli(), d.cfg.BatchSize)
+	if err != nil {
+		d.log.Error(errors.WrapFail(err, "get pending messages"))
+		return 0
+	}
+
+	for _, msg := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+		d.deliver(ctx, msg)
+	}
+
+	return len(pending)
+}
+
+func (d *dispatcher) deliver(ctx context.Context, msg models.OutboxMessage) {
+	now := d.now()
+
+	claimed, err := d.outbox.Claim(ctx, msg.ID, msg.NextAttempt, now.Add(d.cfg.ClaimTimeout).UnixMilli())
+	if err != nil {
+		d.log.Error(errors.WrapFail(err, "claim message %s", msg.ID))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	sendErr := d.send(ctx, msg)
+	if sendErr == nil {
+		err = d.outbox.Sent(ctx, msg.ID, d.now().UnixMilli())
+		if err != nil {
+			d.log.Error(errors.WrapFail(err, "mark message %s sent", msg.ID))
+		}
+		return
+	}
+
+	attempts := msg.Attempts + 1
+
+	var next *int64
+	if attempts < d.cfg.MaxAttempts && !errors.Is(sendErr, notify.ErrPermanent) {
+		at := d.now().Add(d.backoff(attempts, sendErr)).UnixMilli()
+		next = &at
+	}
+
+	if next == nil {
+		d.log.Warn(errors.WrapFail(sendErr, "deliver message %s via %s, giving up", msg.ID, msg.Channel))
+	} else {
+		d.log.Debug(errors.WrapFail(sendErr, "deliver message %s via %s", msg.ID, msg.Channel))
+	}
+
+	err = d.outbox.Retry(ctx, msg.ID, sendErr.Error(), next)
+	if err != nil {
+		d.log.Error(errors.WrapFail(err, "record failure of message %s", msg.ID))
+	}
+}
+
+func (d *dispatcher) send(ctx context.Context, msg models.OutboxMessage) error {
+	ch, ok := d.channels[msg.Channel]
+	if !ok {
+		return notify.Permanent(errors.Error("channel %s is not configured", msg.Channel))
+	}
+
+	return ch.Send(ctx, msg.Address, msg.Text)
+}
+
+// backoff doubles delay after each failed attempt, respecting flood control of Telegram
+func (d *dispatcher) backoff(attempts int, err error) time.Duration {
+	delay := d.cfg.Backoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, d.cfg.MaxBackoff)
+
+	var flood telebot.FloodError
+	if errors.As(err, &flood) {
+		delay = max(delay, time.Duration(flood.RetryAfter)*time.Second)
+	}
+
+	return delay
+}
@@ -0,0 +1,283 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/notify"
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+func Test_dispatcher(t *testing.T) {
+	type testcase struct {
+		name string
+
+		// errs are returned by send one by one, then it succeeds
+		errs []error
+
+		wantSent     int
+		wantStatus   models.OutboxStatus
+		wantAttempts int
+	}
+
+	tests := [...]testcase{
+		{
+			name:         "delivered",
+			wantSent:     1,
+			wantStatus:   models.OutboxStatusSent,
+			wantAttempts: 1,
+		},
+		{
+			name:         "delivered after retries",
+			errs:         []error{errors.New("timeout"), errors.New("timeout")},
+			wantSent:     1,
+			wantStatus:   models.OutboxStatusSent,
+			wantAttempts: 3,
+		},
+		{
+			name:         "gave up after max attempts",
+			errs:         []error{errors.New("1"), errors.New("2"), errors.New("3"), errors.New("4")},
+			wantStatus:   models.OutboxStatusFailed,
+			wantAttempts: 3,
+		},
+		{
+			name:         "permanent failure",
+			errs:         []error{notify.Permanent(telebot.ErrBlockedByUser)},
+			wantStatus:   models.OutboxStatusFailed,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+			to := models.Contact{Channel: models.ChannelTelegram, Address: "42"}
+			id, err := client.Outbox().Add(ctx, to, "hello")
+			require.NoError(t, err)
+
+			var (
+				sent  int
+				calls int
+			)
+			send := func(_ context.Context, address string, msg string) error {
+				require.Equal(t, "42", address)
+				require.Equal(t, "hello", msg)
+
+				calls++
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+				sent++
+				return nil
+			}
+
+			outbox := newRecordingOutbox(client.Outbox())
+			d := newDispatcher(
+				zap.NewNop().Sugar(),
+				OutboxConfig{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute},
+				outbox,
+				map[models.NotificationChannel]notify.Channel{models.ChannelTelegram: channelFunc(send)},
+			)
+
+			now := time.Now()
+			d.now = func() time.Time { return now }
+
+			for range 5 {
+				d.dispatch(ctx)
+				require.Zero(t, d.dispatch(ctx), "message is not due until backoff passes")
+				now = now.Add(time.Hour)
+			}
+
+			require.Equal(t, tt.wantSent, sent)
+			require.Equal(t, tt.wantAttempts, calls)
+
+			require.Equal(t, tt.wantStatus, outbox.status[id])
+			require.Equal(t, tt.wantAttempts, outbox.attempts[id])
+
+			pending, err := client.Outbox().Pending(ctx, now.UnixMilli(), 10)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		})
+	}
+}
+
+func Test_dispatcher_backoff(t *testing.T) {
+	d := newDispatcher(
+		zap.NewNop().Sugar(),
+		OutboxConfig{Backoff: time.Second, MaxBackoff: 5 * time.Second},
+		nil,
+		nil,
+	)
+
+	require.Equal(t, time.Second, d.backoff(1, errors.New("mock")))
+	require.Equal(t, 2*time.Second, d.backoff(2, errors.New("mock")))
+	require.Equal(t, 4*time.Second, d.backoff(3, errors.New("mock")))
+	require.Equal(t, 5*time.Second, d.backoff(10, errors.New("mock")))
+	require.Equal(t, 30*time.Second, d.backoff(1, telebot.FloodError{RetryAfter: 30}))
+}
+
+func TestBot_enqueueAllNotifications(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	id, err := client.Interviews().Create(ctx, "go", "cat", time.Hour)
+	require.NoError(t, err)
+
+	b := &Bot{
+		ctx:          ctx,
+		log:          zap.NewNop().Sugar(),
+		repo:         client,
+		txm:          txn.NewManager(client),
+		notifyPeriod: time.Second,
+		messages:     newTestMessages(t),
+	}
+
+	b.enqueueAllNotifications([]notification{{
+		Interview:  &models.Interview{ID: id, CandidateTg: 1, InterviewerTg: 2},
+		Recipients: []models.Role{models.RoleInterviewer, models.RoleCandidate},
+		NotifyTime: 100,
+		LeftTime:   time.Hour,
+	}})
+
+	pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "2"}, pending[0].Contact)
+	require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "1"}, pending[1].Contact)
+
+	i, err := client.Interviews().Find(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, &models.NotificationLog{UnixTime: 100, Notified: [2]bool{true, true}}, i.LastNotification)
+}
+
+func TestBot_notify(t *testing.T) {
+	type testcase struct {
+		name     string
+		user     *models.Notifications
+		channels []models.NotificationChannel
+		want     []models.Contact
+	}
+
+	telegram := models.Contact{Channel: models.ChannelTelegram, Address: "42"}
+	email := models.Contact{Channel: models.ChannelEmail, Address: "cat@example.com"}
+	webhook := models.Contact{Channel: models.ChannelWebhook, Address: "cat"}
+
+	tests := [...]testcase{
+		{
+			name:     "unknown user",
+			channels: []models.NotificationChannel{models.ChannelTelegram, models.ChannelEmail},
+			want:     []models.Contact{telegram},
+		},
+		{
+			name:     "no preferences",
+			user:     &models.Notifications{Email: "cat@example.com"},
+			channels: []models.NotificationChannel{models.ChannelTelegram, models.ChannelEmail},
+			want:     []models.Contact{telegram},
+		},
+		{
+			name: "preferred channels",
+			user: &models.Notifications{
+				Email:    "cat@example.com",
+				Channels: []models.NotificationChannel{models.ChannelEmail, models.ChannelWebhook},
+			},
+			channels: []models.NotificationChannel{models.ChannelTelegram, models.ChannelEmail, models.ChannelWebhook},
+			want:     []models.Contact{email, webhook},
+		},
+		{
+			name: "preferred channel is not configured",
+			user: &models.Notifications{
+				Email:    "cat@example.com",
+				Channels: []models.NotificationChannel{models.ChannelEmail},
+			},
+			channels: []models.NotificationChannel{models.ChannelTelegram},
+			want:     []models.Contact{telegram},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+			if tt.user != nil {
+				_, err := client.Users().Upsert(ctx, "cat", nil, nil, nil)
+				require.NoError(t, err)
+				_, err = client.Users().SetNotifications(ctx, "cat", *tt.user)
+				require.NoError(t, err)
+			}
+
+			b := &Bot{
+				repo:     client,
+				messages: newTestMessages(t),
+				channels: make(map[models.NotificationChannel]notify.Channel),
+			}
+			for _, ch := range tt.channels {
+				b.channels[ch] = channelFunc(nil)
+			}
+
+			require.NoError(t, b.notify(ctx, "cat", 42, message{"fail", nil}))
+
+			pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+			require.NoError(t, err)
+
+			got := make([]models.Contact, 0, len(pending))
+			for _, msg := range pending {
+				got = append(got, msg.Contact)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type channelFunc func(ctx context.Context, address string, text string) error
+
+func (f channelFunc) Send(ctx context.Context, address string, text string) error {
+	return f(ctx, address, text)
+}
+
+// recordingOutbox remembers what dispatcher has done with messages,
+// because finished ones are not visible through OutboxRepo
+type recordingOutbox struct {
+	models.OutboxRepo
+
+	status   map[string]models.OutboxStatus
+	attempts map[string]int
+}
+
+func newRecordingOutbox(outbox models.OutboxRepo) *recordingOutbox {
+	return &recordingOutbox{
+		OutboxRepo: outbox,
+		status:     make(map[string]models.OutboxStatus),
+		attempts:   make(map[string]int),
+	}
+}
+
+func (r *recordingOutbox) Claim(ctx context.Context, id string, at int64, until int64) (bool, error) {
+	claimed, err := r.OutboxRepo.Claim(ctx, id, at, until)
+	if claimed {
+		r.attempts[id]++
+	}
+	return claimed, err
+}
+
+func (r *recordingOutbox) Sent(ctx context.Context, id string, at int64) error {
+	r.status[id] = models.OutboxStatusSent
+	return r.OutboxRepo.Sent(ctx, id, at)
+}
+
+func (r *recordingOutbox) Retry(ctx context.Context, id string, reason string, next *int64) error {
+	if next == nil {
+		r.status[id] = models.OutboxStatusFailed
+	}
+	return r.OutboxRepo.Retry(ctx, id, reason, next)
+}
@@ -0,0 +1,373 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+var outcomeBtn = &telebot.Btn{Unique: "outcome"}
+
+const (
+	outcomeHappened = "happened"
+	outcomeNoShow   = "no_show"
+
+	// outcomeCatchUp is how long closed outcome windows are looked back for,
+	// ones closed while the bot has been down for longer are left to HR
+	outcomeCatchUp = 7 * 24 * time.Hour
+)
+
+// participantRole returns the role of the user in the interview, false if the user does not participate.
+// Panelists answer for the interviewers' side as well as the lead interviewer.
+func participantRole(i *models.Interview, username string) (models.Role, bool) {
+	switch {
+	case username == "":
+		return 0, false
+	case username == i.CandidateUN:
+		return models.RoleCandidate, true
+	case i.IsInterviewer(username):
+		return models.RoleInterviewer, true
+	default:
+		return 0, false
+	}
+}
+
+func (b *Bot) watchEnded() {
+	tick := time.NewTicker(b.scorecards.Period)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-tick.C:
+			err := b.finishEnded()
+			if err != nil {
+				b.log.Error(errors.WrapFail(err, "finish ended interviews"))
+			}
+
+			err = b.closeOutcomes()
+			if err != nil {
+				b.log.Error(errors.WrapFail(err, "close outcomes"))
+			}
+		}
+	}
+}
+
+// finishEnded marks interviews finished when their meetings are over
+// and asks participants whether the meetings have taken place
+func (b *Bot) finishEnded() error {
+	now := b.time.Now().UnixMilli()
+	scheduled := models.InterviewStatusScheduled
+
+	started, err := b.repo.Interviews().List(b.ctx, models.InterviewsFilter{Status: &scheduled, To: &now})
+	if err != nil {
+		return errors.WrapFail(err, "list started interviews")
+	}
+
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, b.scorecards.Period)
+	if err != nil {
+		return errors.WrapFail(err, "create session context")
+	}
+	defer cancel()
+
+	for _, i := range started {
+		if i.Meet == nil || i.Meet[1] > now {
+			continue
+		}
+
+		err = b.finishInterview(ctx, i.ID)
+		if err != nil {
+			b.log.Error(errors.WrapFail(err, "finish interview %s", i.ID))
+		}
+	}
+
+	return nil
+}
+
+// finishInterview marks the interview done and enqueues questions to participants in the same txn,
+// so they are asked exactly once even if several replicas finish it
+func (b *Bot) finishInterview(ctx context.Context, id string) error {
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "start txn")
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, id)
+	if err != nil {
+		return errors.WrapFail(err, "find interview")
+	}
+	if i == nil || i.Status != models.InterviewStatusScheduled {
+		// it has been finished or cancelled meanwhile
+		return nil
+	}
+
+	err = b.repo.Interviews().Done(ctx, id)
+	if err != nil {
+		return errors.WrapFail(err, "do Interviews.Done request")
+	}
+
+	err = b.NotifyFinished(ctx, i)
+	if err != nil {
+		return errors.WrapFail(err, "notify participants")
+	}
+
+	return errors.WrapFail(tx.Commit(ctx), "commit txn")
+}
+
+// outcomeOpen reports whether participants may still report the outcome of the interview
+func (b *Bot) outcomeOpen(i *models.Interview) bool {
+	if i.Meet == nil {
+		return true
+	}
+	return b.time.Now().Before(time.UnixMilli(i.Meet[1]).Add(b.scorecards.OutcomeWindow))
+}
+
+// closeOutcomes rejects applications of candidates reported absent once the outcome window is over.
+// Until then the report of one side is not final: the other side may still dispute it.
+func (b *Bot) closeOutcomes() error {
+	closed := b.time.Now().Add(-b.scorecards.OutcomeWindow)
+	from := closed.Add(-models.MaxInterviewDuration - outcomeCatchUp).UnixMilli()
+	to := closed.UnixMilli()
+
+	finished, noShow := models.InterviewStatusFinished, models.OutcomeCandidateNoShow
+	absent, err := b.repo.Interviews().List(b.ctx, models.InterviewsFilter{
+		Status:  &finished,
+		Outcome: &noShow,
+		From:    &from,
+		To:      &to,
+	})
+	if err != nil {
+		return errors.WrapFail(err, "list candidate no-shows")
+	}
+
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, b.scorecards.Period)
+	if err != nil {
+		return errors.WrapFail(err, "create session context")
+	}
+	defer cancel()
+
+	for _, i := range absent {
+		if i.Meet == nil || i.Meet[1] > to {
+			continue
+		}
+
+		err = b.rejectAbsent(ctx, i.ID)
+		if err != nil {
+			b.log.Error(errors.WrapFail(err, "reject absent candidate of %s", i.ID))
+		}
+	}
+
+	return nil
+}
+
+// rejectAbsent closes the application at the stage the candidate has not come to,
+// it does nothing if the application has moved on already
+func (b *Bot) rejectAbsent(ctx context.Context, id string) error {
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return errors.WrapFail(err, "start txn")
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, id)
+	if err != nil {
+		return errors.WrapFail(err, "find interview")
+	}
+	if i == nil || i.Outcome != models.OutcomeCandidateNoShow {
+		return nil
+	}
+
+	err = b.advancePipeline(ctx, i, false)
+	if err != nil {
+		return err
+	}
+
+	return errors.WrapFail(tx.Commit(ctx), "commit txn")
+}
+
+func (b *Bot) runOutcome(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	interviews, err := b.repo.Interviews().FindByUser(b.ctx, sender.Username)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find by user"))
+	}
+
+	var pending []vars
+	for _, i := range interviews {
+		role, ok := participantRole(i, sender.Username)
+		if ok && i.Status == models.InterviewStatusFinished && i.Attendance[role] == models.AttendanceUnknown &&
+			b.outcomeOpen(i) {
+			pending = append(pending, vars{"ID": i.ID, "Vacancy": i.Vacancy})
+		}
+	}
+
+	b.setState(s, outcomeReadIIDState)
+	return c.Send(b.text(c, "outcome.ask_id", vars{"Pending": pending}))
+}
+
+func (b *Bot) outcomeReadIID(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	iid := strings.TrimSpace(c.Text())
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+	if _, ok := participantRole(i, sender.Username); !ok {
+		return b.final(c, s, b.text(c, "deny.not_participant", nil))
+	}
+	if i.Status != models.InterviewStatusFinished {
+		return b.final(c, s, b.text(c, "outcome.not_finished", nil))
+	}
+	if !b.outcomeOpen(i) {
+		return b.final(c, s, b.text(c, "outcome.closed", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	b.setState(s, outcomeReadAnswerState)
+	return c.Send(b.text(c, "outcome.ask", vars{"ID": iid}), b.outcomeMarkup(c))
+}
+
+func (b *Bot) outcomeMarkup(c telebot.Context) *telebot.ReplyMarkup {
+	return &telebot.ReplyMarkup{InlineKeyboard: [][]telebot.InlineButton{{
+		button(outcomeBtn, b.text(c, "outcome.happened", nil), outcomeHappened),
+		button(outcomeBtn, b.text(c, "outcome.no_show", nil), outcomeNoShow),
+	}}}
+}
+
+func (b *Bot) outcomeAnswer(c telebot.Context, s fsm.Context) error {
+	var choice string
+	if cb := c.Callback(); cb != nil {
+		b.closeKeyboard(c)
+		choice = cb.Data
+	} else {
+		choice = strings.TrimSpace(c.Text())
+	}
+
+	var attendance models.Attendance
+	switch {
+	case choice == outcomeHappened || strings.EqualFold(choice, b.text(c, "outcome.happened", nil)):
+		attendance = models.AttendanceHappened
+	case choice == outcomeNoShow || strings.EqualFold(choice, b.text(c, "outcome.no_show", nil)):
+		attendance = models.AttendanceNoShow
+	default:
+		return c.Send(b.text(c, "outcome.ask_offered", nil), b.outcomeMarkup(c))
+	}
+
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get iid from state"))
+	}
+
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*5)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "create session context"))
+	}
+	defer cancel()
+
+	// the other participant may answer at the same time
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "start txn"))
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	role, ok := participantRole(i, sender.Username)
+	if !ok {
+		return b.final(c, s, b.text(c, "deny.not_participant", nil))
+	}
+	if !b.outcomeOpen(i) {
+		// the pipeline may have been decided by the outcome already
+		return b.final(c, s, b.text(c, "outcome.closed", nil))
+	}
+
+	reports := i.Attendance
+	reports[role] = attendance
+
+	err = b.repo.Interviews().Attend(ctx, iid, reports)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Interviews.Attend request"))
+	}
+
+	// another participant may have resolved the same outcome already. A candidate no-show
+	// is not final until the window is over, closeOutcomes rejects the application then.
+	outcome := models.ResolveOutcome(reports)
+	if outcome != i.Outcome && outcome == models.OutcomeDone && i.Scorecard == nil {
+		// the lead interviewer rates the candidate once the meeting is known to have taken place
+		err = b.notify(ctx, i.InterviewerUN, i.InterviewerTg, message{"scorecard.prompt", vars{
+			"ID":      i.ID,
+			"Vacancy": i.Vacancy,
+		}})
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "notify interviewer"))
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
+	}
+
+	return b.final(c, s, b.text(c, "outcome.saved", nil))
+}
@@ -0,0 +1,281 @@
+package telegram
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+func TestBot_finishEnded(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	now := time.UnixMilli(10_000)
+
+	schedule := func(meet models.Meeting) string {
+		id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+		require.NoError(t, err)
+
+		err = client.Interviews().Schedule(
+			ctx, id,
+			models.User{Username: "cand", Telegram: 1},
+			models.User{Username: "int", Telegram: 2},
+			[]models.Panelist{{Username: "panelist", Telegram: 3}},
+			meet,
+		)
+		require.NoError(t, err)
+		return id
+	}
+
+	ended := schedule(models.Meeting{1_000, 5_000})
+	running := schedule(models.Meeting{5_000, 20_000})
+	upcoming := schedule(models.Meeting{20_000, 30_000})
+
+	tMock := NewMockTimeProvider(gomock.NewController(t))
+	tMock.EXPECT().Now().Return(now).AnyTimes()
+
+	b := &Bot{
+		ctx:        ctx,
+		log:        zap.NewNop().Sugar(),
+		repo:       client,
+		txm:        txn.NewManager(client),
+		time:       tMock,
+		messages:   newTestMessages(t),
+		scorecards: ScorecardsConfig{Period: time.Second},
+	}
+
+	require.NoError(t, b.finishEnded())
+	require.NoError(t, b.finishEnded(), "finished interviews are skipped")
+
+	for id, want := range map[string]models.InterviewStatus{
+		ended:    models.InterviewStatusFinished,
+		running:  models.InterviewStatusScheduled,
+		upcoming: models.InterviewStatusScheduled,
+	} {
+		i, err := client.Interviews().Find(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, want, i.Status, id)
+	}
+
+	pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 3, "participants are asked once")
+	require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "1"}, pending[0].Contact)
+	require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "2"}, pending[1].Contact)
+	require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "3"}, pending[2].Contact)
+	for _, msg := range pending {
+		require.Contains(t, msg.Text, ended)
+		require.Contains(t, msg.Text, "/outcome")
+	}
+}
+
+func TestBot_outcomeAnswer(t *testing.T) {
+	type testcase struct {
+		name        string
+		sender      string
+		answer      string
+		late        bool
+		wantOutcome models.Outcome
+		wantText    string
+		wantPrompt  bool
+
+		wantApplication models.ApplicationStatus
+	}
+
+	tests := [...]testcase{
+		{
+			name:        "interviewer confirms",
+			sender:      "int",
+			answer:      "Состоялось",
+			wantOutcome: models.OutcomeDone,
+			wantText:    "Ответ сохранён",
+			wantPrompt:  true,
+		},
+		{
+			name:        "panelist confirms",
+			sender:      "panelist",
+			answer:      "Состоялось",
+			wantOutcome: models.OutcomeDone,
+			wantText:    "Ответ сохранён",
+			wantPrompt:  true,
+		},
+		{
+			name:        "candidate has not come",
+			sender:      "int",
+			answer:      "собеседник не пришёл",
+			wantOutcome: models.OutcomeCandidateNoShow,
+			wantText:    "Ответ сохранён",
+		},
+		{
+			name:        "window is over",
+			sender:      "int",
+			answer:      "Состоялось",
+			late:        true,
+			wantOutcome: models.OutcomeUnknown,
+			wantText:    "Срок ответа о том, состоялось ли собеседование, истёк",
+		},
+		{
+			name:        "interviewer has not come",
+			sender:      "cand",
+			answer:      "Собеседник не пришёл",
+			wantOutcome: models.OutcomeInterviewerNoShow,
+			wantText:    "Ответ сохранён",
+		},
+		{
+			name:        "not a participant",
+			sender:      "cat",
+			answer:      "Состоялось",
+			wantOutcome: models.OutcomeUnknown,
+			wantText:    "Вы не являетесь участником собеседования",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+			id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+			require.NoError(t, err)
+			require.NoError(t, client.Interviews().Schedule(
+				ctx, id,
+				models.User{Username: "cand"},
+				models.User{Username: "int", Telegram: 2},
+				[]models.Panelist{{Username: "panelist"}},
+				models.Meeting{100, 200},
+			))
+			require.NoError(t, client.Interviews().Done(ctx, id))
+
+			application, err := client.Applications().Create(ctx, "go", "cand", id)
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+
+			cMock := NewMocktelebotContext(ctrl)
+			cMock.EXPECT().Callback().Return(nil)
+			cMock.EXPECT().Text().Return(tt.answer)
+			cMock.EXPECT().Get(gomock.Any()).Return(nil).AnyTimes()
+			cMock.EXPECT().Sender().Return(&telebot.User{Username: tt.sender})
+			cMock.EXPECT().Send(tt.wantText).Return(nil)
+
+			sMock := NewMockfsmContext(ctrl)
+			sMock.EXPECT().Get("iid", gomock.Any()).DoAndReturn(func(_ string, to any) error {
+				*to.(*string) = id
+				return nil
+			})
+			sMock.EXPECT().Finish(true).Return(nil)
+
+			now := time.UnixMilli(200)
+			if tt.late {
+				now = now.Add(time.Hour)
+			}
+			tMock := NewMockTimeProvider(ctrl)
+			tMock.EXPECT().Now().Return(now).AnyTimes()
+
+			b := &Bot{
+				ctx:        ctx,
+				log:        zap.NewNop().Sugar(),
+				repo:       client,
+				sched:      scheduling.New(client),
+				txm:        txn.NewManager(client),
+				time:       tMock,
+				messages:   newTestMessages(t),
+				scorecards: ScorecardsConfig{OutcomeWindow: time.Hour},
+			}
+
+			require.NoError(t, b.outcomeAnswer(cMock, sMock))
+
+			i, err := client.Interviews().Find(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantOutcome, i.Outcome)
+
+			found, err := client.Applications().Get(ctx, application)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantApplication, found.Status)
+
+			pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+			require.NoError(t, err)
+			if !tt.wantPrompt {
+				require.Empty(t, pending)
+				return
+			}
+			require.Len(t, pending, 1, "lead interviewer is asked for the scorecard")
+			require.Equal(t, models.Contact{Channel: models.ChannelTelegram, Address: "2"}, pending[0].Contact)
+			require.Contains(t, pending[0].Text, id)
+			require.Contains(t, pending[0].Text, "/scorecard")
+		})
+	}
+}
+
+func TestBot_closeOutcomes(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	now := time.UnixMilli(0).Add(48 * time.Hour)
+
+	finish := func(meet models.Meeting, byInterviewer, byCandidate models.Attendance) string {
+		id, err := client.Interviews().Create(ctx, "go", "cand", 0)
+		require.NoError(t, err)
+		require.NoError(t, client.Interviews().Schedule(
+			ctx, id,
+			models.User{Username: "cand"},
+			models.User{Username: "int"},
+			nil,
+			meet,
+		))
+		require.NoError(t, client.Interviews().Done(ctx, id))
+
+		attendance := [2]models.Attendance{}
+		attendance[models.RoleInterviewer] = byInterviewer
+		attendance[models.RoleCandidate] = byCandidate
+		require.NoError(t, client.Interviews().Attend(ctx, id, attendance))
+
+		application, err := client.Applications().Create(ctx, "go", "cand", id)
+		require.NoError(t, err)
+		return application
+	}
+
+	long := models.Meeting{1_000, 2_000}
+	recent := models.Meeting{now.Add(-time.Hour).UnixMilli(), now.Add(-time.Hour / 2).UnixMilli()}
+
+	closed := finish(long, models.AttendanceNoShow, models.AttendanceUnknown)
+	open := finish(recent, models.AttendanceNoShow, models.AttendanceUnknown)
+	disputed := finish(long, models.AttendanceNoShow, models.AttendanceHappened)
+
+	tMock := NewMockTimeProvider(gomock.NewController(t))
+	tMock.EXPECT().Now().Return(now).AnyTimes()
+
+	b := &Bot{
+		ctx:        ctx,
+		log:        zap.NewNop().Sugar(),
+		repo:       client,
+		sched:      scheduling.New(client),
+		txm:        txn.NewManager(client),
+		time:       tMock,
+		messages:   newTestMessages(t),
+		scorecards: ScorecardsConfig{Period: time.Second, OutcomeWindow: 24 * time.Hour},
+	}
+
+	require.NoError(t, b.closeOutcomes())
+	require.NoError(t, b.closeOutcomes(), "closed applications are skipped")
+
+	for id, want := range map[string]models.ApplicationStatus{
+		closed:   models.ApplicationStatusRejected,
+		open:     models.ApplicationStatusActive,
+		disputed: models.ApplicationStatusActive,
+	} {
+		found, err := client.Applications().Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, want, found.Status, id)
+	}
+}
@@ -0,0 +1,98 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+func (b *Bot) runSetPanel(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, setPanelReadIIDState)
+	return c.Send(b.text(c, "interview.ask_id", nil))
+}
+
+func (b *Bot) setPanelReadIID(c telebot.Context, s fsm.Context) error {
+	iid := c.Text()
+
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview by id"))
+	}
+
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	b.setState(s, setPanelReadSizeState)
+	return c.Send(b.text(c, "panel.ask", vars{
+		"Interviewers": max(1, i.Panel.Interviewers),
+		"Shadows":      i.Panel.Shadows,
+		"Max":          models.MaxPanelSize,
+	}))
+}
+
+func (b *Bot) setPanel(c telebot.Context, s fsm.Context) error {
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get iid from state"))
+	}
+
+	panel, ok := parsePanel(c.Text())
+	if !ok || panel.Validate() != nil {
+		return c.Send(b.text(c, "panel.bad", vars{"Max": models.MaxPanelSize}))
+	}
+
+	err = b.repo.Interviews().SetPanel(b.ctx, iid, panel)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "set panel"))
+	}
+
+	return b.final(
+		c, s,
+		b.text(c, "panel.saved", vars{"ID": iid, "Interviewers": panel.Interviewers, "Shadows": panel.Shadows}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
+}
+
+// parsePanel reads "interviewers [shadows]", omitted shadows mean none
+func parsePanel(text string) (models.Panel, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || len(fields) > 2 {
+		return models.Panel{}, false
+	}
+
+	sizes := make([]int, 2)
+	for idx, field := range fields {
+		size, err := strconv.Atoi(field)
+		if err != nil {
+			return models.Panel{}, false
+		}
+		sizes[idx] = size
+	}
+
+	if sizes[0] < 1 {
+		return models.Panel{}, false
+	}
+
+	return models.Panel{Interviewers: sizes[0], Shadows: sizes[1]}, true
+}
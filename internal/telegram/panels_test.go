@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func Test_parsePanel(t *testing.T) {
+	type testcase struct {
+		name   string
+		text   string
+		want   models.Panel
+		wantOk bool
+	}
+
+	tests := [...]testcase{
+		{name: "interviewers only", text: " 2 ", want: models.Panel{Interviewers: 2}, wantOk: true},
+		{name: "with shadows", text: "1 2", want: models.Panel{Interviewers: 1, Shadows: 2}, wantOk: true},
+		{name: "no interviewers", text: "0 1"},
+		{name: "too many numbers", text: "1 1 1"},
+		{name: "empty", text: " "},
+		{name: "garbage", text: "два"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parsePanel(tt.text)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package telegram
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/internal/scheduling"
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+func (b *Bot) runApply(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, applyReadVacancyState)
+	err := b.askVacancy(c, "apply.ask_vacancy")
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	return nil
+}
+
+func (b *Bot) applyReadVacancy(c telebot.Context, s fsm.Context) error {
+	vacancy := b.vacancyChoice(c)
+
+	found, err := b.repo.Vacancies().Get(b.ctx, vacancy)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find vacancy"))
+	}
+	if found == nil {
+		return b.final(c, s, b.text(c, "vacancy.not_found", nil))
+	}
+
+	err = s.Update("vac", vacancy)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with vac"))
+	}
+
+	b.setState(s, applyReadCTgState)
+	return c.Send(b.text(c, "create.ask_candidate", nil))
+}
+
+func (b *Bot) apply(c telebot.Context, s fsm.Context) error {
+	var vacancy string
+	err := s.Get("vac", &vacancy)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get vacancy from state"))
+	}
+
+	tg, ok := b.readTg(c)
+	if !ok {
+		return b.final(c, s, b.text(c, "bad_tg", nil))
+	}
+
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*5)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "create session context"))
+	}
+	defer cancel()
+
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "start txn"))
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	known, err := b.repo.Users().Upsert(ctx, tg, nil, nil, nil)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "upsert user"))
+	}
+
+	application, err := b.sched.Apply(ctx, vacancy, tg)
+	switch {
+	case errors.Is(err, scheduling.ErrVacancyNotFound):
+		return b.final(c, s, b.text(c, "vacancy.not_found", nil))
+	case errors.Is(err, scheduling.ErrAlreadyApplied):
+		return b.final(c, s, b.text(c, "apply.already", nil))
+	case err != nil:
+		return b.fail(c, s, errors.WrapFail(err, "apply"))
+	}
+
+	if known != nil {
+		msg, err := b.stageMessage(ctx, application)
+		if err != nil {
+			return b.fail(c, s, err)
+		}
+
+		err = b.notify(ctx, known.Username, known.Telegram, msg)
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "notify candidate about application"))
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
+	}
+
+	return b.final(
+		c, s,
+		b.text(c, "apply.done", vars{"ID": application.ID, "Interview": application.Interview()}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
+}
+
+// advancePipeline moves the pipeline of the scored interview and tells the candidate about it
+func (b *Bot) advancePipeline(ctx context.Context, i *models.Interview, hire bool) error {
+	application, err := b.sched.Advance(ctx, i.ID, hire)
+	if err != nil {
+		return errors.WrapFail(err, "advance pipeline")
+	}
+	if application == nil {
+		return nil
+	}
+
+	msg, err := b.stageMessage(ctx, application)
+	if err != nil {
+		return err
+	}
+
+	return errors.WrapFail(b.notify(ctx, i.CandidateUN, i.CandidateTg, msg), "notify candidate")
+}
+
+// stageMessage tells the candidate about the current state of the application
+func (b *Bot) stageMessage(ctx context.Context, application *models.Application) (message, error) {
+	vacancy, err := b.repo.Vacancies().Get(ctx, application.Vacancy)
+	if err != nil {
+		return message{}, errors.WrapFail(err, "find vacancy")
+	}
+
+	data := b.applicationVars(*application, vacancy)
+
+	switch application.Status {
+	case models.ApplicationStatusActive:
+		interview, err := b.repo.Interviews().Find(ctx, application.Interview())
+		if err != nil {
+			return message{}, errors.WrapFail(err, "find stage interview")
+		}
+		if interview != nil {
+			data["Duration"] = interview.MeetDuration()
+		}
+		return message{"pipeline.stage", data}, nil
+	case models.ApplicationStatusPassed:
+		return message{"pipeline.passed", data}, nil
+	default:
+		return message{"pipeline.closed", data}, nil
+	}
+}
+
+// applicationVars describes the application as .ID, .Vacancy, .Stage (from 1), .Total, .StageName,
+// .Interview, .Status
+func (b *Bot) applicationVars(application models.Application, vacancy *models.Vacancy) vars {
+	total := 1
+	var stageName string
+	if vacancy != nil {
+		total = vacancy.StagesCount()
+		stageName = vacancy.StageName(application.Stage)
+	}
+
+	return vars{
+		"ID":        application.ID,
+		"Vacancy":   vacancyTitle(vacancy, application.Vacancy),
+		"Stage":     application.Stage + 1,
+		"Total":     max(total, application.Stage+1),
+		"StageName": stageName,
+		"Interview": application.Interview(),
+		"Status":    application.Status.String(),
+	}
+}
+
+func (b *Bot) showPipeline(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	applications, err := b.repo.Applications().List(b.ctx, models.ApplicationsFilter{Candidate: &sender.Username})
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "list applications"))
+	}
+
+	list := make([]vars, 0, len(applications))
+	for _, application := range applications {
+		vacancy, err := b.repo.Vacancies().Get(b.ctx, application.Vacancy)
+		if err != nil {
+			return b.fail(c, s, errors.WrapFail(err, "find vacancy"))
+		}
+		list = append(list, b.applicationVars(application, vacancy))
+	}
+
+	return b.final(c, s, b.text(c, "pipeline.list", vars{"Applications": list}))
+}
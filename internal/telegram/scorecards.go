@@ -0,0 +1,281 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+	"github.com/nikmy/meowbot/pkg/txn"
+)
+
+var scorecardBtn = &telebot.Btn{Unique: "score"}
+
+const (
+	defaultMaxScore         = 5
+	defaultScorecardsPeriod = time.Minute
+	defaultOutcomeWindow    = 24 * time.Hour
+
+	hireYes = "yes"
+	hireNo  = "no"
+
+	// scorecardSkip leaves the comment empty
+	scorecardSkip = "-"
+)
+
+func (b *Bot) applyScorecards(cfg Config) {
+	b.scorecards = cfg.ScorecardsConfig
+	if b.scorecards.MaxScore <= 0 {
+		b.scorecards.MaxScore = defaultMaxScore
+	}
+	if b.scorecards.Period <= 0 {
+		b.scorecards.Period = defaultScorecardsPeriod
+	}
+	if b.scorecards.OutcomeWindow <= 0 {
+		b.scorecards.OutcomeWindow = defaultOutcomeWindow
+	}
+}
+
+func (b *Bot) runScorecard(c telebot.Context, s fsm.Context) error {
+	user, err := b.getInterviewer(c)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	if user == nil {
+		return b.denyNotInterviewer(c, s)
+	}
+
+	finished := models.InterviewStatusFinished
+	interviews, err := b.repo.Interviews().List(b.ctx, models.InterviewsFilter{
+		Status:      &finished,
+		Interviewer: &user.Username,
+	})
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "list finished interviews"))
+	}
+
+	var pending []vars
+	for _, i := range interviews {
+		noShow := i.Outcome == models.OutcomeCandidateNoShow || i.Outcome == models.OutcomeInterviewerNoShow
+		if i.Scorecard == nil && !noShow {
+			pending = append(pending, vars{"ID": i.ID, "Vacancy": i.Vacancy})
+		}
+	}
+
+	b.setState(s, scorecardReadIIDState)
+	return c.Send(b.text(c, "scorecard.ask_id", vars{"Pending": pending}))
+}
+
+func (b *Bot) scorecardReadIID(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	iid := strings.TrimSpace(c.Text())
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+	if i.InterviewerUN != sender.Username {
+		return b.final(c, s, b.text(c, "deny.not_participant", nil))
+	}
+	if i.Status != models.InterviewStatusFinished {
+		return b.final(c, s, b.text(c, "scorecard.not_finished", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	return b.askScorecard(c, s, nil)
+}
+
+// askScorecard asks to rate the next criterion, or for the decision when all of them are rated
+func (b *Bot) askScorecard(c telebot.Context, s fsm.Context, ratings []models.Rating) error {
+	err := s.Update("ratings", ratings)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with ratings"))
+	}
+
+	if len(ratings) < len(b.scorecards.Criteria) {
+		b.setState(s, scorecardReadScoreState)
+		return c.Send(
+			b.text(c, "scorecard.ask_score", vars{
+				"Criterion": b.scorecards.Criteria[len(ratings)],
+				"Max":       b.scorecards.MaxScore,
+			}),
+			b.scoreMarkup(),
+		)
+	}
+
+	b.setState(s, scorecardReadHireState)
+	return c.Send(b.text(c, "scorecard.ask_hire", nil), b.hireMarkup(c))
+}
+
+func (b *Bot) scoreMarkup() *telebot.ReplyMarkup {
+	row := make([]telebot.InlineButton, 0, b.scorecards.MaxScore)
+	for score := range b.scorecards.MaxScore {
+		value := strconv.Itoa(score + 1)
+		row = append(row, button(scorecardBtn, value, value))
+	}
+
+	return &telebot.ReplyMarkup{InlineKeyboard: [][]telebot.InlineButton{row}}
+}
+
+func (b *Bot) hireMarkup(c telebot.Context) *telebot.ReplyMarkup {
+	return &telebot.ReplyMarkup{InlineKeyboard: [][]telebot.InlineButton{{
+		button(scorecardBtn, b.text(c, "scorecard.hire", nil), hireYes),
+		button(scorecardBtn, b.text(c, "scorecard.no_hire", nil), hireNo),
+	}}}
+}
+
+// scorecardChoice returns the pressed button or the text typed by user
+func (b *Bot) scorecardChoice(c telebot.Context) string {
+	if cb := c.Callback(); cb != nil {
+		b.closeKeyboard(c)
+		return cb.Data
+	}
+	return strings.TrimSpace(c.Text())
+}
+
+func (b *Bot) scorecardScore(c telebot.Context, s fsm.Context) error {
+	score, err := parseScore(b.scorecardChoice(c), b.scorecards.MaxScore)
+	if err != nil {
+		b.log.Debug(err)
+		return c.Send(b.text(c, "scorecard.bad_score", vars{"Max": b.scorecards.MaxScore}), b.scoreMarkup())
+	}
+
+	var ratings []models.Rating
+	err = s.Get("ratings", &ratings)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get ratings from state"))
+	}
+	if len(ratings) >= len(b.scorecards.Criteria) {
+		// criteria have been changed during the dialog
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	ratings = append(ratings, models.Rating{Criterion: b.scorecards.Criteria[len(ratings)], Score: score})
+	return b.askScorecard(c, s, ratings)
+}
+
+// parseScore reads score from 1 to maxScore
+func parseScore(text string, maxScore int) (int, error) {
+	score, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, errors.WrapFail(err, "parse score")
+	}
+
+	if score < 1 || score > maxScore {
+		return 0, errors.Error("score %d is out of [1, %d]", score, maxScore)
+	}
+
+	return score, nil
+}
+
+func (b *Bot) scorecardHire(c telebot.Context, s fsm.Context) error {
+	choice := b.scorecardChoice(c)
+
+	var hire bool
+	switch {
+	case choice == hireYes || strings.EqualFold(choice, b.text(c, "scorecard.hire", nil)):
+		hire = true
+	case choice == hireNo || strings.EqualFold(choice, b.text(c, "scorecard.no_hire", nil)):
+		hire = false
+	default:
+		return c.Send(b.text(c, "scorecard.ask_hire", nil), b.hireMarkup(c))
+	}
+
+	err := s.Update("hire", hire)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with hire"))
+	}
+
+	b.setState(s, scorecardReadCommentState)
+	return c.Send(b.text(c, "scorecard.ask_comment", nil))
+}
+
+func (b *Bot) scorecardComment(c telebot.Context, s fsm.Context) error {
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get iid from state"))
+	}
+
+	scorecard := models.Scorecard{SubmittedAt: b.time.Now().UnixMilli()}
+
+	err = s.Get("ratings", &scorecard.Ratings)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get ratings from state"))
+	}
+
+	err = s.Get("hire", &scorecard.Hire)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get hire from state"))
+	}
+
+	if comment := strings.TrimSpace(c.Text()); comment != scorecardSkip {
+		scorecard.Comment = comment
+	}
+
+	err = scorecard.Validate(b.scorecards.MaxScore)
+	if err != nil {
+		b.log.Debug(err)
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	ctx, cancel, err := b.txm.NewSessionContext(b.ctx, time.Second*5)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "create session context"))
+	}
+	defer cancel()
+
+	// the next pipeline stage is created exactly once with the scorecard
+	tx, err := txn.New(ctx).
+		SetModel(txn.CausalConsistency).
+		SetIsolation(txn.SnapshotIsolation).
+		Start(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "start txn"))
+	}
+	defer func() {
+		err := tx.Close(ctx)
+		if err != nil {
+			b.log.Warn(errors.WrapFail(err, "close txn"))
+		}
+	}()
+
+	i, err := b.repo.Interviews().Find(ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview"))
+	}
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	err = b.repo.Interviews().Score(ctx, iid, scorecard)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Interviews.Score request"))
+	}
+
+	err = b.advancePipeline(ctx, i, scorecard.Hire)
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "commit txn"))
+	}
+
+	return b.final(c, s, b.text(c, "scorecard.saved", vars{"ID": iid}))
+}
@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseScore(t *testing.T) {
+	type testcase struct {
+		text    string
+		want    int
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{text: "1", want: 1},
+		{text: "5", want: 5},
+		{text: "0", wantErr: true},
+		{text: "6", wantErr: true},
+		{text: "five", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := parseScore(tt.text, 5)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
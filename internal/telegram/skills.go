@@ -0,0 +1,161 @@
+package telegram
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+// skillsReset removes all skills
+const skillsReset = "*"
+
+func (b *Bot) runSetSkills(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, setSkillsReadTgState)
+	return c.Send(b.text(c, "skills.ask_tg", nil))
+}
+
+func (b *Bot) setSkillsReadTg(c telebot.Context, s fsm.Context) error {
+	tg, ok := b.readTg(c)
+	if !ok {
+		return b.final(c, s, b.text(c, "bad_tg", nil))
+	}
+
+	user, err := b.repo.Users().Get(b.ctx, tg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get user"))
+	}
+
+	if user == nil {
+		return b.final(c, s, b.text(c, "user.not_found", nil))
+	}
+
+	err = s.Update("tg", tg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with tg"))
+	}
+
+	b.setState(s, setSkillsReadState)
+	return c.Send(b.text(c, "skills.ask", vars{"Current": user.Skills, "Max": models.MaxSkills}))
+}
+
+func (b *Bot) setSkills(c telebot.Context, s fsm.Context) error {
+	var tg string
+	err := s.Get("tg", &tg)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get tg from state"))
+	}
+
+	skills, ok := b.readSkills(c)
+	if !ok {
+		return c.Send(b.text(c, "skills.bad", vars{"Max": models.MaxSkills}))
+	}
+
+	updated, err := b.repo.Users().SetSkills(b.ctx, tg, skills)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "set skills"))
+	}
+
+	if updated == nil {
+		return b.final(c, s, b.text(c, "user.not_found", nil))
+	}
+
+	return b.final(c, s, b.text(c, "skills.saved", vars{"Username": tg, "Skills": skills}))
+}
+
+func (b *Bot) runSetInterviewSkills(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, setInterviewSkillsReadIIDState)
+	return c.Send(b.text(c, "interview.ask_id", nil))
+}
+
+func (b *Bot) setInterviewSkillsReadIID(c telebot.Context, s fsm.Context) error {
+	iid := c.Text()
+
+	i, err := b.repo.Interviews().Find(b.ctx, iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find interview by id"))
+	}
+
+	if i == nil {
+		return b.final(c, s, b.text(c, "interview.not_found", nil))
+	}
+
+	err = s.Update("iid", iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with iid"))
+	}
+
+	b.setState(s, setInterviewSkillsReadState)
+	return c.Send(b.text(c, "interview_skills.ask", vars{"Current": i.Skills, "Max": models.MaxSkills}))
+}
+
+func (b *Bot) setInterviewSkills(c telebot.Context, s fsm.Context) error {
+	var iid string
+	err := s.Get("iid", &iid)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get iid from state"))
+	}
+
+	skills, ok := b.readSkills(c)
+	if !ok {
+		return c.Send(b.text(c, "skills.bad", vars{"Max": models.MaxSkills}))
+	}
+
+	err = b.repo.Interviews().Update(b.ctx, iid, nil, nil, nil, nil, nil, nil, &skills)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update interview"))
+	}
+
+	return b.final(
+		c, s,
+		b.text(c, "interview_skills.saved", vars{"ID": iid, "Skills": skills}),
+		&telebot.SendOptions{ParseMode: telebot.ModeMarkdown},
+	)
+}
+
+// readSkills parses skills from the message, skillsReset means none
+func (b *Bot) readSkills(c telebot.Context) ([]string, bool) {
+	text := strings.TrimSpace(c.Text())
+	if text == skillsReset {
+		return nil, true
+	}
+
+	skills, err := parseSkills(text)
+	return skills, err == nil
+}
+
+// parseSkills reads tags separated by spaces or commas like "#go, postgres", at least one is required
+func parseSkills(text string) ([]string, error) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+
+	skills := models.NormalizeSkills(fields)
+	if len(skills) == 0 {
+		return nil, errors.Error("no skills in %q", text)
+	}
+
+	return skills, models.ValidateSkills(skills)
+}
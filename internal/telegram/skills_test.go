@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSkills(t *testing.T) {
+	type testcase struct {
+		name    string
+		text    string
+		want    []string
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{name: "spaces", text: "go  postgres", want: []string{"go", "postgres"}},
+		{name: "commas and hashes", text: "#Go, ML,go", want: []string{"go", "ml"}},
+		{name: "empty", text: " , ", wantErr: true},
+		{name: "too many", text: "a b c d e f g h i j k l m n o p q", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSkills(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
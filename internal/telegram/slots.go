@@ -0,0 +1,155 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const (
+	defaultSlotsCount    = 6
+	defaultSlotsStep     = 30 * time.Minute
+	defaultSlotsMaxRange = 7 * 24 * time.Hour
+
+	slotLayout = "02.01 15:04"
+)
+
+func (b *Bot) applySlots(cfg Config) {
+	b.slots = cfg.SlotsConfig
+
+	if b.slots.Count <= 0 {
+		b.slots.Count = defaultSlotsCount
+	}
+	if b.slots.Step <= 0 {
+		b.slots.Step = defaultSlotsStep
+	}
+	if b.slots.MaxRange <= 0 {
+		b.slots.MaxRange = defaultSlotsMaxRange
+	}
+}
+
+// suggestSlots returns up to slots.Count earliest meetings of given duration
+// inside [from, to), for which candidate is free and the whole panel can be matched
+// at once, interviewers of the panel must pass the filter, the conflict check already skips
+// those who have reached their limits or need the time around the slot as a buffer.
+// Interviewers are loaded once and matched against every slot in memory the same way
+// as UsersRepo.Match does. If the interview is being rescheduled, its current meeting
+// is released for all participants first, as Scheduler.Reschedule does.
+func (b *Bot) suggestSlots(
+	ctx context.Context,
+	candidate models.User,
+	from int64,
+	to int64,
+	duration time.Duration,
+	filter models.InterviewerFilter,
+	rescheduled *models.Interview,
+) ([]models.Meeting, error) {
+	step := b.slots.Step.Milliseconds()
+	length := duration.Milliseconds()
+
+	// align the first slot to the grid
+	if rem := from % step; rem != 0 {
+		from += step - rem
+	}
+
+	interviewers, err := b.repo.Users().List(ctx, models.UsersFilter{InterviewersOnly: true})
+	if err != nil {
+		return nil, errors.WrapFail(err, "do Users.List request")
+	}
+
+	var panel models.Panel
+	if rescheduled != nil {
+		panel = rescheduled.Panel
+		if rescheduled.Meet != nil {
+			candidate.Assigned, _ = candidate.FindAndDeleteMeeting(*rescheduled.Meet)
+			for k, user := range interviewers {
+				if rescheduled.IsInterviewer(user.Username) {
+					interviewers[k].Assigned, _ = user.FindAndDeleteMeeting(*rescheduled.Meet)
+				}
+			}
+		}
+	}
+	poolFilter := panel.PoolFilter(filter)
+
+	var (
+		found []models.Meeting
+		pool  []models.User
+	)
+	for start := from; start+length <= to && len(found) < b.slots.Count; start += step {
+		meet := models.Meeting{start, start + length}
+
+		_, free := candidate.AddMeeting(meet)
+		if !free {
+			continue
+		}
+
+		pool = pool[:0]
+		for _, user := range interviewers {
+			if poolFilter.Matches(user, meet) {
+				pool = append(pool, user)
+			}
+		}
+
+		if panel.CanSeat(pool, filter, candidate.Username) {
+			found = append(found, meet)
+		}
+	}
+
+	return found, nil
+}
+
+// parseDateRange reads "ДД ММ ГГГГ" or "ДД ММ ГГГГ - ДД ММ ГГГГ"
+// and returns the beginning of the first day and the end of the last one.
+func parseDateRange(text string) (time.Time, time.Time, error) {
+	const layout = "02 01 2006"
+
+	first, last, isRange := strings.Cut(text, "-")
+
+	from, err := time.Parse(layout, strings.TrimSpace(first))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.WrapFail(err, "parse first date")
+	}
+
+	to := from
+	if isRange {
+		to, err = time.Parse(layout, strings.TrimSpace(last))
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.WrapFail(err, "parse last date")
+		}
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.Error("last date is before first one")
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+// fromUserTime reads wall clock of t as time in the user's zone,
+// t is usually a date parsed without zone.
+func fromUserTime(t time.Time, zone *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), zone)
+}
+
+// toUserTime converts stored unix millis to the time shown to user.
+func toUserTime(millis int64, zone *time.Location) time.Time {
+	return time.UnixMilli(millis).In(zone)
+}
+
+func formatSlot(meet models.Meeting, zone *time.Location) string {
+	return toUserTime(meet[0], zone).Format(slotLayout)
+}
+
+// slotsLayout is the layout slots are offered in, the date is omitted
+// only if all of them are on the same day of the user
+func slotsLayout(slots []models.Meeting, zone *time.Location) string {
+	for _, slot := range slots {
+		if toUserTime(slot[0], zone).Format(time.DateOnly) != toUserTime(slots[0][0], zone).Format(time.DateOnly) {
+			return slotLayout
+		}
+	}
+	return "15:04"
+}
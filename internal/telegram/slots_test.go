@@ -0,0 +1,229 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func TestBot_suggestSlots(t *testing.T) {
+	const (
+		step = 30 * time.Minute
+		hour = int64(time.Hour / time.Millisecond)
+		half = hour / 2
+	)
+
+	type args struct {
+		candidate models.User
+		from, to  int64
+	}
+
+	type testcase struct {
+		name  string
+		count int
+		args  args
+
+		interviewers []models.User
+		listErr      error
+		filter       models.InterviewerFilter
+		rescheduled  *models.Interview
+
+		want    []models.Meeting
+		wantErr bool
+	}
+
+	interviewer := func(username string, assigned ...models.Meeting) models.User {
+		return models.User{Username: username, IntGrade: 1, Assigned: assigned}
+	}
+
+	tests := [...]testcase{
+		{
+			name:  "no interviewers",
+			count: 3,
+			args:  args{from: 0, to: 4 * hour},
+			want:  nil,
+		},
+		{
+			name:         "align to step",
+			count:        3,
+			args:         args{from: 1, to: 4 * hour},
+			interviewers: []models.User{interviewer("int", models.Meeting{half + hour, 4 * hour})},
+			want:         []models.Meeting{{half, half + hour}},
+		},
+		{
+			name:         "limited by count",
+			count:        2,
+			args:         args{from: 0, to: 4 * hour},
+			interviewers: []models.User{interviewer("int")},
+			want:         []models.Meeting{{0, hour}, {half, half + hour}},
+		},
+		{
+			name:         "slot must fit into range",
+			count:        5,
+			args:         args{from: 0, to: 2 * hour},
+			interviewers: []models.User{interviewer("int", models.Meeting{0, hour})},
+			want:         []models.Meeting{{hour, 2 * hour}},
+		},
+		{
+			name:  "skip busy candidate",
+			count: 5,
+			args: args{
+				candidate: models.User{Username: "cand", Assigned: []models.Meeting{{0, hour}}},
+				from:      0,
+				to:        3 * hour,
+			},
+			interviewers: []models.User{interviewer("int", models.Meeting{2 * hour, 3 * hour})},
+			want:         []models.Meeting{{hour, 2 * hour}},
+		},
+		{
+			name:  "candidate cannot interview himself",
+			count: 5,
+			args: args{
+				candidate: models.User{Username: "cand"},
+				from:      0,
+				to:        half + hour,
+			},
+			interviewers: []models.User{interviewer("cand"), interviewer("int", models.Meeting{0, half})},
+			want:         []models.Meeting{{half, half + hour}},
+		},
+		{
+			name:  "interviewers must pass the filter",
+			count: 5,
+			args:  args{from: 0, to: 2 * hour},
+			interviewers: []models.User{
+				interviewer("int"),
+				{Username: "senior", IntGrade: 3, Assigned: []models.Meeting{{0, hour}}},
+			},
+			filter: models.InterviewerFilter{MinGrade: 3},
+			want:   []models.Meeting{{hour, 2 * hour}},
+		},
+		{
+			name:  "whole panel must be free",
+			count: 5,
+			args:  args{candidate: models.User{Username: "cand"}, from: 0, to: half + hour},
+			interviewers: []models.User{
+				interviewer("int"),
+				interviewer("cand"),
+				interviewer("other", models.Meeting{0, half}),
+			},
+			rescheduled: &models.Interview{Panel: models.Panel{Interviewers: 2}},
+			want:        []models.Meeting{{half, half + hour}},
+		},
+		{
+			name:  "rescheduled meeting is released for all participants",
+			count: 5,
+			args: args{
+				candidate: models.User{Username: "cand", Assigned: []models.Meeting{{0, hour}}},
+				from:      0,
+				to:        hour,
+			},
+			interviewers: []models.User{
+				interviewer("int", models.Meeting{0, hour}),
+				interviewer("panelist", models.Meeting{0, hour}),
+				interviewer("other", models.Meeting{0, hour}),
+			},
+			rescheduled: &models.Interview{
+				CandidateUN:   "cand",
+				InterviewerUN: "int",
+				Panelists:     []models.Panelist{{Username: "panelist"}},
+				Panel:         models.Panel{Interviewers: 2},
+				Meet:          &[2]int64{0, hour},
+			},
+			want: []models.Meeting{{0, hour}},
+		},
+		{
+			name:    "list error",
+			count:   5,
+			args:    args{from: 0, to: 2 * hour},
+			listErr: errors.New("mock"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// the pool is loaded once for the whole range
+			uMock := NewMockusersApi(ctrl)
+			uMock.EXPECT().
+				List(gomock.Any(), models.UsersFilter{InterviewersOnly: true}).
+				Return(tt.interviewers, tt.listErr)
+
+			repoMock := NewMockrepoClient(ctrl)
+			repoMock.EXPECT().Users().Return(uMock).AnyTimes()
+
+			b := &Bot{log: zap.NewNop().Sugar(), repo: repoMock}
+			b.applySlots(Config{SlotsConfig: SlotsConfig{Count: tt.count, Step: step}})
+
+			got, err := b.suggestSlots(
+				context.Background(),
+				tt.args.candidate, tt.args.from, tt.args.to, time.Hour,
+				tt.filter, tt.rescheduled,
+			)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_slotsLayout(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	// 20:00 and 22:00 UTC of the same day are on different days in Moscow
+	evening := time.Date(2024, time.May, 12, 20, 0, 0, 0, time.UTC).UnixMilli()
+	night := time.Date(2024, time.May, 12, 22, 0, 0, 0, time.UTC).UnixMilli()
+	slots := []models.Meeting{{evening, evening + 1}, {night, night + 1}}
+
+	require.Equal(t, "15:04", slotsLayout(slots, time.UTC))
+	require.Equal(t, slotLayout, slotsLayout(slots, moscow))
+}
+
+func Test_parseDateRange(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.May, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	type testcase struct {
+		name     string
+		text     string
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}
+
+	tests := [...]testcase{
+		{name: "single day", text: "12 05 2024", wantFrom: day(12), wantTo: day(13)},
+		{name: "range", text: "12 05 2024 - 14 05 2024", wantFrom: day(12), wantTo: day(15)},
+		{name: "range without spaces", text: "12 05 2024-12 05 2024", wantFrom: day(12), wantTo: day(13)},
+		{name: "reversed range", text: "14 05 2024 - 12 05 2024", wantErr: true},
+		{name: "bad format", text: "12.05.2024", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := parseDateRange(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFrom, from)
+			require.Equal(t, tt.wantTo, to)
+		})
+	}
+}
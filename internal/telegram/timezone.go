@@ -0,0 +1,91 @@
+package telegram
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const (
+	zoneKey = "zone"
+
+	// timeZoneReset removes chosen time zone, so the default one is used
+	timeZoneReset = "-"
+
+	momentLayout = "02.01.06 15:04"
+)
+
+// moment is unix millis in message data, it is shown in the time zone of the recipient
+type moment int64
+
+// in returns copy of vars with moments formatted in the zone
+func (v vars) in(zone *time.Location) vars {
+	formatted := make(vars, len(v))
+	for key, value := range v {
+		switch value := value.(type) {
+		case moment:
+			formatted[key] = formatMoment(int64(value), zone)
+		case []vars:
+			list := make([]vars, 0, len(value))
+			for _, item := range value {
+				list = append(list, item.in(zone))
+			}
+			formatted[key] = list
+		default:
+			formatted[key] = value
+		}
+	}
+	return formatted
+}
+
+// formatMoment formats time with date, year and zone name
+func formatMoment(millis int64, zone *time.Location) string {
+	return toUserTime(millis, zone).Format(momentLayout) + " " + zone.String()
+}
+
+// zone returns time zone of the sender detected by middleware
+func (b *Bot) zone(c telebot.Context) *time.Location {
+	if zone, ok := c.Get(zoneKey).(*time.Location); ok {
+		return zone
+	}
+	return models.DefaultLocation()
+}
+
+func (b *Bot) runTimeZone(c telebot.Context, s fsm.Context) error {
+	b.setState(s, timeZoneReadState)
+	return c.Send(b.text(c, "timezone.ask", vars{"Zone": b.zone(c).String()}))
+}
+
+func (b *Bot) setTimeZone(c telebot.Context, s fsm.Context) error {
+	name := strings.TrimSpace(c.Text())
+	if name == timeZoneReset {
+		name = ""
+	}
+
+	if _, err := models.LoadLocation(name); err != nil {
+		b.log.Debug(err)
+		return c.Send(b.text(c, "timezone.unknown", nil))
+	}
+
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	updated, err := b.repo.Users().SetTimeZone(b.ctx, sender.Username, name)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Users.SetTimeZone request"))
+	}
+	if updated == nil {
+		return b.final(c, s, b.text(c, "user.unknown", nil))
+	}
+
+	zone := updated.Location()
+	c.Set(zoneKey, zone)
+	return b.final(c, s, b.text(c, "timezone.saved", vars{"Zone": zone.String()}))
+}
@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func Test_vars_in(t *testing.T) {
+	almaty, err := models.LoadLocation("Asia/Almaty")
+	require.NoError(t, err)
+
+	start := time.Date(2025, time.March, 3, 7, 30, 0, 0, time.UTC).UnixMilli()
+
+	data := vars{
+		"ID":   "42",
+		"Time": moment(start),
+		"List": []vars{{"Time": moment(start)}, {"Time": ""}},
+	}
+
+	got := data.in(almaty)
+	require.Equal(t, "42", got["ID"])
+	require.Equal(t, "03.03.25 12:30 Asia/Almaty", got["Time"])
+	require.Equal(t, []vars{{"Time": "03.03.25 12:30 Asia/Almaty"}, {"Time": ""}}, got["List"])
+	require.Equal(t, moment(start), data["Time"], "source vars are not changed")
+
+	require.Equal(t, "03.03.25 07:30 UTC", formatMoment(start, time.UTC))
+}
+
+func Test_fromUserTime(t *testing.T) {
+	newYork, err := models.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	day := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	got := fromUserTime(day, newYork)
+	require.Equal(t, time.Date(2025, time.July, 1, 4, 0, 0, 0, time.UTC).UnixMilli(), got.UnixMilli())
+	require.Equal(t, "01.07 00:00", toUserTime(got.UnixMilli(), newYork).Format(slotLayout))
+}
+
+func TestBot_notify_timeZone(t *testing.T) {
+	ctx := context.Background()
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+
+	tg := int64(42)
+	_, err := client.Users().Upsert(ctx, "cat", &tg, nil, nil)
+	require.NoError(t, err)
+	_, err = client.Users().SetTimeZone(ctx, "cat", "Asia/Almaty")
+	require.NoError(t, err)
+
+	moscow, err := models.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	models.SetDefaultLocation(moscow)
+	t.Cleanup(func() { models.SetDefaultLocation(nil) })
+
+	b := &Bot{repo: client, messages: newTestMessages(t)}
+
+	start := time.Date(2025, time.March, 3, 7, 30, 0, 0, time.UTC).UnixMilli()
+	msg := message{"interview.moved", vars{"ID": "42", "Time": moment(start)}}
+	require.NoError(t, b.notify(ctx, "cat", 0, msg))
+	require.NoError(t, b.notify(ctx, "dog", 7, msg))
+
+	pending, err := client.Outbox().Pending(ctx, time.Now().Add(time.Second).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Contains(t, pending[0].Text, "03.03.25 12:30 Asia/Almaty")
+	require.Contains(t, pending[1].Text, "03.03.25 10:30 Europe/Moscow", "unknown user gets default zone")
+}
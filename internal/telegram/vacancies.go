@@ -0,0 +1,383 @@
+package telegram
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliy-ukiru/fsm-telebot"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+var vacancyBtn = &telebot.Btn{Unique: "vacancy"}
+
+const (
+	// vacancyKeep leaves the current value of the field
+	vacancyKeep = "-"
+
+	// vacancyReset clears the field
+	vacancyReset = "*"
+)
+
+// vacancyField is a step of /setVacancy dialog. The ask message gets .Current value,
+// set is called with the answer unless it is vacancyKeep.
+type vacancyField struct {
+	ask     string
+	current func(v models.Vacancy) any
+	set     func(v *models.Vacancy, text string) error
+}
+
+var vacancyFields = [...]vacancyField{
+	{
+		ask:     "vacancy.ask_title",
+		current: func(v models.Vacancy) any { return v.Title },
+		set: func(v *models.Vacancy, text string) error {
+			v.Title = text
+			return nil
+		},
+	},
+	{
+		ask:     "vacancy.ask_interviewers",
+		current: func(v models.Vacancy) any { return v.Interviewers },
+		set: func(v *models.Vacancy, text string) error {
+			v.Interviewers = nil
+			if text == vacancyReset {
+				return nil
+			}
+
+			for _, tg := range strings.Fields(text) {
+				if len(tg) < 2 || tg[0] != '@' {
+					return errors.Error("bad interviewer %q", tg)
+				}
+				v.Interviewers = append(v.Interviewers, tg[1:])
+			}
+			return nil
+		},
+	},
+	{
+		ask:     "vacancy.ask_grade",
+		current: func(v models.Vacancy) any { return v.MinGrade },
+		set: func(v *models.Vacancy, text string) error {
+			grade, err := strconv.Atoi(text)
+			if err != nil || models.ValidateMinGrade(grade) != nil {
+				return errors.Error("bad grade %q", text)
+			}
+			v.MinGrade = grade
+			return nil
+		},
+	},
+	{
+		ask:     "vacancy.ask_skills",
+		current: func(v models.Vacancy) any { return v.Skills },
+		set: func(v *models.Vacancy, text string) (err error) {
+			v.Skills = nil
+			if text == vacancyReset {
+				return nil
+			}
+
+			v.Skills, err = parseSkills(text)
+			return err
+		},
+	},
+	{
+		ask:     "vacancy.ask_duration",
+		current: func(v models.Vacancy) any { return v.Duration },
+		set: func(v *models.Vacancy, text string) (err error) {
+			if text == vacancyReset {
+				v.Duration = 0
+				return nil
+			}
+			v.Duration, err = parseDuration(text)
+			return err
+		},
+	},
+	{
+		ask:     "vacancy.ask_zoom",
+		current: func(v models.Vacancy) any { return v.Zoom },
+		set: func(v *models.Vacancy, text string) error {
+			v.Zoom = text
+			if text == vacancyReset {
+				v.Zoom = ""
+			}
+			return nil
+		},
+	},
+	{
+		ask:     "vacancy.ask_stages",
+		current: func(v models.Vacancy) any { return stagesVars(v) },
+		set: func(v *models.Vacancy, text string) (err error) {
+			v.Stages = nil
+			if text == vacancyReset {
+				return nil
+			}
+			v.Stages, err = parseStages(text)
+			return err
+		},
+	},
+}
+
+// parseStages reads stages one per line as a name optionally followed by duration, e.g. "system design 1h30m".
+// Bare numbers are left in names, so that "round 2" is not read as two minutes.
+func parseStages(text string) ([]models.Stage, error) {
+	var stages []models.Stage
+	for _, line := range strings.Split(text, "\n") {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			continue
+		}
+
+		stage := models.Stage{Name: strings.Join(words, " ")}
+		if last := words[len(words)-1]; len(words) > 1 && !isNumber(last) {
+			d, err := parseDuration(last)
+			if err == nil {
+				stage.Name = strings.Join(words[:len(words)-1], " ")
+				stage.Duration = d
+			}
+		}
+
+		stages = append(stages, stage)
+	}
+
+	if len(stages) == 0 {
+		return nil, errors.Error("no stages")
+	}
+
+	return stages, nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// stagesVars lists stages of the vacancy as .Name, .Duration (zero if the vacancy default is used)
+func stagesVars(v models.Vacancy) []vars {
+	stages := make([]vars, 0, len(v.Stages))
+	for _, stage := range v.Stages {
+		stages = append(stages, vars{"Name": stage.Name, "Duration": stage.Duration})
+	}
+	return stages
+}
+
+// vacancyTitle returns the title of the vacancy or its id if it is unknown or has no title
+func vacancyTitle(v *models.Vacancy, id string) string {
+	if v == nil || v.Title == "" {
+		return id
+	}
+	return v.Title
+}
+
+// vacancyMarkup offers known vacancies as buttons, it is nil if there are no vacancies
+func vacancyMarkup(vacancies []models.Vacancy) *telebot.ReplyMarkup {
+	if len(vacancies) == 0 {
+		return nil
+	}
+
+	rows := make([][]telebot.InlineButton, 0, len(vacancies))
+	for _, v := range vacancies {
+		rows = append(rows, []telebot.InlineButton{button(vacancyBtn, vacancyTitle(&v, v.ID), v.ID)})
+	}
+
+	return &telebot.ReplyMarkup{InlineKeyboard: rows}
+}
+
+// vacancyChoice returns the pressed vacancy button or the text typed by user
+func (b *Bot) vacancyChoice(c telebot.Context) string {
+	if cb := c.Callback(); cb != nil {
+		b.closeKeyboard(c)
+		return cb.Data
+	}
+	return strings.TrimSpace(c.Text())
+}
+
+// askVacancy sends the message with known vacancies listed as .Vacancies of .ID, .Title
+// and offered as buttons
+func (b *Bot) askVacancy(c telebot.Context, key string) error {
+	vacancies, err := b.repo.Vacancies().List(b.ctx)
+	if err != nil {
+		return errors.WrapFail(err, "list vacancies")
+	}
+
+	known := make([]vars, 0, len(vacancies))
+	for _, v := range vacancies {
+		known = append(known, vars{"ID": v.ID, "Title": vacancyTitle(&v, v.ID)})
+	}
+
+	markup := vacancyMarkup(vacancies)
+	if markup == nil {
+		return c.Send(b.text(c, key, vars{"Vacancies": known}))
+	}
+	return c.Send(b.text(c, key, vars{"Vacancies": known}), markup)
+}
+
+func (b *Bot) showVacancies(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	vacancies, err := b.repo.Vacancies().List(b.ctx)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "list vacancies"))
+	}
+
+	list := make([]vars, 0, len(vacancies))
+	for _, v := range vacancies {
+		list = append(list, vars{
+			"ID":           v.ID,
+			"Title":        v.Title,
+			"Interviewers": v.Interviewers,
+			"MinGrade":     v.MinGrade,
+			"Skills":       v.Skills,
+			"Duration":     v.Duration,
+			"Zoom":         v.Zoom,
+			"Stages":       stagesVars(v),
+		})
+	}
+
+	return b.final(c, s, b.text(c, "vacancy.list", vars{"Vacancies": list}))
+}
+
+func (b *Bot) runSetVacancy(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, setVacancyReadIDState)
+	err := b.askVacancy(c, "vacancy.ask_id")
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	return nil
+}
+
+func (b *Bot) setVacancyReadID(c telebot.Context, s fsm.Context) error {
+	id := b.vacancyChoice(c)
+	if (models.Vacancy{ID: id}).Validate() != nil {
+		return c.Send(b.text(c, "vacancy.bad_id", nil))
+	}
+
+	found, err := b.repo.Vacancies().Get(b.ctx, id)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "find vacancy"))
+	}
+
+	draft := models.Vacancy{ID: id}
+	if found != nil {
+		draft = *found
+	}
+
+	return b.askVacancyField(c, s, draft, 0)
+}
+
+// askVacancyField saves the draft and asks for the field with index step
+func (b *Bot) askVacancyField(c telebot.Context, s fsm.Context, draft models.Vacancy, step int) error {
+	err := s.Update("vacancy", draft)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with vacancy"))
+	}
+
+	err = s.Update("step", step)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "update state with step"))
+	}
+
+	field := vacancyFields[step]
+	b.setState(s, setVacancyReadFieldState)
+	return c.Send(b.text(c, field.ask, vars{"Current": field.current(draft)}))
+}
+
+func (b *Bot) setVacancyReadField(c telebot.Context, s fsm.Context) error {
+	var draft models.Vacancy
+	err := s.Get("vacancy", &draft)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get vacancy from state"))
+	}
+
+	var step int
+	err = s.Get("step", &step)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "get step from state"))
+	}
+	if step < 0 || step >= len(vacancyFields) {
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	if text := strings.TrimSpace(c.Text()); text != vacancyKeep {
+		err = vacancyFields[step].set(&draft, text)
+		if err != nil {
+			b.log.Debug(err)
+			return c.Send(b.text(c, "bad_format", nil))
+		}
+	}
+
+	if step+1 < len(vacancyFields) {
+		return b.askVacancyField(c, s, draft, step+1)
+	}
+
+	err = draft.Validate()
+	if err != nil {
+		b.log.Debug(err)
+		return b.final(c, s, b.text(c, "retry", nil))
+	}
+
+	err = b.repo.Vacancies().Upsert(b.ctx, draft)
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Vacancies.Upsert request"))
+	}
+
+	return b.final(c, s, b.text(c, "vacancy.saved", vars{"ID": draft.ID}))
+}
+
+func (b *Bot) runDelVacancy(c telebot.Context, s fsm.Context) error {
+	sender := c.Sender()
+	if sender == nil {
+		return b.fail(c, s, errors.Fail("get sender"))
+	}
+
+	if !b.checkHR(sender.Username) {
+		return b.denyNotHR(c, s)
+	}
+
+	b.setState(s, delVacancyReadIDState)
+	err := b.askVacancy(c, "vacancy.ask_delete")
+	if err != nil {
+		return b.fail(c, s, err)
+	}
+	return nil
+}
+
+func (b *Bot) delVacancy(c telebot.Context, s fsm.Context) error {
+	deleted, err := b.repo.Vacancies().Delete(b.ctx, b.vacancyChoice(c))
+	if err != nil {
+		return b.fail(c, s, errors.WrapFail(err, "do Vacancies.Delete request"))
+	}
+
+	if deleted == nil {
+		return b.final(c, s, b.text(c, "vacancy.not_found", nil))
+	}
+
+	return b.final(c, s, b.text(c, "vacancy.deleted", vars{"ID": deleted.ID}))
+}
+
+// defaultDuration returns default interview duration for the vacancy the same as HR API uses
+func (b *Bot) defaultDuration(vacancy string) time.Duration {
+	d, err := b.sched.DefaultDuration(b.ctx, vacancy)
+	if err != nil {
+		b.log.Warn(errors.WrapFail(err, "get default duration"))
+		return models.DefaultInterviewDuration
+	}
+	return d
+}
@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nikmy/meowbot/internal/repo/models"
+)
+
+func Test_parseStages(t *testing.T) {
+	type testcase struct {
+		name    string
+		text    string
+		want    []models.Stage
+		wantErr bool
+	}
+
+	tests := [...]testcase{
+		{
+			name: "names only",
+			text: "скрининг\nтехническое интервью",
+			want: []models.Stage{{Name: "скрининг"}, {Name: "техническое интервью"}},
+		},
+		{
+			name: "with durations",
+			text: "screening 30m\n\n  system design 1h30m  ",
+			want: []models.Stage{
+				{Name: "screening", Duration: 30 * time.Minute},
+				{Name: "system design", Duration: 90 * time.Minute},
+			},
+		},
+		{
+			name: "number is a part of name",
+			text: "round 2",
+			want: []models.Stage{{Name: "round 2"}},
+		},
+		{
+			name: "single word is a name",
+			text: "1h",
+			want: []models.Stage{{Name: "1h"}},
+		},
+		{
+			name:    "empty",
+			text:    " \n ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStages(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
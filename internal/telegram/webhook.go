@@ -0,0 +1,158 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"time"
+
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/pkg/errors"
+)
+
+const (
+	defaultWebhookPath = "/telegram/webhook"
+
+	secretTokenHeader      = "X-Telegram-Bot-Api-Secret-Token"
+	webhookMaxBodySize     = 1 << 20
+	webhookDeliverTimeout  = 10 * time.Second
+	webhookShutdownTimeout = 5 * time.Second
+)
+
+var secretTokenRe = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
+func newWebhookPoller(cfg WebhookConfig) (*webhookPoller, error) {
+	if cfg.PublicURL == "" {
+		return nil, errors.Error("webhook public url must be provided")
+	}
+
+	if !secretTokenRe.MatchString(cfg.SecretToken) {
+		return nil, errors.Error("webhook secret token must be 1-256 characters A-Z, a-z, 0-9, _ and -")
+	}
+
+	if (cfg.TLSCert == "") != (cfg.TLSKey == "") {
+		return nil, errors.Error("both webhook tls cert and key must be provided")
+	}
+
+	if cfg.Path == "" {
+		cfg.Path = defaultWebhookPath
+	}
+
+	return &webhookPoller{
+		cfg:     cfg,
+		secret:  []byte(cfg.SecretToken),
+		updates: make(chan telebot.Update),
+	}, nil
+}
+
+// webhookPoller receives updates pushed by Telegram. It serves them either on
+// a dedicated listener or through the handler mounted by the owner of another one.
+type webhookPoller struct {
+	cfg     WebhookConfig
+	secret  []byte
+	updates chan telebot.Update
+}
+
+// register tells Telegram where to send updates
+func (p *webhookPoller) register(b *telebot.Bot) error {
+	hook := &telebot.Webhook{
+		MaxConnections: p.cfg.MaxConnections,
+		DropUpdates:    p.cfg.DropPendingUpdates,
+		SecretToken:    p.cfg.SecretToken,
+		Endpoint: &telebot.WebhookEndpoint{
+			PublicURL: p.cfg.PublicURL,
+			Cert:      p.cfg.Certificate,
+		},
+	}
+
+	return errors.WrapFail(b.SetWebhook(hook), "set webhook")
+}
+
+func (p *webhookPoller) Poll(b *telebot.Bot, dest chan telebot.Update, stop chan struct{}) {
+	var srv *http.Server
+	if p.cfg.Listen != "" {
+		mux := http.NewServeMux()
+		mux.Handle(p.cfg.Path, p)
+
+		srv = &http.Server{
+			Addr:              p.cfg.Listen,
+			Handler:           mux,
+			ReadHeaderTimeout: webhookDeliverTimeout,
+		}
+
+		go func() {
+			var err error
+			if p.cfg.TLSCert != "" {
+				err = srv.ListenAndServeTLS(p.cfg.TLSCert, p.cfg.TLSKey)
+			} else {
+				err = srv.ListenAndServe()
+			}
+
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				b.OnError(errors.WrapFail(err, "serve webhook"), nil)
+			}
+		}()
+	}
+
+	for {
+		select {
+		case u := <-p.updates:
+			select {
+			case dest <- u:
+			case <-stop:
+			}
+		case <-stop:
+			if srv != nil {
+				ctx, cancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+				_ = srv.Shutdown(ctx)
+				cancel()
+			}
+			return
+		}
+	}
+}
+
+func (p *webhookPoller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := []byte(r.Header.Get(secretTokenHeader))
+	if subtle.ConstantTimeCompare(token, p.secret) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var u telebot.Update
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, webhookMaxBodySize)).Decode(&u)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	timer := time.NewTimer(webhookDeliverTimeout)
+	defer timer.Stop()
+
+	// Telegram retries the update on any non-2xx status
+	select {
+	case p.updates <- u:
+		w.WriteHeader(http.StatusOK)
+	case <-r.Context().Done():
+	case <-timer.C:
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+// Webhook returns handler to be mounted at path of a shared listener,
+// or nil if the bot polls updates or has a dedicated listener.
+func (b *Bot) Webhook() (path string, handler http.Handler) {
+	if b.webhook == nil || b.webhook.cfg.Listen != "" {
+		return "", nil
+	}
+
+	return b.webhook.cfg.Path, b.webhook
+}
@@ -0,0 +1,197 @@
+package telegram
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v3"
+
+	"github.com/nikmy/meowbot/internal/repo"
+	"github.com/nikmy/meowbot/internal/scheduling"
+)
+
+// fakeTelegram emulates Bot API methods used by the bot and records calls
+type fakeTelegram struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	calls map[string][]map[string]string
+}
+
+func newFakeTelegram(t *testing.T, token string) *fakeTelegram {
+	f := &fakeTelegram{calls: make(map[string][]map[string]string)}
+
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, ok := strings.CutPrefix(r.URL.Path, "/bot"+token+"/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		params := make(map[string]string)
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			_ = r.ParseMultipartForm(1 << 20)
+			for k, v := range r.MultipartForm.Value {
+				params[k] = v[0]
+			}
+		} else {
+			var raw map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&raw)
+			for k, v := range raw {
+				b, _ := json.Marshal(v)
+				params[k] = strings.Trim(string(b), `"`)
+			}
+		}
+
+		f.mu.Lock()
+		f.calls[method] = append(f.calls[method], params)
+		f.mu.Unlock()
+
+		var result any = true
+		switch method {
+		case "getMe":
+			result = telebot.User{ID: 1, IsBot: true, FirstName: "meow", Username: "meowbot"}
+		case "sendMessage":
+			result = telebot.Message{ID: 1, Text: params["text"], Chat: &telebot.Chat{ID: 42}}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": result})
+	}))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+func (f *fakeTelegram) called(method string) []map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+func TestBot_webhook(t *testing.T) {
+	const (
+		token  = "123:token"
+		secret = "secret-token"
+	)
+
+	api := newFakeTelegram(t, token)
+
+	cfg := Config{BotConfig: BotConfig{
+		Token:  token,
+		APIURL: api.URL,
+		Webhook: WebhookConfig{
+			Enabled:     true,
+			PublicURL:   "https://bot.example.com/tg",
+			SecretToken: secret,
+		},
+	}}
+
+	client := repo.NewMemoryClient(repo.MemoryConfig{})
+	b, err := New(zap.NewNop().Sugar(), cfg, client, scheduling.New(client))
+	require.NoError(t, err)
+	require.NotEmpty(t, api.called("getMe"))
+
+	require.NoError(t, b.registerUpdates())
+	require.Len(t, api.called("setWebhook"), 1)
+	require.Equal(t, cfg.Webhook.PublicURL, api.called("setWebhook")[0]["url"])
+	require.Equal(t, secret, api.called("setWebhook")[0]["secret_token"])
+
+	path, handler := b.Webhook()
+	require.Equal(t, defaultWebhookPath, path)
+	require.NotNil(t, handler)
+
+	// updates are processed here instead of bot.Start, so that nothing
+	// outlives the test and races with the client of the bot
+	sent := make(chan error, 1)
+	b.bot.Handle("/ping", func(c telebot.Context) error {
+		err := c.Send("pong")
+		sent <- err
+		return err
+	})
+
+	dest, stop, polled := make(chan telebot.Update), make(chan struct{}), make(chan struct{})
+	go func() {
+		b.webhook.Poll(b.bot, dest, stop)
+		close(polled)
+	}()
+	t.Cleanup(func() {
+		close(stop)
+		<-polled
+	})
+
+	update := `{"update_id": 1, "message": {"message_id": 1, "text": "/ping",` +
+		` "from": {"id": 42, "username": "cat"}, "chat": {"id": 42, "type": "private"}}}`
+
+	post := func(token string) int {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(update))
+		if token != "" {
+			r.Header.Set(secretTokenHeader, token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, post(""))
+	require.Equal(t, http.StatusUnauthorized, post("wrong"))
+	require.Empty(t, api.called("sendMessage"))
+
+	require.Equal(t, http.StatusOK, post(secret))
+
+	select {
+	case u := <-dest:
+		b.bot.ProcessUpdate(u)
+	case <-time.After(time.Second):
+		require.FailNow(t, "update is not polled")
+	}
+
+	select {
+	case err := <-sent:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.FailNow(t, "update is not handled")
+	}
+
+	messages := api.called("sendMessage")
+	require.Len(t, messages, 1)
+	require.Equal(t, "pong", messages[0]["text"])
+	require.Equal(t, "42", messages[0]["chat_id"])
+}
+
+func Test_newWebhookPoller(t *testing.T) {
+	type testcase struct {
+		name    string
+		cfg     WebhookConfig
+		wantErr bool
+	}
+
+	valid := WebhookConfig{PublicURL: "https://bot.example.com", SecretToken: "abc_DEF-123"}
+
+	withCfg := func(patch func(cfg *WebhookConfig)) WebhookConfig {
+		cfg := valid
+		patch(&cfg)
+		return cfg
+	}
+
+	tests := [...]testcase{
+		{name: "valid", cfg: valid},
+		{name: "no public url", cfg: withCfg(func(cfg *WebhookConfig) { cfg.PublicURL = "" }), wantErr: true},
+		{name: "no secret", cfg: withCfg(func(cfg *WebhookConfig) { cfg.SecretToken = "" }), wantErr: true},
+		{name: "bad secret", cfg: withCfg(func(cfg *WebhookConfig) { cfg.SecretToken = "a b" }), wantErr: true},
+		{name: "cert without key", cfg: withCfg(func(cfg *WebhookConfig) { cfg.TLSCert = "cert.pem" }), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newWebhookPoller(tt.cfg)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,5509 @@
+// Package hrclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package hrclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiKeyScopes = "apiKey.Scopes"
+	HmacScopes   = "hmac.Scopes"
+)
+
+// Defines values for ApplicationStatusName.
+const (
+	Active    ApplicationStatusName = "active"
+	Passed    ApplicationStatusName = "passed"
+	Rejected  ApplicationStatusName = "rejected"
+	Withdrawn ApplicationStatusName = "withdrawn"
+)
+
+// Defines values for InterviewStatusName.
+const (
+	Cancelled InterviewStatusName = "cancelled"
+	Finished  InterviewStatusName = "finished"
+	New       InterviewStatusName = "new"
+	Scheduled InterviewStatusName = "scheduled"
+)
+
+// Defines values for NotificationsChannels.
+const (
+	Email    NotificationsChannels = "email"
+	Telegram NotificationsChannels = "telegram"
+	Webhook  NotificationsChannels = "webhook"
+)
+
+// Defines values for OutcomeName.
+const (
+	CandidateNoShow   OutcomeName = "candidate_no_show"
+	Disputed          OutcomeName = "disputed"
+	Done              OutcomeName = "done"
+	InterviewerNoShow OutcomeName = "interviewer_no_show"
+	Unknown           OutcomeName = "unknown"
+)
+
+// Defines values for ListUsersParamsCategory.
+const (
+	Employee ListUsersParamsCategory = "employee"
+	External ListUsersParamsCategory = "external"
+	Hr       ListUsersParamsCategory = "hr"
+)
+
+// Application defines model for Application.
+type Application struct {
+	Candidate string `json:"candidate"`
+
+	// CreatedAt Unix time in milliseconds
+	CreatedAt int64  `json:"created_at"`
+	Id        string `json:"id"`
+
+	// Interviews Interview ids by stage, the last one is of the current stage
+	Interviews []string `json:"interviews"`
+
+	// Stage Index of the current stage
+	Stage int `json:"stage"`
+
+	// Status 0 - active, 1 - passed, 2 - rejected, 3 - withdrawn
+	Status  int    `json:"status"`
+	Vacancy string `json:"vacancy"`
+}
+
+// ApplicationStatusName defines model for ApplicationStatusName.
+type ApplicationStatusName string
+
+// Availability defines model for Availability.
+type Availability struct {
+	// Exceptions Vacations, no meetings are assigned inside them
+	Exceptions *[]Meeting      `json:"exceptions"`
+	Weekly     *[]WorkingHours `json:"weekly"`
+}
+
+// CreateApplicationRequest defines model for CreateApplicationRequest.
+type CreateApplicationRequest struct {
+	// Candidate Candidate telegram username
+	Candidate string `json:"candidate"`
+	Vacancy   string `json:"vacancy"`
+}
+
+// CreateInterviewRequest defines model for CreateInterviewRequest.
+type CreateInterviewRequest struct {
+	// Candidate Candidate telegram username
+	Candidate string `json:"candidate"`
+
+	// Duration Go duration from 1m to 24h, e.g. "1h30m", empty means default.
+	// Nanoseconds, as durations are returned, are accepted too.
+	Duration *string `json:"duration,omitempty"`
+
+	// MinGrade The lowest interviewer grade from 0 to 4, 0 means no requirement besides the vacancy one
+	MinGrade *int `json:"min_grade,omitempty"`
+
+	// Skills Skills required from interviewers, they replace the vacancy ones if set
+	Skills  *[]string `json:"skills,omitempty"`
+	Vacancy string    `json:"vacancy"`
+}
+
+// CreatedInterview defines model for CreatedInterview.
+type CreatedInterview struct {
+	Id string `json:"id"`
+}
+
+// DeletedInterview defines model for DeletedInterview.
+type DeletedInterview struct {
+	// Cancelled Whether scheduled meeting has been cancelled
+	Cancelled bool `json:"cancelled"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// Interview defines model for Interview.
+type Interview struct {
+	// Attendance Reports of the interviewer and the candidate, 0 - unknown, 1 - happened, 2 - the other side has not come
+	Attendance *[]int `json:"attendance,omitempty"`
+
+	// CancelledBy 0 - interviewer, 1 - candidate, 2 - HR
+	CancelledBy int     `json:"cancelled_by"`
+	Candidate   string  `json:"candidate"`
+	CandidateTg int64   `json:"candidate_tg"`
+	Data        *[]byte `json:"data"`
+
+	// Duration Meeting duration in nanoseconds, 0 means default
+	Duration         int64            `json:"duration"`
+	Id               string           `json:"id"`
+	Interviewer      string           `json:"interviewer"`
+	InterviewerTg    int64            `json:"interviewer_tg"`
+	LastNotification *NotificationLog `json:"last_notification"`
+	Meet             *Meeting         `json:"meet"`
+
+	// MinGrade The lowest interviewer grade, the vacancy one applies if it is higher
+	MinGrade *int `json:"min_grade,omitempty"`
+
+	// Outcome 0 - unknown, 1 - done, 2 - candidate no-show, 3 - interviewer no-show, 4 - disputed
+	Outcome *int   `json:"outcome,omitempty"`
+	Panel   *Panel `json:"panel,omitempty"`
+
+	// Panelists Booked interviewers besides the lead one
+	Panelists *[]Panelist `json:"panelists"`
+	Scorecard *Scorecard  `json:"scorecard"`
+
+	// Skills Skills required from interviewers, they replace the vacancy ones if set
+	Skills *[]string `json:"skills"`
+
+	// Status 0 - new, 1 - scheduled, 2 - finished, 3 - cancelled
+	Status  int    `json:"status"`
+	Vacancy string `json:"vacancy"`
+	Zoom    string `json:"zoom"`
+}
+
+// InterviewPatch defines model for InterviewPatch.
+type InterviewPatch struct {
+	Candidate *string `json:"candidate,omitempty"`
+	Data      *[]byte `json:"data,omitempty"`
+
+	// Duration Go duration from 1m to 24h, e.g. "1h30m", empty means default.
+	// Nanoseconds, as durations are returned, are accepted too.
+	Duration *string `json:"duration,omitempty"`
+
+	// MinGrade The lowest interviewer grade from 0 to 4, 0 means no requirement besides the vacancy one
+	MinGrade *int `json:"min_grade,omitempty"`
+
+	// Skills Skills required from interviewers, they replace the vacancy ones if set
+	Skills  *[]string `json:"skills,omitempty"`
+	Vacancy *string   `json:"vacancy,omitempty"`
+	Zoom    *string   `json:"zoom,omitempty"`
+}
+
+// InterviewStatusName defines model for InterviewStatusName.
+type InterviewStatusName string
+
+// InterviewerGrant defines model for InterviewerGrant.
+type InterviewerGrant struct {
+	// Grade Interviewer grade from 1 (screenings) to 4 (staff loops), 1 by default
+	Grade *int `json:"grade,omitempty"`
+}
+
+// Language Chosen with /language in the bot, the Telegram one is used if nothing is chosen
+type Language struct {
+	Chosen   *string `json:"chosen,omitempty"`
+	Telegram *string `json:"telegram,omitempty"`
+}
+
+// Limits Caps on interviews of the interviewer in their time zone, weeks start on Monday
+type Limits struct {
+	// BufferAfter Free time after every meeting in nanoseconds, at most 2 hours
+	BufferAfter *int64 `json:"bufferAfter,omitempty"`
+
+	// BufferBefore Free time before every meeting in nanoseconds, at most 2 hours
+	BufferBefore *int64 `json:"bufferBefore,omitempty"`
+
+	// PerDay 0 means no limit
+	PerDay *int `json:"perDay,omitempty"`
+
+	// PerWeek 0 means no limit
+	PerWeek *int `json:"perWeek,omitempty"`
+}
+
+// Meeting Meeting interval [start, end) in unix milliseconds
+type Meeting = []int64
+
+// NotificationLog defines model for NotificationLog.
+type NotificationLog struct {
+	Notified []bool `json:"notified"`
+	UnixTime int64  `json:"unix_time"`
+}
+
+// Notifications defines model for Notifications.
+type Notifications struct {
+	// Channels Channels used all at once, empty means telegram only
+	Channels *[]NotificationsChannels `json:"channels"`
+	Email    *string                  `json:"email,omitempty"`
+}
+
+// NotificationsChannels defines model for Notifications.Channels.
+type NotificationsChannels string
+
+// OutcomeName defines model for OutcomeName.
+type OutcomeName string
+
+// OutcomesRow defines model for OutcomesRow.
+type OutcomesRow struct {
+	Interviewer string `json:"interviewer"`
+
+	// Outcomes Number of finished interviews by outcome name
+	Outcomes map[string]int `json:"outcomes"`
+	Total    int            `json:"total"`
+}
+
+// Panel defines model for Panel.
+type Panel struct {
+	// Interviewers Interviewers passing the vacancy restrictions including the lead one, 0 means 1
+	Interviewers *int `json:"interviewers,omitempty"`
+
+	// Shadows Any interviewers attending to learn
+	Shadows *int `json:"shadows,omitempty"`
+}
+
+// Panelist defines model for Panelist.
+type Panelist struct {
+	Shadow   bool   `json:"shadow"`
+	Telegram int64  `json:"telegram"`
+	Username string `json:"username"`
+}
+
+// Rating defines model for Rating.
+type Rating struct {
+	Criterion string `json:"criterion"`
+	Score     int    `json:"score"`
+}
+
+// RescheduleRequest defines model for RescheduleRequest.
+type RescheduleRequest struct {
+	// Start New meeting start, unix milliseconds
+	Start int64 `json:"start"`
+}
+
+// RescheduledInterview defines model for RescheduledInterview.
+type RescheduledInterview struct {
+	// Interviewer Interviewer username, the same one if they are free
+	Interviewer string `json:"interviewer"`
+
+	// Meet Meeting interval [start, end) in unix milliseconds
+	Meet      Meeting     `json:"meet"`
+	Panelists *[]Panelist `json:"panelists"`
+}
+
+// RevokedInterviewer defines model for RevokedInterviewer.
+type RevokedInterviewer struct {
+	// Cancelled IDs of cancelled interviews
+	Cancelled []string `json:"cancelled"`
+}
+
+// Scorecard defines model for Scorecard.
+type Scorecard struct {
+	Comment string    `json:"comment"`
+	Hire    bool      `json:"hire"`
+	Ratings *[]Rating `json:"ratings"`
+
+	// SubmittedAt Unix time in milliseconds
+	SubmittedAt int64 `json:"submitted_at"`
+}
+
+// SkillsRequest defines model for SkillsRequest.
+type SkillsRequest struct {
+	// Skills Tags are lowercased, "#" prefix and duplicates are dropped, empty list removes all
+	Skills []string `json:"skills"`
+}
+
+// Stage defines model for Stage.
+type Stage struct {
+	// Duration Interview duration in nanoseconds, 0 means the vacancy default
+	Duration int64  `json:"duration"`
+	Name     string `json:"name"`
+}
+
+// UpsertEmployeeRequest defines model for UpsertEmployeeRequest.
+type UpsertEmployeeRequest struct {
+	Hr *bool  `json:"hr,omitempty"`
+	Tg string `json:"tg"`
+}
+
+// User defines model for User.
+type User struct {
+	Assigned     *[]Meeting    `json:"assigned"`
+	Availability *Availability `json:"availability"`
+
+	// Category 0 - external, 1 - employee, 2 - HR
+	Category int `json:"category"`
+
+	// IntGrade 0 - not an interviewer, 1 (screenings) to 4 (staff loops) - interviewer grade
+	IntGrade int `json:"intGrade"`
+
+	// Language Chosen with /language in the bot, the Telegram one is used if nothing is chosen
+	Language *Language `json:"language,omitempty"`
+
+	// Limits Caps on interviews of the interviewer in their time zone, weeks start on Monday
+	Limits        *Limits        `json:"limits,omitempty"`
+	Notifications *Notifications `json:"notifications,omitempty"`
+
+	// Skills Skill tags, lowercase single words like "go" or "frontend"
+	Skills   *[]string `json:"skills"`
+	Telegram int64     `json:"telegram"`
+
+	// TimeZone IANA name, empty means UTC
+	TimeZone *string `json:"timeZone,omitempty"`
+	Username string  `json:"username"`
+}
+
+// Vacancy defines model for Vacancy.
+type Vacancy struct {
+	// Duration Default interview duration in nanoseconds, 0 means not set
+	Duration int64  `json:"duration"`
+	Id       string `json:"id"`
+
+	// Interviewers Usernames allowed to interview, empty means anyone
+	Interviewers *[]string `json:"interviewers"`
+
+	// MinGrade The lowest interviewer grade from 0 to 4
+	MinGrade int `json:"min_grade"`
+
+	// Skills Skills required from interviewers, any of them is enough; empty means any interviewer
+	Skills *[]string `json:"skills"`
+
+	// Stages Pipeline stages, empty means a single interview
+	Stages *[]Stage `json:"stages"`
+	Title  string   `json:"title"`
+
+	// Zoom Default meeting link
+	Zoom string `json:"zoom"`
+}
+
+// VacancyRequest defines model for VacancyRequest.
+type VacancyRequest struct {
+	// Duration Go duration from 1m to 24h, e.g. "1h30m", empty means not set.
+	// Nanoseconds, as durations are returned, are accepted too.
+	Duration     *string   `json:"duration,omitempty"`
+	Interviewers *[]string `json:"interviewers,omitempty"`
+	MinGrade     *int      `json:"min_grade,omitempty"`
+	Skills       *[]string `json:"skills,omitempty"`
+	Stages       *[]struct {
+		// Duration Go duration from 1m to 24h, e.g. "1h30m", empty means the vacancy default.
+		// Nanoseconds, as durations are returned, are accepted too.
+		Duration *string `json:"duration,omitempty"`
+		Name     string  `json:"name"`
+	} `json:"stages,omitempty"`
+	Title *string `json:"title,omitempty"`
+	Zoom  *string `json:"zoom,omitempty"`
+}
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// From Minutes since midnight, inclusive
+	From int `json:"from"`
+
+	// To Minutes since midnight, exclusive
+	To int `json:"to"`
+
+	// Weekday 0 - Sunday, ..., 6 - Saturday
+	Weekday int `json:"weekday"`
+}
+
+// ApplicationID defines model for ApplicationID.
+type ApplicationID = string
+
+// InterviewID defines model for InterviewID.
+type InterviewID = string
+
+// Outcome defines model for Outcome.
+type Outcome = OutcomeName
+
+// Username defines model for Username.
+type Username = string
+
+// VacancyID defines model for VacancyID.
+type VacancyID = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Error
+
+// Conflict defines model for Conflict.
+type Conflict = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// ListApplicationsParams defines parameters for ListApplications.
+type ListApplicationsParams struct {
+	Status  *ApplicationStatusName `form:"status,omitempty" json:"status,omitempty"`
+	Vacancy *string                `form:"vacancy,omitempty" json:"vacancy,omitempty"`
+
+	// Candidate Candidate telegram username
+	Candidate *string `form:"candidate,omitempty" json:"candidate,omitempty"`
+}
+
+// GetAvailabilityParams defines parameters for GetAvailability.
+type GetAvailabilityParams struct {
+	Username string `form:"username" json:"username"`
+}
+
+// SetAvailabilityParams defines parameters for SetAvailability.
+type SetAvailabilityParams struct {
+	Username string `form:"username" json:"username"`
+}
+
+// InterviewDataParams defines parameters for InterviewData.
+type InterviewDataParams struct {
+	Iid string `form:"iid" json:"iid"`
+}
+
+// ListInterviewsParams defines parameters for ListInterviews.
+type ListInterviewsParams struct {
+	Status  *InterviewStatusName `form:"status,omitempty" json:"status,omitempty"`
+	Outcome *Outcome             `form:"outcome,omitempty" json:"outcome,omitempty"`
+	Vacancy *string              `form:"vacancy,omitempty" json:"vacancy,omitempty"`
+
+	// Candidate Candidate telegram username
+	Candidate *string `form:"candidate,omitempty" json:"candidate,omitempty"`
+
+	// Interviewer Interviewer telegram username
+	Interviewer *string `form:"interviewer,omitempty" json:"interviewer,omitempty"`
+
+	// From Lower bound of meeting start, unix milliseconds, inclusive
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Upper bound of meeting start, unix milliseconds, exclusive
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// OutcomesReportParams defines parameters for OutcomesReport.
+type OutcomesReportParams struct {
+	Vacancy *string `form:"vacancy,omitempty" json:"vacancy,omitempty"`
+
+	// Candidate Candidate telegram username
+	Candidate *string `form:"candidate,omitempty" json:"candidate,omitempty"`
+
+	// Interviewer Interviewer telegram username
+	Interviewer *string `form:"interviewer,omitempty" json:"interviewer,omitempty"`
+
+	// From Lower bound of meeting start, unix milliseconds, inclusive
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Upper bound of meeting start, unix milliseconds, exclusive
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	Category *ListUsersParamsCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Interviewer Return only interviewers
+	Interviewer *bool `form:"interviewer,omitempty" json:"interviewer,omitempty"`
+}
+
+// ListUsersParamsCategory defines parameters for ListUsers.
+type ListUsersParamsCategory string
+
+// SetTimeZoneJSONBody defines parameters for SetTimeZone.
+type SetTimeZoneJSONBody struct {
+	// TimeZone IANA name like "Asia/Almaty", empty one resets it to UTC
+	TimeZone string `json:"timeZone"`
+}
+
+// CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
+type CreateApplicationJSONRequestBody = CreateApplicationRequest
+
+// SetAvailabilityJSONRequestBody defines body for SetAvailability for application/json ContentType.
+type SetAvailabilityJSONRequestBody = Availability
+
+// InterviewDataJSONRequestBody defines body for InterviewData for application/json ContentType.
+type InterviewDataJSONRequestBody = InterviewPatch
+
+// CreateInterviewJSONRequestBody defines body for CreateInterview for application/json ContentType.
+type CreateInterviewJSONRequestBody = CreateInterviewRequest
+
+// PatchInterviewJSONRequestBody defines body for PatchInterview for application/json ContentType.
+type PatchInterviewJSONRequestBody = InterviewPatch
+
+// SetInterviewPanelJSONRequestBody defines body for SetInterviewPanel for application/json ContentType.
+type SetInterviewPanelJSONRequestBody = Panel
+
+// RescheduleInterviewJSONRequestBody defines body for RescheduleInterview for application/json ContentType.
+type RescheduleInterviewJSONRequestBody = RescheduleRequest
+
+// UpsertEmployeeJSONRequestBody defines body for UpsertEmployee for application/json ContentType.
+type UpsertEmployeeJSONRequestBody = UpsertEmployeeRequest
+
+// GrantInterviewerJSONRequestBody defines body for GrantInterviewer for application/json ContentType.
+type GrantInterviewerJSONRequestBody = InterviewerGrant
+
+// SetLimitsJSONRequestBody defines body for SetLimits for application/json ContentType.
+type SetLimitsJSONRequestBody = Limits
+
+// SetNotificationsJSONRequestBody defines body for SetNotifications for application/json ContentType.
+type SetNotificationsJSONRequestBody = Notifications
+
+// SetSkillsJSONRequestBody defines body for SetSkills for application/json ContentType.
+type SetSkillsJSONRequestBody = SkillsRequest
+
+// SetTimeZoneJSONRequestBody defines body for SetTimeZone for application/json ContentType.
+type SetTimeZoneJSONRequestBody SetTimeZoneJSONBody
+
+// PutVacancyJSONRequestBody defines body for PutVacancy for application/json ContentType.
+type PutVacancyJSONRequestBody = VacancyRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListApplications request
+	ListApplications(ctx context.Context, params *ListApplicationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateApplicationWithBody request with any body
+	CreateApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateApplication(ctx context.Context, body CreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApplication request
+	GetApplication(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WithdrawApplication request
+	WithdrawApplication(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAvailability request
+	GetAvailability(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAvailabilityWithBody request with any body
+	SetAvailabilityWithBody(ctx context.Context, params *SetAvailabilityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAvailability(ctx context.Context, params *SetAvailabilityParams, body SetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InterviewDataWithBody request with any body
+	InterviewDataWithBody(ctx context.Context, params *InterviewDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InterviewData(ctx context.Context, params *InterviewDataParams, body InterviewDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInterviews request
+	ListInterviews(ctx context.Context, params *ListInterviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInterviewWithBody request with any body
+	CreateInterviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInterview(ctx context.Context, body CreateInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInterview request
+	DeleteInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInterview request
+	GetInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchInterviewWithBody request with any body
+	PatchInterviewWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchInterview(ctx context.Context, id InterviewID, body PatchInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelInterview request
+	CancelInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DoneInterview request
+	DoneInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetInterviewPanelWithBody request with any body
+	SetInterviewPanelWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetInterviewPanel(ctx context.Context, id InterviewID, body SetInterviewPanelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RescheduleInterviewWithBody request with any body
+	RescheduleInterviewWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RescheduleInterview(ctx context.Context, id InterviewID, body RescheduleInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScorecard request
+	GetScorecard(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OutcomesReport request
+	OutcomesReport(ctx context.Context, params *OutcomesReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpsertEmployeeWithBody request with any body
+	UpsertEmployeeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpsertEmployee(ctx context.Context, body UpsertEmployeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsers request
+	ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeInterviewer request
+	RevokeInterviewer(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GrantInterviewerWithBody request with any body
+	GrantInterviewerWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GrantInterviewer(ctx context.Context, username Username, body GrantInterviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUserInterviews request
+	ListUserInterviews(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetLimitsWithBody request with any body
+	SetLimitsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetLimits(ctx context.Context, username Username, body SetLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetNotificationsWithBody request with any body
+	SetNotificationsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetNotifications(ctx context.Context, username Username, body SetNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetSkillsWithBody request with any body
+	SetSkillsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetSkills(ctx context.Context, username Username, body SetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTimeZoneWithBody request with any body
+	SetTimeZoneWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTimeZone(ctx context.Context, username Username, body SetTimeZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVacancies request
+	ListVacancies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVacancy request
+	DeleteVacancy(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVacancy request
+	GetVacancy(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutVacancyWithBody request with any body
+	PutVacancyWithBody(ctx context.Context, id VacancyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutVacancy(ctx context.Context, id VacancyID, body PutVacancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListApplications(ctx context.Context, params *ListApplicationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApplicationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApplicationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApplication(ctx context.Context, body CreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApplicationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApplication(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApplicationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WithdrawApplication(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWithdrawApplicationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAvailability(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAvailabilityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAvailabilityWithBody(ctx context.Context, params *SetAvailabilityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAvailabilityRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAvailability(ctx context.Context, params *SetAvailabilityParams, body SetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAvailabilityRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InterviewDataWithBody(ctx context.Context, params *InterviewDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterviewDataRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InterviewData(ctx context.Context, params *InterviewDataParams, body InterviewDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterviewDataRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInterviews(ctx context.Context, params *ListInterviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInterviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterviewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterview(ctx context.Context, body CreateInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterviewRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInterviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInterviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchInterviewWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchInterviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchInterview(ctx context.Context, id InterviewID, body PatchInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchInterviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelInterviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DoneInterview(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDoneInterviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetInterviewPanelWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetInterviewPanelRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetInterviewPanel(ctx context.Context, id InterviewID, body SetInterviewPanelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetInterviewPanelRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RescheduleInterviewWithBody(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleInterviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RescheduleInterview(ctx context.Context, id InterviewID, body RescheduleInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleInterviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScorecard(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScorecardRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OutcomesReport(ctx context.Context, params *OutcomesReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOutcomesReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertEmployeeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertEmployeeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertEmployee(ctx context.Context, body UpsertEmployeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertEmployeeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeInterviewer(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeInterviewerRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrantInterviewerWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrantInterviewerRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrantInterviewer(ctx context.Context, username Username, body GrantInterviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrantInterviewerRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUserInterviews(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUserInterviewsRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetLimitsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetLimitsRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetLimits(ctx context.Context, username Username, body SetLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetLimitsRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetNotificationsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetNotificationsRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetNotifications(ctx context.Context, username Username, body SetNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetNotificationsRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetSkillsWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSkillsRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetSkills(ctx context.Context, username Username, body SetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSkillsRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTimeZoneWithBody(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeZoneRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTimeZone(ctx context.Context, username Username, body SetTimeZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeZoneRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVacancies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVacanciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVacancy(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVacancyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVacancy(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVacancyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutVacancyWithBody(ctx context.Context, id VacancyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutVacancyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutVacancy(ctx context.Context, id VacancyID, body PutVacancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutVacancyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListApplicationsRequest generates requests for ListApplications
+func NewListApplicationsRequest(server string, params *ListApplicationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/applications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Vacancy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vacancy", runtime.ParamLocationQuery, *params.Vacancy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Candidate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "candidate", runtime.ParamLocationQuery, *params.Candidate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateApplicationRequest calls the generic CreateApplication builder with application/json body
+func NewCreateApplicationRequest(server string, body CreateApplicationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateApplicationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateApplicationRequestWithBody generates requests for CreateApplication with any type of body
+func NewCreateApplicationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/applications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApplicationRequest generates requests for GetApplication
+func NewGetApplicationRequest(server string, id ApplicationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/applications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWithdrawApplicationRequest generates requests for WithdrawApplication
+func NewWithdrawApplicationRequest(server string, id ApplicationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/applications/%s/withdraw", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAvailabilityRequest generates requests for GetAvailability
+func NewGetAvailabilityRequest(server string, params *GetAvailabilityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/availability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetAvailabilityRequest calls the generic SetAvailability builder with application/json body
+func NewSetAvailabilityRequest(server string, params *SetAvailabilityParams, body SetAvailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAvailabilityRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSetAvailabilityRequestWithBody generates requests for SetAvailability with any type of body
+func NewSetAvailabilityRequestWithBody(server string, params *SetAvailabilityParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/availability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInterviewDataRequest calls the generic InterviewData builder with application/json body
+func NewInterviewDataRequest(server string, params *InterviewDataParams, body InterviewDataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInterviewDataRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewInterviewDataRequestWithBody generates requests for InterviewData with any type of body
+func NewInterviewDataRequestWithBody(server string, params *InterviewDataParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviewData")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "iid", runtime.ParamLocationQuery, params.Iid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListInterviewsRequest generates requests for ListInterviews
+func NewListInterviewsRequest(server string, params *ListInterviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Outcome != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Vacancy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vacancy", runtime.ParamLocationQuery, *params.Vacancy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Candidate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "candidate", runtime.ParamLocationQuery, *params.Candidate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interviewer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interviewer", runtime.ParamLocationQuery, *params.Interviewer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInterviewRequest calls the generic CreateInterview builder with application/json body
+func NewCreateInterviewRequest(server string, body CreateInterviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInterviewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInterviewRequestWithBody generates requests for CreateInterview with any type of body
+func NewCreateInterviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInterviewRequest generates requests for DeleteInterview
+func NewDeleteInterviewRequest(server string, id InterviewID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInterviewRequest generates requests for GetInterview
+func NewGetInterviewRequest(server string, id InterviewID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchInterviewRequest calls the generic PatchInterview builder with application/json body
+func NewPatchInterviewRequest(server string, id InterviewID, body PatchInterviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchInterviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPatchInterviewRequestWithBody generates requests for PatchInterview with any type of body
+func NewPatchInterviewRequestWithBody(server string, id InterviewID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelInterviewRequest generates requests for CancelInterview
+func NewCancelInterviewRequest(server string, id InterviewID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDoneInterviewRequest generates requests for DoneInterview
+func NewDoneInterviewRequest(server string, id InterviewID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s/done", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetInterviewPanelRequest calls the generic SetInterviewPanel builder with application/json body
+func NewSetInterviewPanelRequest(server string, id InterviewID, body SetInterviewPanelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetInterviewPanelRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetInterviewPanelRequestWithBody generates requests for SetInterviewPanel with any type of body
+func NewSetInterviewPanelRequestWithBody(server string, id InterviewID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s/panel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRescheduleInterviewRequest calls the generic RescheduleInterview builder with application/json body
+func NewRescheduleInterviewRequest(server string, id InterviewID, body RescheduleInterviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRescheduleInterviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRescheduleInterviewRequestWithBody generates requests for RescheduleInterview with any type of body
+func NewRescheduleInterviewRequestWithBody(server string, id InterviewID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s/reschedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScorecardRequest generates requests for GetScorecard
+func NewGetScorecardRequest(server string, id InterviewID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interviews/%s/scorecard", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOutcomesReportRequest generates requests for OutcomesReport
+func NewOutcomesReportRequest(server string, params *OutcomesReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/outcomes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Vacancy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vacancy", runtime.ParamLocationQuery, *params.Vacancy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Candidate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "candidate", runtime.ParamLocationQuery, *params.Candidate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interviewer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interviewer", runtime.ParamLocationQuery, *params.Interviewer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpsertEmployeeRequest calls the generic UpsertEmployee builder with application/json body
+func NewUpsertEmployeeRequest(server string, body UpsertEmployeeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertEmployeeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpsertEmployeeRequestWithBody generates requests for UpsertEmployee with any type of body
+func NewUpsertEmployeeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/upsertEmployee")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interviewer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interviewer", runtime.ParamLocationQuery, *params.Interviewer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, username Username) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeInterviewerRequest generates requests for RevokeInterviewer
+func NewRevokeInterviewerRequest(server string, username Username) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/interviewer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGrantInterviewerRequest calls the generic GrantInterviewer builder with application/json body
+func NewGrantInterviewerRequest(server string, username Username, body GrantInterviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGrantInterviewerRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewGrantInterviewerRequestWithBody generates requests for GrantInterviewer with any type of body
+func NewGrantInterviewerRequestWithBody(server string, username Username, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/interviewer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUserInterviewsRequest generates requests for ListUserInterviews
+func NewListUserInterviewsRequest(server string, username Username) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/interviews", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetLimitsRequest calls the generic SetLimits builder with application/json body
+func NewSetLimitsRequest(server string, username Username, body SetLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetLimitsRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetLimitsRequestWithBody generates requests for SetLimits with any type of body
+func NewSetLimitsRequestWithBody(server string, username Username, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetNotificationsRequest calls the generic SetNotifications builder with application/json body
+func NewSetNotificationsRequest(server string, username Username, body SetNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetNotificationsRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetNotificationsRequestWithBody generates requests for SetNotifications with any type of body
+func NewSetNotificationsRequestWithBody(server string, username Username, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/notifications", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetSkillsRequest calls the generic SetSkills builder with application/json body
+func NewSetSkillsRequest(server string, username Username, body SetSkillsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetSkillsRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetSkillsRequestWithBody generates requests for SetSkills with any type of body
+func NewSetSkillsRequestWithBody(server string, username Username, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/skills", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTimeZoneRequest calls the generic SetTimeZone builder with application/json body
+func NewSetTimeZoneRequest(server string, username Username, body SetTimeZoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTimeZoneRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetTimeZoneRequestWithBody generates requests for SetTimeZone with any type of body
+func NewSetTimeZoneRequestWithBody(server string, username Username, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/timezone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListVacanciesRequest generates requests for ListVacancies
+func NewListVacanciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vacancies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteVacancyRequest generates requests for DeleteVacancy
+func NewDeleteVacancyRequest(server string, id VacancyID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vacancies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVacancyRequest generates requests for GetVacancy
+func NewGetVacancyRequest(server string, id VacancyID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vacancies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutVacancyRequest calls the generic PutVacancy builder with application/json body
+func NewPutVacancyRequest(server string, id VacancyID, body PutVacancyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutVacancyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutVacancyRequestWithBody generates requests for PutVacancy with any type of body
+func NewPutVacancyRequestWithBody(server string, id VacancyID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vacancies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListApplicationsWithResponse request
+	ListApplicationsWithResponse(ctx context.Context, params *ListApplicationsParams, reqEditors ...RequestEditorFn) (*ListApplicationsResponse, error)
+
+	// CreateApplicationWithBodyWithResponse request with any body
+	CreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApplicationResponse, error)
+
+	CreateApplicationWithResponse(ctx context.Context, body CreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApplicationResponse, error)
+
+	// GetApplicationWithResponse request
+	GetApplicationWithResponse(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*GetApplicationResponse, error)
+
+	// WithdrawApplicationWithResponse request
+	WithdrawApplicationWithResponse(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*WithdrawApplicationResponse, error)
+
+	// GetAvailabilityWithResponse request
+	GetAvailabilityWithResponse(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*GetAvailabilityResponse, error)
+
+	// SetAvailabilityWithBodyWithResponse request with any body
+	SetAvailabilityWithBodyWithResponse(ctx context.Context, params *SetAvailabilityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAvailabilityResponse, error)
+
+	SetAvailabilityWithResponse(ctx context.Context, params *SetAvailabilityParams, body SetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAvailabilityResponse, error)
+
+	// InterviewDataWithBodyWithResponse request with any body
+	InterviewDataWithBodyWithResponse(ctx context.Context, params *InterviewDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InterviewDataResponse, error)
+
+	InterviewDataWithResponse(ctx context.Context, params *InterviewDataParams, body InterviewDataJSONRequestBody, reqEditors ...RequestEditorFn) (*InterviewDataResponse, error)
+
+	// ListInterviewsWithResponse request
+	ListInterviewsWithResponse(ctx context.Context, params *ListInterviewsParams, reqEditors ...RequestEditorFn) (*ListInterviewsResponse, error)
+
+	// CreateInterviewWithBodyWithResponse request with any body
+	CreateInterviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterviewResponse, error)
+
+	CreateInterviewWithResponse(ctx context.Context, body CreateInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterviewResponse, error)
+
+	// DeleteInterviewWithResponse request
+	DeleteInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*DeleteInterviewResponse, error)
+
+	// GetInterviewWithResponse request
+	GetInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*GetInterviewResponse, error)
+
+	// PatchInterviewWithBodyWithResponse request with any body
+	PatchInterviewWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInterviewResponse, error)
+
+	PatchInterviewWithResponse(ctx context.Context, id InterviewID, body PatchInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInterviewResponse, error)
+
+	// CancelInterviewWithResponse request
+	CancelInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*CancelInterviewResponse, error)
+
+	// DoneInterviewWithResponse request
+	DoneInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*DoneInterviewResponse, error)
+
+	// SetInterviewPanelWithBodyWithResponse request with any body
+	SetInterviewPanelWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetInterviewPanelResponse, error)
+
+	SetInterviewPanelWithResponse(ctx context.Context, id InterviewID, body SetInterviewPanelJSONRequestBody, reqEditors ...RequestEditorFn) (*SetInterviewPanelResponse, error)
+
+	// RescheduleInterviewWithBodyWithResponse request with any body
+	RescheduleInterviewWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleInterviewResponse, error)
+
+	RescheduleInterviewWithResponse(ctx context.Context, id InterviewID, body RescheduleInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleInterviewResponse, error)
+
+	// GetScorecardWithResponse request
+	GetScorecardWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*GetScorecardResponse, error)
+
+	// OutcomesReportWithResponse request
+	OutcomesReportWithResponse(ctx context.Context, params *OutcomesReportParams, reqEditors ...RequestEditorFn) (*OutcomesReportResponse, error)
+
+	// UpsertEmployeeWithBodyWithResponse request with any body
+	UpsertEmployeeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEmployeeResponse, error)
+
+	UpsertEmployeeWithResponse(ctx context.Context, body UpsertEmployeeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEmployeeResponse, error)
+
+	// ListUsersWithResponse request
+	ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// RevokeInterviewerWithResponse request
+	RevokeInterviewerWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*RevokeInterviewerResponse, error)
+
+	// GrantInterviewerWithBodyWithResponse request with any body
+	GrantInterviewerWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrantInterviewerResponse, error)
+
+	GrantInterviewerWithResponse(ctx context.Context, username Username, body GrantInterviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*GrantInterviewerResponse, error)
+
+	// ListUserInterviewsWithResponse request
+	ListUserInterviewsWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*ListUserInterviewsResponse, error)
+
+	// SetLimitsWithBodyWithResponse request with any body
+	SetLimitsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetLimitsResponse, error)
+
+	SetLimitsWithResponse(ctx context.Context, username Username, body SetLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetLimitsResponse, error)
+
+	// SetNotificationsWithBodyWithResponse request with any body
+	SetNotificationsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetNotificationsResponse, error)
+
+	SetNotificationsWithResponse(ctx context.Context, username Username, body SetNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetNotificationsResponse, error)
+
+	// SetSkillsWithBodyWithResponse request with any body
+	SetSkillsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSkillsResponse, error)
+
+	SetSkillsWithResponse(ctx context.Context, username Username, body SetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSkillsResponse, error)
+
+	// SetTimeZoneWithBodyWithResponse request with any body
+	SetTimeZoneWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeZoneResponse, error)
+
+	SetTimeZoneWithResponse(ctx context.Context, username Username, body SetTimeZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeZoneResponse, error)
+
+	// ListVacanciesWithResponse request
+	ListVacanciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVacanciesResponse, error)
+
+	// DeleteVacancyWithResponse request
+	DeleteVacancyWithResponse(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*DeleteVacancyResponse, error)
+
+	// GetVacancyWithResponse request
+	GetVacancyWithResponse(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*GetVacancyResponse, error)
+
+	// PutVacancyWithBodyWithResponse request with any body
+	PutVacancyWithBodyWithResponse(ctx context.Context, id VacancyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutVacancyResponse, error)
+
+	PutVacancyWithResponse(ctx context.Context, id VacancyID, body PutVacancyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutVacancyResponse, error)
+}
+
+type ListApplicationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Application
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListApplicationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApplicationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Application
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Application
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WithdrawApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Application
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WithdrawApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WithdrawApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Availability
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InterviewDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InterviewDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InterviewDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInterviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Interview
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInterviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInterviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedInterview
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeletedInterview
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Interview
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DoneInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DoneInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DoneInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetInterviewPanelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Panel
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetInterviewPanelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetInterviewPanelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RescheduleInterviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RescheduledInterview
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RescheduleInterviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RescheduleInterviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScorecardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scorecard
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScorecardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScorecardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OutcomesReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OutcomesRow
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OutcomesReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OutcomesReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertEmployeeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertEmployeeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertEmployeeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeInterviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevokedInterviewer
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeInterviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeInterviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GrantInterviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GrantInterviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GrantInterviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUserInterviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Interview
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserInterviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserInterviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetLimitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetSkillsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetSkillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetSkillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTimeZoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTimeZoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTimeZoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVacanciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Vacancy
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVacanciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVacanciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVacancyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVacancyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVacancyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVacancyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Vacancy
+	JSON404      *NotFound
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVacancyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVacancyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutVacancyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Vacancy
+	JSON400      *BadRequest
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutVacancyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutVacancyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListApplicationsWithResponse request returning *ListApplicationsResponse
+func (c *ClientWithResponses) ListApplicationsWithResponse(ctx context.Context, params *ListApplicationsParams, reqEditors ...RequestEditorFn) (*ListApplicationsResponse, error) {
+	rsp, err := c.ListApplications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApplicationsResponse(rsp)
+}
+
+// CreateApplicationWithBodyWithResponse request with arbitrary body returning *CreateApplicationResponse
+func (c *ClientWithResponses) CreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApplicationResponse, error) {
+	rsp, err := c.CreateApplicationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApplicationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateApplicationWithResponse(ctx context.Context, body CreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApplicationResponse, error) {
+	rsp, err := c.CreateApplication(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApplicationResponse(rsp)
+}
+
+// GetApplicationWithResponse request returning *GetApplicationResponse
+func (c *ClientWithResponses) GetApplicationWithResponse(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*GetApplicationResponse, error) {
+	rsp, err := c.GetApplication(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApplicationResponse(rsp)
+}
+
+// WithdrawApplicationWithResponse request returning *WithdrawApplicationResponse
+func (c *ClientWithResponses) WithdrawApplicationWithResponse(ctx context.Context, id ApplicationID, reqEditors ...RequestEditorFn) (*WithdrawApplicationResponse, error) {
+	rsp, err := c.WithdrawApplication(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWithdrawApplicationResponse(rsp)
+}
+
+// GetAvailabilityWithResponse request returning *GetAvailabilityResponse
+func (c *ClientWithResponses) GetAvailabilityWithResponse(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*GetAvailabilityResponse, error) {
+	rsp, err := c.GetAvailability(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAvailabilityResponse(rsp)
+}
+
+// SetAvailabilityWithBodyWithResponse request with arbitrary body returning *SetAvailabilityResponse
+func (c *ClientWithResponses) SetAvailabilityWithBodyWithResponse(ctx context.Context, params *SetAvailabilityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAvailabilityResponse, error) {
+	rsp, err := c.SetAvailabilityWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAvailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetAvailabilityWithResponse(ctx context.Context, params *SetAvailabilityParams, body SetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAvailabilityResponse, error) {
+	rsp, err := c.SetAvailability(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAvailabilityResponse(rsp)
+}
+
+// InterviewDataWithBodyWithResponse request with arbitrary body returning *InterviewDataResponse
+func (c *ClientWithResponses) InterviewDataWithBodyWithResponse(ctx context.Context, params *InterviewDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InterviewDataResponse, error) {
+	rsp, err := c.InterviewDataWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterviewDataResponse(rsp)
+}
+
+func (c *ClientWithResponses) InterviewDataWithResponse(ctx context.Context, params *InterviewDataParams, body InterviewDataJSONRequestBody, reqEditors ...RequestEditorFn) (*InterviewDataResponse, error) {
+	rsp, err := c.InterviewData(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterviewDataResponse(rsp)
+}
+
+// ListInterviewsWithResponse request returning *ListInterviewsResponse
+func (c *ClientWithResponses) ListInterviewsWithResponse(ctx context.Context, params *ListInterviewsParams, reqEditors ...RequestEditorFn) (*ListInterviewsResponse, error) {
+	rsp, err := c.ListInterviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInterviewsResponse(rsp)
+}
+
+// CreateInterviewWithBodyWithResponse request with arbitrary body returning *CreateInterviewResponse
+func (c *ClientWithResponses) CreateInterviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterviewResponse, error) {
+	rsp, err := c.CreateInterviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInterviewWithResponse(ctx context.Context, body CreateInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterviewResponse, error) {
+	rsp, err := c.CreateInterview(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterviewResponse(rsp)
+}
+
+// DeleteInterviewWithResponse request returning *DeleteInterviewResponse
+func (c *ClientWithResponses) DeleteInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*DeleteInterviewResponse, error) {
+	rsp, err := c.DeleteInterview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInterviewResponse(rsp)
+}
+
+// GetInterviewWithResponse request returning *GetInterviewResponse
+func (c *ClientWithResponses) GetInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*GetInterviewResponse, error) {
+	rsp, err := c.GetInterview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInterviewResponse(rsp)
+}
+
+// PatchInterviewWithBodyWithResponse request with arbitrary body returning *PatchInterviewResponse
+func (c *ClientWithResponses) PatchInterviewWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInterviewResponse, error) {
+	rsp, err := c.PatchInterviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchInterviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchInterviewWithResponse(ctx context.Context, id InterviewID, body PatchInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInterviewResponse, error) {
+	rsp, err := c.PatchInterview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchInterviewResponse(rsp)
+}
+
+// CancelInterviewWithResponse request returning *CancelInterviewResponse
+func (c *ClientWithResponses) CancelInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*CancelInterviewResponse, error) {
+	rsp, err := c.CancelInterview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelInterviewResponse(rsp)
+}
+
+// DoneInterviewWithResponse request returning *DoneInterviewResponse
+func (c *ClientWithResponses) DoneInterviewWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*DoneInterviewResponse, error) {
+	rsp, err := c.DoneInterview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDoneInterviewResponse(rsp)
+}
+
+// SetInterviewPanelWithBodyWithResponse request with arbitrary body returning *SetInterviewPanelResponse
+func (c *ClientWithResponses) SetInterviewPanelWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetInterviewPanelResponse, error) {
+	rsp, err := c.SetInterviewPanelWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetInterviewPanelResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetInterviewPanelWithResponse(ctx context.Context, id InterviewID, body SetInterviewPanelJSONRequestBody, reqEditors ...RequestEditorFn) (*SetInterviewPanelResponse, error) {
+	rsp, err := c.SetInterviewPanel(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetInterviewPanelResponse(rsp)
+}
+
+// RescheduleInterviewWithBodyWithResponse request with arbitrary body returning *RescheduleInterviewResponse
+func (c *ClientWithResponses) RescheduleInterviewWithBodyWithResponse(ctx context.Context, id InterviewID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleInterviewResponse, error) {
+	rsp, err := c.RescheduleInterviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleInterviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) RescheduleInterviewWithResponse(ctx context.Context, id InterviewID, body RescheduleInterviewJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleInterviewResponse, error) {
+	rsp, err := c.RescheduleInterview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleInterviewResponse(rsp)
+}
+
+// GetScorecardWithResponse request returning *GetScorecardResponse
+func (c *ClientWithResponses) GetScorecardWithResponse(ctx context.Context, id InterviewID, reqEditors ...RequestEditorFn) (*GetScorecardResponse, error) {
+	rsp, err := c.GetScorecard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScorecardResponse(rsp)
+}
+
+// OutcomesReportWithResponse request returning *OutcomesReportResponse
+func (c *ClientWithResponses) OutcomesReportWithResponse(ctx context.Context, params *OutcomesReportParams, reqEditors ...RequestEditorFn) (*OutcomesReportResponse, error) {
+	rsp, err := c.OutcomesReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOutcomesReportResponse(rsp)
+}
+
+// UpsertEmployeeWithBodyWithResponse request with arbitrary body returning *UpsertEmployeeResponse
+func (c *ClientWithResponses) UpsertEmployeeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEmployeeResponse, error) {
+	rsp, err := c.UpsertEmployeeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertEmployeeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpsertEmployeeWithResponse(ctx context.Context, body UpsertEmployeeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEmployeeResponse, error) {
+	rsp, err := c.UpsertEmployee(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertEmployeeResponse(rsp)
+}
+
+// ListUsersWithResponse request returning *ListUsersResponse
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// RevokeInterviewerWithResponse request returning *RevokeInterviewerResponse
+func (c *ClientWithResponses) RevokeInterviewerWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*RevokeInterviewerResponse, error) {
+	rsp, err := c.RevokeInterviewer(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeInterviewerResponse(rsp)
+}
+
+// GrantInterviewerWithBodyWithResponse request with arbitrary body returning *GrantInterviewerResponse
+func (c *ClientWithResponses) GrantInterviewerWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrantInterviewerResponse, error) {
+	rsp, err := c.GrantInterviewerWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrantInterviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) GrantInterviewerWithResponse(ctx context.Context, username Username, body GrantInterviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*GrantInterviewerResponse, error) {
+	rsp, err := c.GrantInterviewer(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrantInterviewerResponse(rsp)
+}
+
+// ListUserInterviewsWithResponse request returning *ListUserInterviewsResponse
+func (c *ClientWithResponses) ListUserInterviewsWithResponse(ctx context.Context, username Username, reqEditors ...RequestEditorFn) (*ListUserInterviewsResponse, error) {
+	rsp, err := c.ListUserInterviews(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserInterviewsResponse(rsp)
+}
+
+// SetLimitsWithBodyWithResponse request with arbitrary body returning *SetLimitsResponse
+func (c *ClientWithResponses) SetLimitsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetLimitsResponse, error) {
+	rsp, err := c.SetLimitsWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetLimitsWithResponse(ctx context.Context, username Username, body SetLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetLimitsResponse, error) {
+	rsp, err := c.SetLimits(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetLimitsResponse(rsp)
+}
+
+// SetNotificationsWithBodyWithResponse request with arbitrary body returning *SetNotificationsResponse
+func (c *ClientWithResponses) SetNotificationsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetNotificationsResponse, error) {
+	rsp, err := c.SetNotificationsWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetNotificationsWithResponse(ctx context.Context, username Username, body SetNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetNotificationsResponse, error) {
+	rsp, err := c.SetNotifications(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetNotificationsResponse(rsp)
+}
+
+// SetSkillsWithBodyWithResponse request with arbitrary body returning *SetSkillsResponse
+func (c *ClientWithResponses) SetSkillsWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSkillsResponse, error) {
+	rsp, err := c.SetSkillsWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSkillsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetSkillsWithResponse(ctx context.Context, username Username, body SetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSkillsResponse, error) {
+	rsp, err := c.SetSkills(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSkillsResponse(rsp)
+}
+
+// SetTimeZoneWithBodyWithResponse request with arbitrary body returning *SetTimeZoneResponse
+func (c *ClientWithResponses) SetTimeZoneWithBodyWithResponse(ctx context.Context, username Username, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeZoneResponse, error) {
+	rsp, err := c.SetTimeZoneWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeZoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTimeZoneWithResponse(ctx context.Context, username Username, body SetTimeZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeZoneResponse, error) {
+	rsp, err := c.SetTimeZone(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeZoneResponse(rsp)
+}
+
+// ListVacanciesWithResponse request returning *ListVacanciesResponse
+func (c *ClientWithResponses) ListVacanciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVacanciesResponse, error) {
+	rsp, err := c.ListVacancies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVacanciesResponse(rsp)
+}
+
+// DeleteVacancyWithResponse request returning *DeleteVacancyResponse
+func (c *ClientWithResponses) DeleteVacancyWithResponse(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*DeleteVacancyResponse, error) {
+	rsp, err := c.DeleteVacancy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVacancyResponse(rsp)
+}
+
+// GetVacancyWithResponse request returning *GetVacancyResponse
+func (c *ClientWithResponses) GetVacancyWithResponse(ctx context.Context, id VacancyID, reqEditors ...RequestEditorFn) (*GetVacancyResponse, error) {
+	rsp, err := c.GetVacancy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVacancyResponse(rsp)
+}
+
+// PutVacancyWithBodyWithResponse request with arbitrary body returning *PutVacancyResponse
+func (c *ClientWithResponses) PutVacancyWithBodyWithResponse(ctx context.Context, id VacancyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutVacancyResponse, error) {
+	rsp, err := c.PutVacancyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutVacancyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutVacancyWithResponse(ctx context.Context, id VacancyID, body PutVacancyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutVacancyResponse, error) {
+	rsp, err := c.PutVacancy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutVacancyResponse(rsp)
+}
+
+// ParseListApplicationsResponse parses an HTTP response from a ListApplicationsWithResponse call
+func ParseListApplicationsResponse(rsp *http.Response) (*ListApplicationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListApplicationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Application
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApplicationResponse parses an HTTP response from a CreateApplicationWithResponse call
+func ParseCreateApplicationResponse(rsp *http.Response) (*CreateApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Application
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApplicationResponse parses an HTTP response from a GetApplicationWithResponse call
+func ParseGetApplicationResponse(rsp *http.Response) (*GetApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Application
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWithdrawApplicationResponse parses an HTTP response from a WithdrawApplicationWithResponse call
+func ParseWithdrawApplicationResponse(rsp *http.Response) (*WithdrawApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WithdrawApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Application
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAvailabilityResponse parses an HTTP response from a GetAvailabilityWithResponse call
+func ParseGetAvailabilityResponse(rsp *http.Response) (*GetAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Availability
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAvailabilityResponse parses an HTTP response from a SetAvailabilityWithResponse call
+func ParseSetAvailabilityResponse(rsp *http.Response) (*SetAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInterviewDataResponse parses an HTTP response from a InterviewDataWithResponse call
+func ParseInterviewDataResponse(rsp *http.Response) (*InterviewDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InterviewDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInterviewsResponse parses an HTTP response from a ListInterviewsWithResponse call
+func ParseListInterviewsResponse(rsp *http.Response) (*ListInterviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInterviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Interview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInterviewResponse parses an HTTP response from a CreateInterviewWithResponse call
+func ParseCreateInterviewResponse(rsp *http.Response) (*CreateInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedInterview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInterviewResponse parses an HTTP response from a DeleteInterviewWithResponse call
+func ParseDeleteInterviewResponse(rsp *http.Response) (*DeleteInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeletedInterview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInterviewResponse parses an HTTP response from a GetInterviewWithResponse call
+func ParseGetInterviewResponse(rsp *http.Response) (*GetInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Interview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchInterviewResponse parses an HTTP response from a PatchInterviewWithResponse call
+func ParsePatchInterviewResponse(rsp *http.Response) (*PatchInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelInterviewResponse parses an HTTP response from a CancelInterviewWithResponse call
+func ParseCancelInterviewResponse(rsp *http.Response) (*CancelInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDoneInterviewResponse parses an HTTP response from a DoneInterviewWithResponse call
+func ParseDoneInterviewResponse(rsp *http.Response) (*DoneInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DoneInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetInterviewPanelResponse parses an HTTP response from a SetInterviewPanelWithResponse call
+func ParseSetInterviewPanelResponse(rsp *http.Response) (*SetInterviewPanelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetInterviewPanelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Panel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRescheduleInterviewResponse parses an HTTP response from a RescheduleInterviewWithResponse call
+func ParseRescheduleInterviewResponse(rsp *http.Response) (*RescheduleInterviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RescheduleInterviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RescheduledInterview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScorecardResponse parses an HTTP response from a GetScorecardWithResponse call
+func ParseGetScorecardResponse(rsp *http.Response) (*GetScorecardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScorecardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scorecard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOutcomesReportResponse parses an HTTP response from a OutcomesReportWithResponse call
+func ParseOutcomesReportResponse(rsp *http.Response) (*OutcomesReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OutcomesReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OutcomesRow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpsertEmployeeResponse parses an HTTP response from a UpsertEmployeeWithResponse call
+func ParseUpsertEmployeeResponse(rsp *http.Response) (*UpsertEmployeeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpsertEmployeeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUsersResponse parses an HTTP response from a ListUsersWithResponse call
+func ParseListUsersResponse(rsp *http.Response) (*ListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeInterviewerResponse parses an HTTP response from a RevokeInterviewerWithResponse call
+func ParseRevokeInterviewerResponse(rsp *http.Response) (*RevokeInterviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeInterviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevokedInterviewer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGrantInterviewerResponse parses an HTTP response from a GrantInterviewerWithResponse call
+func ParseGrantInterviewerResponse(rsp *http.Response) (*GrantInterviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GrantInterviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUserInterviewsResponse parses an HTTP response from a ListUserInterviewsWithResponse call
+func ParseListUserInterviewsResponse(rsp *http.Response) (*ListUserInterviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUserInterviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Interview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetLimitsResponse parses an HTTP response from a SetLimitsWithResponse call
+func ParseSetLimitsResponse(rsp *http.Response) (*SetLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetNotificationsResponse parses an HTTP response from a SetNotificationsWithResponse call
+func ParseSetNotificationsResponse(rsp *http.Response) (*SetNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetSkillsResponse parses an HTTP response from a SetSkillsWithResponse call
+func ParseSetSkillsResponse(rsp *http.Response) (*SetSkillsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetSkillsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTimeZoneResponse parses an HTTP response from a SetTimeZoneWithResponse call
+func ParseSetTimeZoneResponse(rsp *http.Response) (*SetTimeZoneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTimeZoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVacanciesResponse parses an HTTP response from a ListVacanciesWithResponse call
+func ParseListVacanciesResponse(rsp *http.Response) (*ListVacanciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVacanciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Vacancy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVacancyResponse parses an HTTP response from a DeleteVacancyWithResponse call
+func ParseDeleteVacancyResponse(rsp *http.Response) (*DeleteVacancyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVacancyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVacancyResponse parses an HTTP response from a GetVacancyWithResponse call
+func ParseGetVacancyResponse(rsp *http.Response) (*GetVacancyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVacancyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Vacancy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutVacancyResponse parses an HTTP response from a PutVacancyWithResponse call
+func ParsePutVacancyResponse(rsp *http.Response) (*PutVacancyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutVacancyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Vacancy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
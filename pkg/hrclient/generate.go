@@ -0,0 +1,3 @@
+package hrclient
+
+//go:generate oapi-codegen -config oapi-codegen.yaml ../../internal/hr/openapi.yaml
@@ -0,0 +1,114 @@
+package txn
+
+import (
+	"context"
+	"sync"
+)
+
+type hooksKey struct{}
+
+// hooks are functions waiting for the active txn of the session to commit
+type hooks struct {
+	mu      sync.Mutex
+	active  bool
+	txn     int
+	pending []func()
+}
+
+// OnCommit runs f once the active txn of the session context commits, f is dropped
+// if the txn is aborted. Outside of a txn changes are applied at once, so f runs immediately.
+func OnCommit(ctx context.Context, f func()) {
+	h, ok := ctx.Value(hooksKey{}).(*hooks)
+	if !ok || !h.add(f) {
+		f()
+	}
+}
+
+func (h *hooks) add(f func()) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.active {
+		return false
+	}
+
+	h.pending = append(h.pending, f)
+	return true
+}
+
+// start returns the number of the started txn, finish ignores txns ended already
+func (h *hooks) start() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.txn++
+	h.active = true
+	h.pending = nil
+	return h.txn
+}
+
+// finish ends the txn and returns the hooks to run, if it has been committed
+func (h *hooks) finish(txn int, committed bool) []func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.active || txn != h.txn {
+		return nil
+	}
+
+	pending := h.pending
+	h.active = false
+	h.pending = nil
+
+	if !committed {
+		return nil
+	}
+	return pending
+}
+
+// hookedTxn starts txns running hooks of the session on commit
+type hookedTxn struct {
+	Txn
+	hooks *hooks
+}
+
+func (t hookedTxn) SetModel(model ConsistencyModel) Txn {
+	return hookedTxn{Txn: t.Txn.SetModel(model), hooks: t.hooks}
+}
+
+func (t hookedTxn) SetIsolation(lvl IsolationLevel) Txn {
+	return hookedTxn{Txn: t.Txn.SetIsolation(lvl), hooks: t.hooks}
+}
+
+func (t hookedTxn) Start(ctx context.Context) (ActiveTxn, error) {
+	tx, err := t.Txn.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return hookedActiveTxn{ActiveTxn: tx, hooks: t.hooks, txn: t.hooks.start()}, nil
+}
+
+type hookedActiveTxn struct {
+	ActiveTxn
+	hooks *hooks
+	txn   int
+}
+
+func (t hookedActiveTxn) Commit(ctx context.Context) error {
+	err := t.ActiveTxn.Commit(ctx)
+	for _, f := range t.hooks.finish(t.txn, err == nil) {
+		f()
+	}
+	return err
+}
+
+func (t hookedActiveTxn) Abort(ctx context.Context) error {
+	t.hooks.finish(t.txn, false)
+	return t.ActiveTxn.Abort(ctx)
+}
+
+func (t hookedActiveTxn) Close(ctx context.Context) error {
+	t.hooks.finish(t.txn, false)
+	return t.ActiveTxn.Close(ctx)
+}
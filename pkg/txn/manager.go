@@ -35,6 +35,7 @@ func (m Manager) NewSessionContext(parent context.Context, timeout time.Duration
 	})
 
 	ctx = context.WithValue(parent, sessionKey{}, session)
+	ctx = context.WithValue(ctx, hooksKey{}, &hooks{})
 	ctx = session.BindContext(ctx)
 
 	return ctx, cancel, nil
@@ -46,7 +47,7 @@ func Start(ctx context.Context) (ActiveTxn, error) {
 		return nil, errors.Fail("get session from context")
 	}
 
-	tx, err := session.Txn().Start(ctx)
+	tx, err := newTxn(ctx, session).Start(ctx)
 	return tx, errors.WrapFail(err, "start txn")
 }
 
@@ -56,5 +57,13 @@ func New(ctx context.Context) Txn {
 		panic(errors.Fail("get session from context"))
 	}
 
-	return session.Txn()
+	return newTxn(ctx, session)
+}
+
+func newTxn(ctx context.Context, session Session) Txn {
+	h, ok := ctx.Value(hooksKey{}).(*hooks)
+	if !ok {
+		return session.Txn()
+	}
+	return hookedTxn{Txn: session.Txn(), hooks: h}
 }